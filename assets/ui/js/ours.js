@@ -1,2 +1,74 @@
 htmx.logAll();
-htmx.getCacheBusterParam = true;
\ No newline at end of file
+htmx.getCacheBusterParam = true;
+// every request htmx makes carries the csrf token of the page
+document.addEventListener("htmx:configRequest", (e) => {
+    const token = document.querySelector("meta[name=csrf-token]");
+    if (token) {
+        e.detail.headers["X-CSRF-Token"] = token.content;
+    }
+});
+// avatarCropper lets the square of a chosen avatar that will be kept be picked before it's uploaded
+// the preview shows that square: drag it to move and use the slider to zoom
+// the square is sent in the image's own pixels as crop_x, crop_y and crop_size
+function avatarCropper(form) {
+    const file = form.querySelector("input[type=file]");
+    const frame = form.querySelector(".avatar-crop");
+    const img = frame.querySelector("img");
+    const zoom = form.querySelector("input[type=range]");
+    const fields = ["crop_x", "crop_y", "crop_size"].map((name) => form.querySelector("[name=" + name + "]"));
+    let largest = 0;
+    let crop = { x: 0, y: 0, size: 0 };
+    let drag = null;
+
+    function draw() {
+        crop.size = Math.min(Math.max(crop.size, 1), largest);
+        crop.x = Math.min(Math.max(crop.x, 0), img.naturalWidth - crop.size);
+        crop.y = Math.min(Math.max(crop.y, 0), img.naturalHeight - crop.size);
+        const scale = frame.clientWidth / crop.size;
+        img.style.width = img.naturalWidth * scale + "px";
+        img.style.left = -crop.x * scale + "px";
+        img.style.top = -crop.y * scale + "px";
+        [crop.x, crop.y, crop.size].forEach((v, i) => { fields[i].value = Math.round(v); });
+    }
+
+    file.addEventListener("change", () => {
+        fields.forEach((f) => { f.value = ""; });
+        frame.hidden = true;
+        zoom.parentElement.hidden = true;
+        if (file.files.length) {
+            img.src = URL.createObjectURL(file.files[0]);
+        }
+    });
+    img.addEventListener("load", () => {
+        largest = Math.min(img.naturalWidth, img.naturalHeight);
+        crop = { x: (img.naturalWidth - largest) / 2, y: (img.naturalHeight - largest) / 2, size: largest };
+        zoom.value = 1;
+        frame.hidden = false;
+        zoom.parentElement.hidden = false;
+        draw();
+    });
+    zoom.addEventListener("input", () => {
+        const size = largest / zoom.value;
+        crop = { x: crop.x + (crop.size - size) / 2, y: crop.y + (crop.size - size) / 2, size: size };
+        draw();
+    });
+    frame.addEventListener("pointerdown", (e) => {
+        drag = { x: e.clientX, y: e.clientY };
+        frame.setPointerCapture(e.pointerId);
+    });
+    frame.addEventListener("pointermove", (e) => {
+        if (!drag) {
+            return;
+        }
+        const scale = frame.clientWidth / crop.size;
+        crop.x -= (e.clientX - drag.x) / scale;
+        crop.y -= (e.clientY - drag.y) / scale;
+        drag = { x: e.clientX, y: e.clientY };
+        draw();
+    });
+    frame.addEventListener("pointerup", () => { drag = null; });
+}
+
+htmx.onLoad((elt) => {
+    elt.querySelectorAll(".avatar-cropper").forEach(avatarCropper);
+});
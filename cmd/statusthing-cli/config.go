@@ -0,0 +1,69 @@
+package main
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	defaultServer = "http://127.0.0.1:9000"
+	serverEnv     = "STATUSTHING_SERVER"
+	tlsCertEnv    = "STATUSTHING_CLI_TLS_CERT"
+	tlsKeyEnv     = "STATUSTHING_CLI_TLS_KEY"
+	caEnv         = "STATUSTHING_CLI_CA"
+	configEnv     = "STATUSTHING_CLI_CONFIG"
+)
+
+// config is the cli configuration
+// values are taken from flags, then the environment, then the config file
+type config struct {
+	Server string `yaml:"server"`
+	// TLSCert and TLSKey are the client certificate the server identifies the cli by
+	TLSCert string `yaml:"tls_cert"`
+	TLSKey  string `yaml:"tls_key"`
+	// CA verifies the server's certificate in place of the system roots
+	CA     string `yaml:"ca"`
+	Output string `yaml:"output"`
+}
+
+// defaultConfigPath is where the config file is read from when --config and STATUSTHING_CLI_CONFIG aren't set
+func defaultConfigPath() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "statusthing", "cli.yaml")
+}
+
+// loadConfig reads the config file at the provided path
+// a missing file is only an error if the path was set explicitly
+func loadConfig(path string, explicit bool) (*config, error) {
+	cfg := &config{}
+	if path == "" {
+		return cfg, nil
+	}
+	b, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) && !explicit {
+			return cfg, nil
+		}
+		return nil, err
+	}
+	if err := yaml.Unmarshal(b, cfg); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// resolve returns the first value that is set
+func resolve(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,151 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/bufbuild/connect-go"
+	flag "github.com/spf13/pflag"
+
+	statusthingv1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
+)
+
+const itemsHeader = "ID\tNAME\tSTATUS\tDESCRIPTION"
+
+var itemCommands = map[string]command{
+	"list": {
+		usage: "[--tree] [--status-id id]... [--kind kind]... [--notes]",
+		flags: func(fs *flag.FlagSet) func(context.Context, *cli, []string) error {
+			tree := fs.Bool("tree", false, "nest child items under their group")
+			statusIDs := fs.StringSlice("status-id", nil, "only items with any of these status ids")
+			kinds := fs.StringSlice("kind", nil, "only items with a status of any of these kinds. i.e. down or STATUS_KIND_DOWN")
+			notes := fs.Bool("notes", false, "include notes in json and yaml output")
+			return func(ctx context.Context, c *cli, args []string) error {
+				req := &statusthingv1.ListItemsRequest{StatusIds: *statusIDs, Tree: *tree, Extended: *notes}
+				for _, k := range *kinds {
+					kind, err := parseKind(k)
+					if err != nil {
+						return err
+					}
+					req.Kinds = append(req.Kinds, kind)
+				}
+				res, err := c.items.ListItems(ctx, connect.NewRequest(req))
+				if err != nil {
+					return err
+				}
+				return c.print(res.Msg, itemsHeader, func(w io.Writer) {
+					for _, item := range res.Msg.GetItems() {
+						writeItem(w, item, 0)
+					}
+				})
+			}
+		},
+	},
+	"get": {
+		usage: "<item id>",
+		flags: func(fs *flag.FlagSet) func(context.Context, *cli, []string) error {
+			return func(ctx context.Context, c *cli, args []string) error {
+				if len(args) != 1 {
+					return fmt.Errorf("an item id is required")
+				}
+				return c.printItem(ctx, args[0])
+			}
+		},
+	},
+	"add": {
+		usage: "<name> [--description text] [--status status] [--parent-id id] [--note text]",
+		flags: func(fs *flag.FlagSet) func(context.Context, *cli, []string) error {
+			description := fs.String("description", "", "the description")
+			status := fs.String("status", "", "id or name of the initial status")
+			parentID := fs.String("parent-id", "", "id of the item to group the new item under")
+			note := fs.String("note", "", "text of an initial note")
+			return func(ctx context.Context, c *cli, args []string) error {
+				if len(args) != 1 {
+					return fmt.Errorf("a name is required")
+				}
+				req := &statusthingv1.AddItemRequest{Name: args[0], Description: *description, ParentId: *parentID, InitialNoteText: *note}
+				if *status != "" {
+					statusID, err := c.findStatusID(ctx, *status)
+					if err != nil {
+						return err
+					}
+					req.InitialStatusId = statusID
+				}
+				res, err := c.items.AddItem(ctx, connect.NewRequest(req))
+				if err != nil {
+					return err
+				}
+				return c.print(res.Msg, itemsHeader, func(w io.Writer) {
+					writeItem(w, res.Msg.GetItem(), 0)
+				})
+			}
+		},
+	},
+	"set-status": {
+		usage: "<item id> <status> [--note text]",
+		flags: func(fs *flag.FlagSet) func(context.Context, *cli, []string) error {
+			note := fs.String("note", "", "text of a note to add along with the status change")
+			return func(ctx context.Context, c *cli, args []string) error {
+				if len(args) != 2 {
+					return fmt.Errorf("an item id and a status id or name are required")
+				}
+				statusID, err := c.findStatusID(ctx, args[1])
+				if err != nil {
+					return err
+				}
+				if *note != "" {
+					// the batch update changes the status and adds the note in one transaction
+					req := &statusthingv1.BatchUpdateItemsRequest{ItemIds: []string{args[0]}, StatusId: statusID, NoteText: *note}
+					if _, err := c.items.BatchUpdateItems(ctx, connect.NewRequest(req)); err != nil {
+						return err
+					}
+				} else {
+					req := &statusthingv1.UpdateItemRequest{ItemId: args[0], StatusId: statusID}
+					if _, err := c.items.UpdateItem(ctx, connect.NewRequest(req)); err != nil {
+						return err
+					}
+				}
+				return c.printItem(ctx, args[0])
+			}
+		},
+	},
+	"delete": {
+		usage: "<item id>",
+		flags: func(fs *flag.FlagSet) func(context.Context, *cli, []string) error {
+			return func(ctx context.Context, c *cli, args []string) error {
+				if len(args) != 1 {
+					return fmt.Errorf("an item id is required")
+				}
+				if _, err := c.items.DeleteItem(ctx, connect.NewRequest(&statusthingv1.DeleteItemRequest{ItemId: args[0]})); err != nil {
+					return err
+				}
+				c.printDone("deleted item %s", args[0])
+				return nil
+			}
+		},
+	},
+}
+
+func (c *cli) printItem(ctx context.Context, itemID string) error {
+	res, err := c.items.GetItem(ctx, connect.NewRequest(&statusthingv1.GetItemRequest{ItemId: itemID}))
+	if err != nil {
+		return err
+	}
+	return c.print(res.Msg, itemsHeader, func(w io.Writer) {
+		writeItem(w, res.Msg.GetItem(), 0)
+	})
+}
+
+// writeItem writes the item and its children indented by depth
+func writeItem(w io.Writer, item *statusthingv1.Item, depth int) {
+	status := item.GetStatus().GetName()
+	if effective := item.GetEffectiveStatus(); effective != nil && effective.GetId() != item.GetStatus().GetId() {
+		status = fmt.Sprintf("%s (%s)", status, effective.GetName())
+	}
+	fmt.Fprintf(w, "%s\t%s%s\t%s\t%s\n", item.GetId(), strings.Repeat("  ", depth), item.GetName(), status, item.GetDescription())
+	for _, child := range item.GetChildren() {
+		writeItem(w, child, depth+1)
+	}
+}
@@ -0,0 +1,173 @@
+// Package main is a command line client for the statusthing api
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+
+	flag "github.com/spf13/pflag"
+
+	v1connect "github.com/lusis/statusthing/gen/go/statusthing/v1/statusthingv1connect"
+)
+
+// command is a single verb of a resource. i.e. items list
+type command struct {
+	// usage is the arguments the command takes
+	usage string
+	// flags adds the command's flags and returns the function that runs the command once they are parsed
+	flags func(fs *flag.FlagSet) func(ctx context.Context, c *cli, args []string) error
+}
+
+// commands are the commands by resource and verb
+var commands = map[string]map[string]command{
+	"items":    itemCommands,
+	"notes":    noteCommands,
+	"statuses": statusCommands,
+}
+
+func main() {
+	if err := run(context.Background(), os.Args[1:], os.Stdout); err != nil {
+		fmt.Fprintf(os.Stderr, "error: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, args []string, out io.Writer) error {
+	global := flag.NewFlagSet("statusthing-cli", flag.ContinueOnError)
+	server := global.String("server", "", fmt.Sprintf("url of the statusthing server. also %s. defaults to %s", serverEnv, defaultServer))
+	tlsCert := global.String("tls-cert", "", "pem encoded client certificate the server identifies the cli by. also "+tlsCertEnv)
+	tlsKey := global.String("tls-key", "", "pem encoded private key of --tls-cert. also "+tlsKeyEnv)
+	ca := global.String("ca", "", "pem encoded certificate authorities to verify the server with instead of the system ones. also "+caEnv)
+	configPath := global.String("config", "", fmt.Sprintf("config file with server, tls_cert, tls_key, ca and output. also %s. defaults to %s", configEnv, defaultConfigPath()))
+	output := global.StringP("output", "o", "", "table, json or yaml. defaults to table")
+	global.Usage = func() { usage(global) }
+	// everything after the resource and verb is parsed again along with the command's own flags
+	global.SetInterspersed(false)
+	if err := global.Parse(args); err != nil {
+		return err
+	}
+	rest := global.Args()
+	if len(rest) < 2 {
+		usage(global)
+		return fmt.Errorf("a resource and command are required")
+	}
+	cmd, ok := commands[rest[0]][rest[1]]
+	if !ok {
+		usage(global)
+		return fmt.Errorf("unknown command %s %s", rest[0], rest[1])
+	}
+	fs := flag.NewFlagSet(rest[0]+" "+rest[1], flag.ContinueOnError)
+	fs.AddFlagSet(global)
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: statusthing-cli %s %s %s\n", rest[0], rest[1], cmd.usage)
+		fs.PrintDefaults()
+	}
+	runCmd := cmd.flags(fs)
+	if err := fs.Parse(rest[2:]); err != nil {
+		return err
+	}
+
+	explicit := resolve(*configPath, os.Getenv(configEnv))
+	cfg, err := loadConfig(resolve(explicit, defaultConfigPath()), explicit != "")
+	if err != nil {
+		return fmt.Errorf("reading config: %w", err)
+	}
+	format := resolve(*output, cfg.Output, formatTable)
+	switch format {
+	case formatTable, formatJSON, formatYAML:
+	default:
+		return fmt.Errorf("unknown output %q. expected table, json or yaml", format)
+	}
+	httpClient, err := newHTTPClient(
+		resolve(*tlsCert, os.Getenv(tlsCertEnv), cfg.TLSCert),
+		resolve(*tlsKey, os.Getenv(tlsKeyEnv), cfg.TLSKey),
+		resolve(*ca, os.Getenv(caEnv), cfg.CA),
+	)
+	if err != nil {
+		return err
+	}
+	c := newCLI(httpClient, resolve(*server, os.Getenv(serverEnv), cfg.Server, defaultServer), format, out)
+	return runCmd(ctx, c, fs.Args())
+}
+
+// cli holds the api clients and output settings shared by every command
+type cli struct {
+	items    v1connect.ItemsServiceClient
+	statuses v1connect.StatusServiceClient
+	notes    v1connect.NotesServiceClient
+	format   string
+	out      io.Writer
+}
+
+func newCLI(httpClient *http.Client, server, format string, out io.Writer) *cli {
+	server = strings.TrimSuffix(server, "/")
+	return &cli{
+		items:    v1connect.NewItemsServiceClient(httpClient, server),
+		statuses: v1connect.NewStatusServiceClient(httpClient, server),
+		notes:    v1connect.NewNotesServiceClient(httpClient, server),
+		format:   format,
+		out:      out,
+	}
+}
+
+// newHTTPClient returns the client the api is called with
+// the server only knows who is calling from a client certificate. without one every call is anonymous
+func newHTTPClient(certFile, keyFile, caFile string) (*http.Client, error) {
+	if certFile == "" && keyFile == "" && caFile == "" {
+		return http.DefaultClient, nil
+	}
+	if (certFile == "") != (keyFile == "") {
+		return nil, fmt.Errorf("--tls-cert and --tls-key must be set together")
+	}
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if certFile != "" {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("loading client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	if caFile != "" {
+		b, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, fmt.Errorf("reading ca: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(b) {
+			return nil, fmt.Errorf("no certificates found in %s", caFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	return &http.Client{Transport: transport}, nil
+}
+
+func usage(global *flag.FlagSet) {
+	fmt.Fprintln(os.Stderr, "usage: statusthing-cli [flags] <resource> <command> [args]")
+	fmt.Fprintln(os.Stderr, "\ncommands:")
+	resources := []string{}
+	for resource := range commands {
+		resources = append(resources, resource)
+	}
+	sort.Strings(resources)
+	for _, resource := range resources {
+		verbs := []string{}
+		for verb := range commands[resource] {
+			verbs = append(verbs, verb)
+		}
+		sort.Strings(verbs)
+		for _, verb := range verbs {
+			fmt.Fprintf(os.Stderr, "  %s %s %s\n", resource, verb, commands[resource][verb].usage)
+		}
+	}
+	fmt.Fprintln(os.Stderr, "\nflags:")
+	global.PrintDefaults()
+}
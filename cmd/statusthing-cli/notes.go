@@ -0,0 +1,225 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/bufbuild/connect-go"
+	flag "github.com/spf13/pflag"
+
+	statusthingv1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
+)
+
+const (
+	notesHeader       = "ID\tCREATED\tAUTHOR\tFLAGS\tTEXT"
+	revisionsHeader   = "VERSION\tWRITTEN\tREPLACED\tFLAGS\tTEXT"
+	attachmentsHeader = "ID\tCREATED\tTYPE\tSIZE\tFILENAME"
+	// uploadChunkSize is the size of each chunk of an uploaded attachment
+	uploadChunkSize = 32 << 10
+)
+
+var noteCommands = map[string]command{
+	"list": {
+		usage: "<item id>",
+		flags: func(fs *flag.FlagSet) func(context.Context, *cli, []string) error {
+			return func(ctx context.Context, c *cli, args []string) error {
+				if len(args) != 1 {
+					return fmt.Errorf("an item id is required")
+				}
+				res, err := c.notes.ListNotes(ctx, connect.NewRequest(&statusthingv1.ListNotesRequest{ItemId: args[0]}))
+				if err != nil {
+					return err
+				}
+				return c.print(res.Msg, notesHeader, func(w io.Writer) {
+					for _, note := range res.Msg.GetNotes() {
+						writeNote(w, note)
+					}
+				})
+			}
+		},
+	},
+	"add": {
+		usage: "<item id> <text> [--internal] [--pinned]",
+		flags: func(fs *flag.FlagSet) func(context.Context, *cli, []string) error {
+			internal := fs.Bool("internal", false, "only show the note to authenticated callers and in the admin ui")
+			pinned := fs.Bool("pinned", false, "list the note before all others")
+			return func(ctx context.Context, c *cli, args []string) error {
+				if len(args) != 2 {
+					return fmt.Errorf("an item id and text are required")
+				}
+				req := &statusthingv1.AddNoteRequest{ItemId: args[0], NoteText: args[1], Pinned: *pinned}
+				if *internal {
+					req.Visibility = statusthingv1.NoteVisibility_NOTE_VISIBILITY_INTERNAL
+				}
+				res, err := c.notes.AddNote(ctx, connect.NewRequest(req))
+				if err != nil {
+					return err
+				}
+				return c.print(res.Msg, notesHeader, func(w io.Writer) {
+					writeNote(w, res.Msg.GetNote())
+				})
+			}
+		},
+	},
+	"revisions": {
+		usage: "<note id>",
+		flags: func(fs *flag.FlagSet) func(context.Context, *cli, []string) error {
+			return func(ctx context.Context, c *cli, args []string) error {
+				if len(args) != 1 {
+					return fmt.Errorf("a note id is required")
+				}
+				res, err := c.notes.ListNoteRevisions(ctx, connect.NewRequest(&statusthingv1.ListNoteRevisionsRequest{NoteId: args[0]}))
+				if err != nil {
+					return err
+				}
+				return c.print(res.Msg, revisionsHeader, func(w io.Writer) {
+					for _, rev := range res.Msg.GetRevisions() {
+						fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\n", rev.GetVersion(), formatTime(rev.GetWritten()), formatTime(rev.GetReplaced()), noteFlags(rev.GetPinned(), rev.GetVisibility(), false), rev.GetText())
+					}
+				})
+			}
+		},
+	},
+	"attach": {
+		usage: "<note id> <file>",
+		flags: func(fs *flag.FlagSet) func(context.Context, *cli, []string) error {
+			return func(ctx context.Context, c *cli, args []string) error {
+				if len(args) != 2 {
+					return fmt.Errorf("a note id and file are required")
+				}
+				f, err := os.Open(args[1])
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+				stream := c.notes.UploadAttachment(ctx)
+				if err := stream.Send(&statusthingv1.UploadAttachmentRequest{Data: &statusthingv1.UploadAttachmentRequest_Info{
+					Info: &statusthingv1.AttachmentInfo{NoteId: args[0], Filename: filepath.Base(args[1])},
+				}}); err != nil {
+					return closeUpload(stream, err)
+				}
+				buf := make([]byte, uploadChunkSize)
+				for {
+					n, err := f.Read(buf)
+					if n > 0 {
+						if serr := stream.Send(&statusthingv1.UploadAttachmentRequest{Data: &statusthingv1.UploadAttachmentRequest_Chunk{Chunk: buf[:n]}}); serr != nil {
+							return closeUpload(stream, serr)
+						}
+					}
+					if errors.Is(err, io.EOF) {
+						break
+					}
+					if err != nil {
+						return err
+					}
+				}
+				res, err := stream.CloseAndReceive()
+				if err != nil {
+					return err
+				}
+				return c.print(res.Msg, attachmentsHeader, func(w io.Writer) {
+					writeAttachment(w, res.Msg.GetAttachment())
+				})
+			}
+		},
+	},
+	"attachments": {
+		usage: "<note id>",
+		flags: func(fs *flag.FlagSet) func(context.Context, *cli, []string) error {
+			return func(ctx context.Context, c *cli, args []string) error {
+				if len(args) != 1 {
+					return fmt.Errorf("a note id is required")
+				}
+				res, err := c.notes.ListAttachments(ctx, connect.NewRequest(&statusthingv1.ListAttachmentsRequest{NoteId: args[0]}))
+				if err != nil {
+					return err
+				}
+				return c.print(res.Msg, attachmentsHeader, func(w io.Writer) {
+					for _, att := range res.Msg.GetAttachments() {
+						writeAttachment(w, att)
+					}
+				})
+			}
+		},
+	},
+	"download": {
+		usage: "<attachment id> [path]",
+		flags: func(fs *flag.FlagSet) func(context.Context, *cli, []string) error {
+			return func(ctx context.Context, c *cli, args []string) error {
+				if len(args) < 1 || len(args) > 2 {
+					return fmt.Errorf("an attachment id is required")
+				}
+				stream, err := c.notes.DownloadAttachment(ctx, connect.NewRequest(&statusthingv1.DownloadAttachmentRequest{AttachmentId: args[0]}))
+				if err != nil {
+					return err
+				}
+				defer stream.Close()
+				if !stream.Receive() {
+					if err := stream.Err(); err != nil {
+						return err
+					}
+					return fmt.Errorf("no attachment was returned")
+				}
+				att := stream.Msg().GetAttachment()
+				path := filepath.Base(att.GetFilename())
+				if len(args) == 2 {
+					path = args[1]
+				}
+				// never overwrite an existing file
+				f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+				if err != nil {
+					return err
+				}
+				for stream.Receive() {
+					if _, err := f.Write(stream.Msg().GetChunk()); err != nil {
+						f.Close()
+						return err
+					}
+				}
+				if err := errors.Join(stream.Err(), f.Close()); err != nil {
+					return err
+				}
+				return c.print(att, attachmentsHeader, func(w io.Writer) {
+					writeAttachment(w, att)
+				})
+			}
+		},
+	},
+}
+
+// closeUpload closes a failed upload stream and returns the server's error if there is one
+// the error from Send is usually io.EOF when the server has already rejected the upload
+func closeUpload(stream *connect.ClientStreamForClient[statusthingv1.UploadAttachmentRequest, statusthingv1.UploadAttachmentResponse], sendErr error) error {
+	if _, err := stream.CloseAndReceive(); err != nil {
+		return err
+	}
+	return sendErr
+}
+
+func writeAttachment(w io.Writer, att *statusthingv1.Attachment) {
+	fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%s\n", att.GetId(), formatTime(att.GetTimestamps().GetCreated()), att.GetContentType(), att.GetSize(), att.GetFilename())
+}
+
+func writeNote(w io.Writer, note *statusthingv1.Note) {
+	fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", note.GetId(), formatTime(note.GetTimestamps().GetCreated()), note.GetAuthor(), noteFlags(note.GetPinned(), note.GetVisibility(), note.GetEdited()), note.GetText())
+}
+
+// noteFlags returns a comma separated list of which flags are set
+func noteFlags(pinned bool, visibility statusthingv1.NoteVisibility, edited bool) string {
+	flags := []string{}
+	if pinned {
+		flags = append(flags, "pinned")
+	}
+	if visibility == statusthingv1.NoteVisibility_NOTE_VISIBILITY_INTERNAL {
+		flags = append(flags, "internal")
+	}
+	if edited {
+		flags = append(flags, "edited")
+	}
+	return strings.Join(flags, ",")
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/lusis/statusthing/internal/dataset"
+)
+
+const (
+	formatTable = "table"
+	formatJSON  = string(dataset.FormatJSON)
+	formatYAML  = string(dataset.FormatYAML)
+)
+
+// print writes the response in the configured format
+// table is called to write rows when the format is table
+func (c *cli) print(msg proto.Message, header string, table func(w io.Writer)) error {
+	if c.format != formatTable {
+		b, err := dataset.MarshalMessage(msg, dataset.Format(c.format))
+		if err != nil {
+			return err
+		}
+		_, err = c.out.Write(b)
+		return err
+	}
+	w := tabwriter.NewWriter(c.out, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, header)
+	table(w)
+	return w.Flush()
+}
+
+// printDone reports a change that has no response to print
+func (c *cli) printDone(format string, args ...any) {
+	if c.format == formatTable {
+		fmt.Fprintf(c.out, format+"\n", args...)
+	}
+}
+
+func formatTime(ts *timestamppb.Timestamp) string {
+	if ts == nil {
+		return ""
+	}
+	return ts.AsTime().Local().Format(time.RFC3339)
+}
@@ -0,0 +1,142 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/bufbuild/connect-go"
+	flag "github.com/spf13/pflag"
+
+	statusthingv1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
+)
+
+const statusesHeader = "ID\tNAME\tKIND\tCOLOR\tDESCRIPTION"
+
+var statusCommands = map[string]command{
+	"list": {
+		usage: "[--kind kind]...",
+		flags: func(fs *flag.FlagSet) func(context.Context, *cli, []string) error {
+			kinds := fs.StringSlice("kind", nil, "only statuses of any of these kinds. i.e. down or STATUS_KIND_DOWN")
+			return func(ctx context.Context, c *cli, args []string) error {
+				req := &statusthingv1.ListStatusRequest{}
+				for _, k := range *kinds {
+					kind, err := parseKind(k)
+					if err != nil {
+						return err
+					}
+					req.Kinds = append(req.Kinds, kind)
+				}
+				res, err := c.statuses.ListStatus(ctx, connect.NewRequest(req))
+				if err != nil {
+					return err
+				}
+				return c.print(res.Msg, statusesHeader, func(w io.Writer) {
+					for _, status := range res.Msg.GetStatuses() {
+						writeStatus(w, status)
+					}
+				})
+			}
+		},
+	},
+	"get": {
+		usage: "<status id>",
+		flags: func(fs *flag.FlagSet) func(context.Context, *cli, []string) error {
+			return func(ctx context.Context, c *cli, args []string) error {
+				if len(args) != 1 {
+					return fmt.Errorf("a status id is required")
+				}
+				res, err := c.statuses.GetStatus(ctx, connect.NewRequest(&statusthingv1.GetStatusRequest{StatusId: args[0]}))
+				if err != nil {
+					return err
+				}
+				return c.print(res.Msg, statusesHeader, func(w io.Writer) {
+					writeStatus(w, res.Msg.GetStatus())
+				})
+			}
+		},
+	},
+	"add": {
+		usage: "<name> --kind kind [--color color] [--description text]",
+		flags: func(fs *flag.FlagSet) func(context.Context, *cli, []string) error {
+			kind := fs.String("kind", "", "the kind. i.e. up or STATUS_KIND_UP")
+			color := fs.String("color", "", "the color")
+			description := fs.String("description", "", "the description")
+			return func(ctx context.Context, c *cli, args []string) error {
+				if len(args) != 1 {
+					return fmt.Errorf("a name is required")
+				}
+				k, err := parseKind(*kind)
+				if err != nil {
+					return err
+				}
+				req := &statusthingv1.AddStatusRequest{Name: args[0], Kind: k, Color: *color, Description: *description}
+				res, err := c.statuses.AddStatus(ctx, connect.NewRequest(req))
+				if err != nil {
+					return err
+				}
+				return c.print(res.Msg, statusesHeader, func(w io.Writer) {
+					writeStatus(w, res.Msg.GetStatus())
+				})
+			}
+		},
+	},
+	"delete": {
+		usage: "<status id>",
+		flags: func(fs *flag.FlagSet) func(context.Context, *cli, []string) error {
+			return func(ctx context.Context, c *cli, args []string) error {
+				if len(args) != 1 {
+					return fmt.Errorf("a status id is required")
+				}
+				if _, err := c.statuses.DeleteStatus(ctx, connect.NewRequest(&statusthingv1.DeleteStatusRequest{StatusId: args[0]})); err != nil {
+					return err
+				}
+				c.printDone("deleted status %s", args[0])
+				return nil
+			}
+		},
+	},
+}
+
+func writeStatus(w io.Writer, status *statusthingv1.Status) {
+	fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", status.GetId(), status.GetName(), strings.TrimPrefix(status.GetKind().String(), "STATUS_KIND_"), status.GetColor(), status.GetDescription())
+}
+
+// parseKind returns the [statusthingv1.StatusKind] with or without the STATUS_KIND_ prefix
+func parseKind(name string) (statusthingv1.StatusKind, error) {
+	kind := strings.ToUpper(strings.TrimSpace(name))
+	if !strings.HasPrefix(kind, "STATUS_KIND_") {
+		kind = "STATUS_KIND_" + kind
+	}
+	k, ok := statusthingv1.StatusKind_value[kind]
+	if !ok || k == 0 {
+		return 0, fmt.Errorf("unknown status kind %q", name)
+	}
+	return statusthingv1.StatusKind(k), nil
+}
+
+// findStatusID returns the id of the status with the provided id or name
+func (c *cli) findStatusID(ctx context.Context, idOrName string) (string, error) {
+	res, err := c.statuses.ListStatus(ctx, connect.NewRequest(&statusthingv1.ListStatusRequest{}))
+	if err != nil {
+		return "", err
+	}
+	matches := []string{}
+	for _, status := range res.Msg.GetStatuses() {
+		if status.GetId() == idOrName {
+			return status.GetId(), nil
+		}
+		if strings.EqualFold(status.GetName(), idOrName) {
+			matches = append(matches, status.GetId())
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("no status with id or name %q", idOrName)
+	case 1:
+		return matches[0], nil
+	default:
+		return "", fmt.Errorf("more than one status named %q. use the id instead", idOrName)
+	}
+}
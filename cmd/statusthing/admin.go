@@ -0,0 +1,32 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	flag "github.com/spf13/pflag"
+
+	"github.com/lusis/statusthing/internal/config"
+	"github.com/lusis/statusthing/internal/services"
+)
+
+// runCreateAdmin creates an admin user with a random password and prints the password
+// it is refused when oidc is configured since admins then come from the provider's groups
+func runCreateAdmin(ctx context.Context, cfg *config.Config, sts *services.StatusThingService, args []string) error {
+	fs := flag.NewFlagSet("create-admin", flag.ContinueOnError)
+	username := fs.String("username", services.DefaultAdminUsername, "username of the admin")
+	email := fs.String("email", services.DefaultAdminEmail, "email address of the admin")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if cfg.OIDC.Enabled() {
+		return fmt.Errorf("oidc is configured so admins log in with the provider. add them to one of oidc.admin_groups instead")
+	}
+	user, password, err := sts.CreateAdmin(ctx, *username, *email)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("created admin %s with password %s\n", user.GetUsername(), password)
+	fmt.Println("the password has to be changed at the first login")
+	return nil
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	flag "github.com/spf13/pflag"
+	"golang.org/x/exp/slog"
+
+	"github.com/lusis/statusthing/internal/pageconfig"
+	"github.com/lusis/statusthing/internal/services"
+)
+
+// runApply converges the store on a page config file after printing the plan
+func runApply(ctx context.Context, sts *services.StatusThingService, args []string) error {
+	fs := flag.NewFlagSet("apply", flag.ContinueOnError)
+	file := fs.StringP("file", "f", "", "page config file to apply")
+	prune := fs.Bool("prune", false, "delete statuses and items that aren't in the file")
+	dryRun := fs.Bool("dry-run", false, "print the plan without applying it")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *file == "" {
+		return fmt.Errorf("usage: statusthing apply -f <file> [--prune] [--dry-run]")
+	}
+	page, err := pageconfig.Load(*file)
+	if err != nil {
+		return err
+	}
+	plan, err := sts.PlanPage(ctx, page, *prune)
+	if err != nil {
+		return err
+	}
+	fmt.Print(plan.String())
+	if *dryRun || plan.Empty() {
+		return nil
+	}
+	if err := sts.ApplyPlan(ctx, plan); err != nil {
+		return err
+	}
+	fmt.Printf("applied %d changes\n", len(plan.Changes))
+	return nil
+}
+
+// applyConfigFile converges the store on a page config file at startup
+func applyConfigFile(ctx context.Context, sts *services.StatusThingService, file string, prune bool) error {
+	page, err := pageconfig.Load(file)
+	if err != nil {
+		return err
+	}
+	plan, err := sts.PlanPage(ctx, page, prune)
+	if err != nil {
+		return err
+	}
+	for _, c := range plan.Changes {
+		slog.Info("applying page config change", "action", c.Action, "kind", c.Kind, "name", c.Name, "fields", c.Fields)
+	}
+	return sts.ApplyPlan(ctx, plan)
+}
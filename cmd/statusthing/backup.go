@@ -0,0 +1,48 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	flag "github.com/spf13/pflag"
+
+	"github.com/lusis/statusthing/internal/services"
+	"github.com/lusis/statusthing/internal/storers/sqlite"
+)
+
+// runBackup writes a consistent copy of the database. it is safe to run while the server is running
+func runBackup(ctx context.Context, store *sqlite.Store, sts *services.StatusThingService, args []string) error {
+	fs := flag.NewFlagSet("backup", flag.ContinueOnError)
+	output := fs.StringP("output", "o", "", "file to write the backup to. defaults to a new file in --backup-dir")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	path := *output
+	if path == "" {
+		p, err := sts.Backup(ctx)
+		if err != nil {
+			return fmt.Errorf("set --backup-dir or --output: %w", err)
+		}
+		path = p
+	} else if err := store.Backup(ctx, path); err != nil {
+		return err
+	}
+	fmt.Println(path)
+	return nil
+}
+
+// runRestore replaces the database with a backup. the server must be stopped first
+func runRestore(ctx context.Context, dbPath string, args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("usage: statusthing restore <backup file>")
+	}
+	version, keptPath, err := sqlite.Restore(ctx, args[0], dbPath)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("restored %s to %s at schema version %d\n", args[0], dbPath, version)
+	if keptPath != "" {
+		fmt.Printf("the previous database was kept as %s\n", keptPath)
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	flag "github.com/spf13/pflag"
+
+	statusthingv1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
+	"github.com/lusis/statusthing/internal/dataset"
+	"github.com/lusis/statusthing/internal/services"
+)
+
+// runExport writes every status, item, note, dependency and user to a file or stdout
+func runExport(ctx context.Context, sts *services.StatusThingService, args []string) error {
+	fs := flag.NewFlagSet("export", flag.ContinueOnError)
+	output := fs.StringP("output", "o", "-", "file to write the export to. - writes to stdout")
+	format := fs.String("format", "", "json or yaml. defaults to the output file extension or json")
+	includeHashes := fs.Bool("include-password-hashes", false, "include user password hashes in the export")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	f := dataset.FormatFromFilename(*output)
+	if *format != "" {
+		pf, err := dataset.ParseFormat(*format)
+		if err != nil {
+			return err
+		}
+		f = pf
+	}
+
+	ds, err := sts.ExportData(ctx, *includeHashes)
+	if err != nil {
+		return err
+	}
+	b, err := dataset.Marshal(ds, f)
+	if err != nil {
+		return err
+	}
+	if *output == "-" {
+		_, err := os.Stdout.Write(b)
+		return err
+	}
+	return os.WriteFile(*output, b, 0o600)
+}
+
+// runImport reads a file written by runExport, or stdin, and imports it
+func runImport(ctx context.Context, sts *services.StatusThingService, args []string) error {
+	fs := flag.NewFlagSet("import", flag.ContinueOnError)
+	mode := fs.String("mode", "merge", "merge adds and updates records. replace removes existing records first")
+	dryRun := fs.Bool("dry-run", false, "report what would change without changing anything")
+	format := fs.String("format", "", "json or yaml. defaults to the input file extension or json")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		return fmt.Errorf("usage: statusthing import [flags] <file|->")
+	}
+	input := fs.Arg(0)
+
+	importMode, ok := map[string]statusthingv1.ImportMode{
+		"merge":   statusthingv1.ImportMode_IMPORT_MODE_MERGE,
+		"replace": statusthingv1.ImportMode_IMPORT_MODE_REPLACE,
+	}[*mode]
+	if !ok {
+		return fmt.Errorf("unknown import mode %q", *mode)
+	}
+	f := dataset.FormatFromFilename(input)
+	if *format != "" {
+		pf, err := dataset.ParseFormat(*format)
+		if err != nil {
+			return err
+		}
+		f = pf
+	}
+
+	var b []byte
+	var err error
+	if input == "-" {
+		b, err = io.ReadAll(os.Stdin)
+	} else {
+		b, err = os.ReadFile(input)
+	}
+	if err != nil {
+		return err
+	}
+	ds, err := dataset.Unmarshal(b, f)
+	if err != nil {
+		return err
+	}
+	summary, err := sts.ImportData(ctx, ds, importMode, *dryRun)
+	if err != nil {
+		return err
+	}
+	if summary.DryRun {
+		fmt.Println("dry run: nothing was changed")
+	}
+	fmt.Printf("statuses: %d items: %d notes: %d dependencies: %d users: %d\n",
+		summary.Statuses, summary.Items, summary.Notes, summary.Dependencies, summary.Users)
+	if summary.RemovedItems != 0 || summary.RemovedStatuses != 0 {
+		fmt.Printf("removed items: %d removed statuses: %d\n", summary.RemovedItems, summary.RemovedStatuses)
+	}
+	for _, username := range summary.SkippedUsers {
+		fmt.Printf("skipped user %s: no password hash in the export\n", username)
+	}
+	return nil
+}
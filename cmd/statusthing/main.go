@@ -3,30 +3,63 @@ package main
 
 import (
 	"context"
+	"fmt"
+	"io"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 
 	flag "github.com/spf13/pflag"
 
 	"github.com/lusis/statusthing/internal"
+	"github.com/lusis/statusthing/internal/config"
+	"github.com/lusis/statusthing/internal/services"
+	"github.com/lusis/statusthing/internal/storers/blobdir"
 	"github.com/lusis/statusthing/internal/storers/sqlite"
 	"github.com/lusis/statusthing/migrations"
 
 	"golang.org/x/exp/slog"
 )
 
-var (
-	apiAddr *string = flag.String("api-addr", "127.0.0.1:9000", "address to serve the api")
-	devMode *bool   = flag.Bool("devmode", false, "enables grpc reflection and template reloading for development")
-)
+// serverConfigFlag names the file the server [config.Config] is loaded from
+const serverConfigFlag = "server-config"
 
 func main() {
-	logHandler := slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{})
+	cfg, err := loadConfig(os.Args[1:])
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "unable to load config: %s\n", err)
+		os.Exit(1)
+	}
+	command := flag.Arg(0)
+	logOutput := os.Stdout
+	if command != "" {
+		// keep stdout clean for exports
+		logOutput = os.Stderr
+	}
+	logHandler, err := cfg.LogHandler(logOutput)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "unable to create logger: %s\n", err)
+		os.Exit(1)
+	}
 	logger := slog.New(logHandler)
 	slog.SetDefault(logger)
-	flag.Parse()
-	db, err := migrations.MigrateDatabase(context.TODO(), "sqlite3", "statusthing.db", logHandler)
+
+	// restoring replaces the db so it has to happen before we open it
+	if command == "restore" {
+		if err := runRestore(context.TODO(), cfg.DB.DSN, flag.Args()[1:]); err != nil {
+			logger.Error("command failed", "command", command, "error", err)
+			os.Exit(1)
+		}
+		return
+	}
+	svcOpts, err := serviceOptions(cfg)
+	if err != nil {
+		logger.Error("unable to configure service", "error", err)
+		os.Exit(1)
+	}
+
+	db, err := migrations.MigrateDatabase(context.TODO(), cfg.DB.Driver, cfg.DB.DSN, logHandler)
 	if err != nil {
 		logger.Error("error migrating database", "error", err)
 		os.Exit(1)
@@ -42,11 +75,29 @@ func main() {
 		os.Exit(1)
 	}
 
-	server, err := internal.New(store, *apiAddr, logHandler, *devMode)
+	if command != "" {
+		if err := runCommand(context.TODO(), cfg, store, command, flag.Args()[1:], svcOpts...); err != nil {
+			logger.Error("command failed", "command", command, "error", err)
+			db.Close()
+			os.Exit(1)
+		}
+		return
+	}
+
+	if cfg.Seed.DefaultStatuses {
+		svcOpts = append(svcOpts, services.WithDefaults())
+	}
+	server, err := internal.New(store, cfg, logHandler, svcOpts...)
 	if err != nil {
 		slog.Error("cannot create statusthing", "error", err)
 		os.Exit(1)
 	}
+	if cfg.Seed.PageFile != "" {
+		if err := applyConfigFile(context.TODO(), server.Service(), cfg.Seed.PageFile, cfg.Seed.PagePrune); err != nil {
+			logger.Error("unable to apply config file", "file", cfg.Seed.PageFile, "error", err)
+			os.Exit(1)
+		}
+	}
 
 	sigs := make(chan os.Signal, 1)
 	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
@@ -64,3 +115,119 @@ func main() {
 	}
 	slog.Info("statusthing stopped")
 }
+
+// runCommand runs the named command against the store instead of starting the server
+func runCommand(ctx context.Context, cfg *config.Config, store *sqlite.Store, command string, args []string, opts ...services.ServiceOption) error {
+	sts, err := services.NewStatusThingService(store, opts...)
+	if err != nil {
+		return err
+	}
+	switch command {
+	case "export":
+		return runExport(ctx, sts, args)
+	case "import":
+		return runImport(ctx, sts, args)
+	case "apply":
+		return runApply(ctx, sts, args)
+	case "backup":
+		return runBackup(ctx, store, sts, args)
+	case "prune":
+		return runPrune(ctx, sts, args)
+	case "create-admin":
+		return runCreateAdmin(ctx, cfg, sts, args)
+	default:
+		return fmt.Errorf("unknown command %q. expected export, import, apply, backup, restore, prune or create-admin", command)
+	}
+}
+
+// loadConfig layers the server config file, STATUSTHING_* environment variables and then flags over the defaults
+// flags are bound to the loaded [config.Config] so their defaults show the value from the file or environment
+func loadConfig(args []string) (*config.Config, error) {
+	// the config file has to be found before the rest of the flags can be defined
+	pre := flag.NewFlagSet("statusthing", flag.ContinueOnError)
+	pre.ParseErrorsWhitelist.UnknownFlags = true
+	pre.SetInterspersed(false)
+	pre.SetOutput(io.Discard)
+	pre.Usage = func() {}
+	configPath := pre.String(serverConfigFlag, os.Getenv(config.EnvPrefix+"SERVER_CONFIG"), "")
+	_ = pre.Parse(args)
+
+	cfg := config.Default()
+	if *configPath != "" {
+		if err := cfg.LoadFile(*configPath); err != nil {
+			return nil, err
+		}
+	}
+	if err := cfg.ApplyEnv(os.LookupEnv); err != nil {
+		return nil, err
+	}
+
+	flag.String(serverConfigFlag, *configPath, "yaml, json or toml file to load the server config from. also "+config.EnvPrefix+"SERVER_CONFIG")
+	flag.StringVar(&cfg.Listen.API, "api-addr", cfg.Listen.API, "address to serve the api. also serves the admin ui unless --admin-addr or --admin-socket is set. empty disables it")
+	flag.StringVar(&cfg.Listen.Admin, "admin-addr", cfg.Listen.Admin, "address to serve the admin ui")
+	flag.StringVar(&cfg.Listen.AdminSocket, "admin-socket", cfg.Listen.AdminSocket, "unix socket to serve the admin ui on")
+	flag.StringVar(&cfg.Listen.Public, "public-addr", cfg.Listen.Public, "address to serve the read-only public status page")
+	flag.StringVar(&cfg.DB.Driver, "db-driver", cfg.DB.Driver, "database driver. only sqlite3 is supported")
+	flag.StringVar(&cfg.DB.DSN, "db-file", cfg.DB.DSN, "path to the sqlite database")
+	flag.BoolVar(&cfg.DevMode, "devmode", cfg.DevMode, "enables grpc reflection and template reloading for development")
+	flag.StringVar(&cfg.ThemeDir, "theme-dir", cfg.ThemeDir, "directory of templates and static files to use in place of the built-in ones")
+	flag.StringVar(&cfg.TLS.CertFile, "tls-cert-file", cfg.TLS.CertFile, "pem encoded certificate to serve tls with")
+	flag.StringVar(&cfg.TLS.KeyFile, "tls-key-file", cfg.TLS.KeyFile, "pem encoded private key for --tls-cert-file")
+	flag.DurationVar(&cfg.TLS.ReloadInterval, "tls-reload-interval", cfg.TLS.ReloadInterval, "how often to check --tls-cert-file and --tls-key-file for changes. 0 disables reloading")
+	flag.StringVar(&cfg.TLS.ClientAuth, "tls-client-auth", cfg.TLS.ClientAuth, "client certificates: none, optional or require")
+	flag.StringVar(&cfg.TLS.ClientCAFile, "tls-client-ca-file", cfg.TLS.ClientCAFile, "pem encoded certificate authorities that sign client certificates")
+	flag.BoolVar(&cfg.Listen.H2C, "h2c", cfg.Listen.H2C, "serve plaintext http/2 for deployments behind a proxy. can't be used with tls")
+	flag.StringVar(&cfg.Log.Level, "log-level", cfg.Log.Level, "minimum log level: debug, info, warn or error")
+	flag.StringVar(&cfg.Log.Format, "log-format", cfg.Log.Format, "log format: json or text")
+	flag.BoolVar(&cfg.Seed.DefaultStatuses, "seed-default-statuses", cfg.Seed.DefaultStatuses, "create a default set of statuses at startup if there are none")
+	flag.StringVar(&cfg.Seed.PageFile, "config-file", cfg.Seed.PageFile, "page config file of statuses and items to apply at startup")
+	flag.BoolVar(&cfg.Seed.PagePrune, "config-prune", cfg.Seed.PagePrune, "delete statuses and items that aren't in the config file at startup")
+	flag.DurationVar(&cfg.IdempotencyTTL, "idempotency-ttl", cfg.IdempotencyTTL, "how long idempotency keys are remembered")
+	flag.StringVar(&cfg.Backup.Dir, "backup-dir", cfg.Backup.Dir, "directory to write backups to")
+	flag.DurationVar(&cfg.Backup.Interval, "backup-interval", cfg.Backup.Interval, "how often to write a backup to --backup-dir. 0 disables periodic backups")
+	flag.IntVar(&cfg.Backup.Retention, "backup-retention", cfg.Backup.Retention, "how many backups to keep in --backup-dir")
+	flag.DurationVar(&cfg.Retention.NoteMaxAge, "note-max-age", cfg.Retention.NoteMaxAge, "prune notes older than this. 0 keeps notes forever")
+	flag.IntVar(&cfg.Retention.NoteMaxCount, "note-max-count", cfg.Retention.NoteMaxCount, "prune all but this many of the newest unpinned notes on each item. 0 keeps every note")
+	flag.DurationVar(&cfg.Retention.PruneInterval, "prune-interval", cfg.Retention.PruneInterval, "how often to prune data. 0 disables the pruner")
+	flag.StringVar(&cfg.Attachments.Dir, "attachment-dir", cfg.Attachments.Dir, "directory to keep note attachments in. empty keeps them in the database")
+	flag.IntVar(&cfg.Attachments.MaxSize, "attachment-max-size", cfg.Attachments.MaxSize, "largest note attachment in bytes")
+	// flags after a command belong to the command
+	flag.CommandLine.SetInterspersed(false)
+	flag.Usage = usage
+	flag.Parse()
+
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// serviceOptions returns the [services.ServiceOption] for the provided config
+func serviceOptions(cfg *config.Config) ([]services.ServiceOption, error) {
+	opts := []services.ServiceOption{
+		services.WithIdempotencyTTL(cfg.IdempotencyTTL),
+		services.WithBackupRetention(cfg.Backup.Retention),
+		services.WithRetentionPolicy(services.RetentionPolicy{NoteMaxAge: cfg.Retention.NoteMaxAge, NoteMaxCount: cfg.Retention.NoteMaxCount}),
+		services.WithAttachmentLimits(int64(cfg.Attachments.MaxSize), cfg.Attachments.Types...),
+	}
+	if cfg.Retention.PruneInterval > 0 {
+		opts = append(opts, services.WithPruneInterval(cfg.Retention.PruneInterval))
+	}
+	if cfg.Backup.Dir != "" {
+		opts = append(opts, services.WithBackupDir(cfg.Backup.Dir))
+	}
+	if cfg.Attachments.Dir != "" {
+		blobs, err := blobdir.New(cfg.Attachments.Dir)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, services.WithBlobStorer(blobs))
+	}
+	return opts, nil
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "usage: statusthing [flags] [export|import|apply|backup|restore|prune|create-admin] [args]\n\nflags:\n")
+	flag.PrintDefaults()
+	fmt.Fprintf(os.Stderr, "\nevery setting can also be set in --%s or with these environment variables:\n  %s\n", serverConfigFlag, strings.Join(config.EnvVars(), "\n  "))
+}
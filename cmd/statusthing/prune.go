@@ -0,0 +1,31 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	flag "github.com/spf13/pflag"
+
+	"github.com/lusis/statusthing/internal/services"
+)
+
+// runPrune removes data according to the retention flags
+func runPrune(ctx context.Context, sts *services.StatusThingService, args []string) error {
+	fs := flag.NewFlagSet("prune", flag.ContinueOnError)
+	dryRun := fs.Bool("dry-run", false, "report what would be removed without removing anything")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	summary, err := sts.Prune(ctx, *dryRun)
+	if err != nil {
+		return err
+	}
+	if summary.DryRun {
+		fmt.Println("dry run: nothing was removed")
+	}
+	for _, id := range summary.NoteIDs {
+		fmt.Printf("note %s\n", id)
+	}
+	fmt.Printf("notes: %d idempotency keys: %d sessions: %d attachment blobs: %d items with open incidents: %d\n", len(summary.NoteIDs), summary.IdempotencyKeys, summary.Sessions, summary.Blobs, len(summary.SkippedItemIDs))
+	return nil
+}
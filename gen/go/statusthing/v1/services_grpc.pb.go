@@ -19,11 +19,16 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	ItemsService_GetItem_FullMethodName    = "/statusthing.v1.ItemsService/GetItem"
-	ItemsService_ListItems_FullMethodName  = "/statusthing.v1.ItemsService/ListItems"
-	ItemsService_AddItem_FullMethodName    = "/statusthing.v1.ItemsService/AddItem"
-	ItemsService_UpdateItem_FullMethodName = "/statusthing.v1.ItemsService/UpdateItem"
-	ItemsService_DeleteItem_FullMethodName = "/statusthing.v1.ItemsService/DeleteItem"
+	ItemsService_GetItem_FullMethodName              = "/statusthing.v1.ItemsService/GetItem"
+	ItemsService_ListItems_FullMethodName            = "/statusthing.v1.ItemsService/ListItems"
+	ItemsService_AddItem_FullMethodName              = "/statusthing.v1.ItemsService/AddItem"
+	ItemsService_UpdateItem_FullMethodName           = "/statusthing.v1.ItemsService/UpdateItem"
+	ItemsService_DeleteItem_FullMethodName           = "/statusthing.v1.ItemsService/DeleteItem"
+	ItemsService_BatchUpdateItems_FullMethodName     = "/statusthing.v1.ItemsService/BatchUpdateItems"
+	ItemsService_BatchDeleteItems_FullMethodName     = "/statusthing.v1.ItemsService/BatchDeleteItems"
+	ItemsService_AddItemDependency_FullMethodName    = "/statusthing.v1.ItemsService/AddItemDependency"
+	ItemsService_RemoveItemDependency_FullMethodName = "/statusthing.v1.ItemsService/RemoveItemDependency"
+	ItemsService_GetItemImpact_FullMethodName        = "/statusthing.v1.ItemsService/GetItemImpact"
 )
 
 // ItemsServiceClient is the client API for ItemsService service.
@@ -40,6 +45,18 @@ type ItemsServiceClient interface {
 	UpdateItem(ctx context.Context, in *UpdateItemRequest, opts ...grpc.CallOption) (*UpdateItemResponse, error)
 	// DeleteItem deletes an exisiting Item
 	DeleteItem(ctx context.Context, in *DeleteItemRequest, opts ...grpc.CallOption) (*DeleteItemResponse, error)
+	// BatchUpdateItems sets the status of and optionally adds the same note to many Items in a single transaction
+	// no Items are changed if any Item fails. the error for each failed Item is returned as a BatchItemError detail
+	BatchUpdateItems(ctx context.Context, in *BatchUpdateItemsRequest, opts ...grpc.CallOption) (*BatchUpdateItemsResponse, error)
+	// BatchDeleteItems deletes many Items in a single transaction
+	// no Items are deleted if any Item fails. the error for each failed Item is returned as a BatchItemError detail
+	BatchDeleteItems(ctx context.Context, in *BatchDeleteItemsRequest, opts ...grpc.CallOption) (*BatchDeleteItemsResponse, error)
+	// AddItemDependency declares that an Item depends on another Item
+	AddItemDependency(ctx context.Context, in *AddItemDependencyRequest, opts ...grpc.CallOption) (*AddItemDependencyResponse, error)
+	// RemoveItemDependency removes a dependency between two Items
+	RemoveItemDependency(ctx context.Context, in *RemoveItemDependencyRequest, opts ...grpc.CallOption) (*RemoveItemDependencyResponse, error)
+	// GetItemImpact gets the effective status of an Item along with the Items it impacts and the dependencies degrading it
+	GetItemImpact(ctx context.Context, in *GetItemImpactRequest, opts ...grpc.CallOption) (*GetItemImpactResponse, error)
 }
 
 type itemsServiceClient struct {
@@ -95,6 +112,51 @@ func (c *itemsServiceClient) DeleteItem(ctx context.Context, in *DeleteItemReque
 	return out, nil
 }
 
+func (c *itemsServiceClient) BatchUpdateItems(ctx context.Context, in *BatchUpdateItemsRequest, opts ...grpc.CallOption) (*BatchUpdateItemsResponse, error) {
+	out := new(BatchUpdateItemsResponse)
+	err := c.cc.Invoke(ctx, ItemsService_BatchUpdateItems_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itemsServiceClient) BatchDeleteItems(ctx context.Context, in *BatchDeleteItemsRequest, opts ...grpc.CallOption) (*BatchDeleteItemsResponse, error) {
+	out := new(BatchDeleteItemsResponse)
+	err := c.cc.Invoke(ctx, ItemsService_BatchDeleteItems_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itemsServiceClient) AddItemDependency(ctx context.Context, in *AddItemDependencyRequest, opts ...grpc.CallOption) (*AddItemDependencyResponse, error) {
+	out := new(AddItemDependencyResponse)
+	err := c.cc.Invoke(ctx, ItemsService_AddItemDependency_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itemsServiceClient) RemoveItemDependency(ctx context.Context, in *RemoveItemDependencyRequest, opts ...grpc.CallOption) (*RemoveItemDependencyResponse, error) {
+	out := new(RemoveItemDependencyResponse)
+	err := c.cc.Invoke(ctx, ItemsService_RemoveItemDependency_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itemsServiceClient) GetItemImpact(ctx context.Context, in *GetItemImpactRequest, opts ...grpc.CallOption) (*GetItemImpactResponse, error) {
+	out := new(GetItemImpactResponse)
+	err := c.cc.Invoke(ctx, ItemsService_GetItemImpact_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ItemsServiceServer is the server API for ItemsService service.
 // All implementations must embed UnimplementedItemsServiceServer
 // for forward compatibility
@@ -109,6 +171,18 @@ type ItemsServiceServer interface {
 	UpdateItem(context.Context, *UpdateItemRequest) (*UpdateItemResponse, error)
 	// DeleteItem deletes an exisiting Item
 	DeleteItem(context.Context, *DeleteItemRequest) (*DeleteItemResponse, error)
+	// BatchUpdateItems sets the status of and optionally adds the same note to many Items in a single transaction
+	// no Items are changed if any Item fails. the error for each failed Item is returned as a BatchItemError detail
+	BatchUpdateItems(context.Context, *BatchUpdateItemsRequest) (*BatchUpdateItemsResponse, error)
+	// BatchDeleteItems deletes many Items in a single transaction
+	// no Items are deleted if any Item fails. the error for each failed Item is returned as a BatchItemError detail
+	BatchDeleteItems(context.Context, *BatchDeleteItemsRequest) (*BatchDeleteItemsResponse, error)
+	// AddItemDependency declares that an Item depends on another Item
+	AddItemDependency(context.Context, *AddItemDependencyRequest) (*AddItemDependencyResponse, error)
+	// RemoveItemDependency removes a dependency between two Items
+	RemoveItemDependency(context.Context, *RemoveItemDependencyRequest) (*RemoveItemDependencyResponse, error)
+	// GetItemImpact gets the effective status of an Item along with the Items it impacts and the dependencies degrading it
+	GetItemImpact(context.Context, *GetItemImpactRequest) (*GetItemImpactResponse, error)
 	mustEmbedUnimplementedItemsServiceServer()
 }
 
@@ -131,6 +205,21 @@ func (UnimplementedItemsServiceServer) UpdateItem(context.Context, *UpdateItemRe
 func (UnimplementedItemsServiceServer) DeleteItem(context.Context, *DeleteItemRequest) (*DeleteItemResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteItem not implemented")
 }
+func (UnimplementedItemsServiceServer) BatchUpdateItems(context.Context, *BatchUpdateItemsRequest) (*BatchUpdateItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdateItems not implemented")
+}
+func (UnimplementedItemsServiceServer) BatchDeleteItems(context.Context, *BatchDeleteItemsRequest) (*BatchDeleteItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchDeleteItems not implemented")
+}
+func (UnimplementedItemsServiceServer) AddItemDependency(context.Context, *AddItemDependencyRequest) (*AddItemDependencyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddItemDependency not implemented")
+}
+func (UnimplementedItemsServiceServer) RemoveItemDependency(context.Context, *RemoveItemDependencyRequest) (*RemoveItemDependencyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveItemDependency not implemented")
+}
+func (UnimplementedItemsServiceServer) GetItemImpact(context.Context, *GetItemImpactRequest) (*GetItemImpactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetItemImpact not implemented")
+}
 func (UnimplementedItemsServiceServer) mustEmbedUnimplementedItemsServiceServer() {}
 
 // UnsafeItemsServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -234,6 +323,96 @@ func _ItemsService_DeleteItem_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ItemsService_BatchUpdateItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdateItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItemsServiceServer).BatchUpdateItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ItemsService_BatchUpdateItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItemsServiceServer).BatchUpdateItems(ctx, req.(*BatchUpdateItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItemsService_BatchDeleteItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItemsServiceServer).BatchDeleteItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ItemsService_BatchDeleteItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItemsServiceServer).BatchDeleteItems(ctx, req.(*BatchDeleteItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItemsService_AddItemDependency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddItemDependencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItemsServiceServer).AddItemDependency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ItemsService_AddItemDependency_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItemsServiceServer).AddItemDependency(ctx, req.(*AddItemDependencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItemsService_RemoveItemDependency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveItemDependencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItemsServiceServer).RemoveItemDependency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ItemsService_RemoveItemDependency_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItemsServiceServer).RemoveItemDependency(ctx, req.(*RemoveItemDependencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItemsService_GetItemImpact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetItemImpactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItemsServiceServer).GetItemImpact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ItemsService_GetItemImpact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItemsServiceServer).GetItemImpact(ctx, req.(*GetItemImpactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ItemsService_ServiceDesc is the grpc.ServiceDesc for ItemsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -261,6 +440,26 @@ var ItemsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteItem",
 			Handler:    _ItemsService_DeleteItem_Handler,
 		},
+		{
+			MethodName: "BatchUpdateItems",
+			Handler:    _ItemsService_BatchUpdateItems_Handler,
+		},
+		{
+			MethodName: "BatchDeleteItems",
+			Handler:    _ItemsService_BatchDeleteItems_Handler,
+		},
+		{
+			MethodName: "AddItemDependency",
+			Handler:    _ItemsService_AddItemDependency_Handler,
+		},
+		{
+			MethodName: "RemoveItemDependency",
+			Handler:    _ItemsService_RemoveItemDependency_Handler,
+		},
+		{
+			MethodName: "GetItemImpact",
+			Handler:    _ItemsService_GetItemImpact_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "statusthing/v1/services.proto",
@@ -515,11 +714,16 @@ var StatusService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	NotesService_GetNote_FullMethodName    = "/statusthing.v1.NotesService/GetNote"
-	NotesService_ListNotes_FullMethodName  = "/statusthing.v1.NotesService/ListNotes"
-	NotesService_AddNote_FullMethodName    = "/statusthing.v1.NotesService/AddNote"
-	NotesService_UpdateNote_FullMethodName = "/statusthing.v1.NotesService/UpdateNote"
-	NotesService_DeleteNote_FullMethodName = "/statusthing.v1.NotesService/DeleteNote"
+	NotesService_GetNote_FullMethodName            = "/statusthing.v1.NotesService/GetNote"
+	NotesService_ListNotes_FullMethodName          = "/statusthing.v1.NotesService/ListNotes"
+	NotesService_AddNote_FullMethodName            = "/statusthing.v1.NotesService/AddNote"
+	NotesService_UpdateNote_FullMethodName         = "/statusthing.v1.NotesService/UpdateNote"
+	NotesService_DeleteNote_FullMethodName         = "/statusthing.v1.NotesService/DeleteNote"
+	NotesService_ListNoteRevisions_FullMethodName  = "/statusthing.v1.NotesService/ListNoteRevisions"
+	NotesService_UploadAttachment_FullMethodName   = "/statusthing.v1.NotesService/UploadAttachment"
+	NotesService_ListAttachments_FullMethodName    = "/statusthing.v1.NotesService/ListAttachments"
+	NotesService_DownloadAttachment_FullMethodName = "/statusthing.v1.NotesService/DownloadAttachment"
+	NotesService_DeleteAttachment_FullMethodName   = "/statusthing.v1.NotesService/DeleteAttachment"
 )
 
 // NotesServiceClient is the client API for NotesService service.
@@ -536,6 +740,18 @@ type NotesServiceClient interface {
 	UpdateNote(ctx context.Context, in *UpdateNoteRequest, opts ...grpc.CallOption) (*UpdateNoteResponse, error)
 	// DeleteNote deletes a Note from an Item
 	DeleteNote(ctx context.Context, in *DeleteNoteRequest, opts ...grpc.CallOption) (*DeleteNoteResponse, error)
+	// ListNoteRevisions gets every earlier version of a Note
+	ListNoteRevisions(ctx context.Context, in *ListNoteRevisionsRequest, opts ...grpc.CallOption) (*ListNoteRevisionsResponse, error)
+	// UploadAttachment attaches a file to a Note
+	// the first message carries the AttachmentInfo and every following message a chunk of the file
+	UploadAttachment(ctx context.Context, opts ...grpc.CallOption) (NotesService_UploadAttachmentClient, error)
+	// ListAttachments gets all Attachment of a Note
+	ListAttachments(ctx context.Context, in *ListAttachmentsRequest, opts ...grpc.CallOption) (*ListAttachmentsResponse, error)
+	// DownloadAttachment gets the contents of an Attachment
+	// the first message carries the Attachment and every following message a chunk of the file
+	DownloadAttachment(ctx context.Context, in *DownloadAttachmentRequest, opts ...grpc.CallOption) (NotesService_DownloadAttachmentClient, error)
+	// DeleteAttachment deletes an Attachment
+	DeleteAttachment(ctx context.Context, in *DeleteAttachmentRequest, opts ...grpc.CallOption) (*DeleteAttachmentResponse, error)
 }
 
 type notesServiceClient struct {
@@ -591,6 +807,99 @@ func (c *notesServiceClient) DeleteNote(ctx context.Context, in *DeleteNoteReque
 	return out, nil
 }
 
+func (c *notesServiceClient) ListNoteRevisions(ctx context.Context, in *ListNoteRevisionsRequest, opts ...grpc.CallOption) (*ListNoteRevisionsResponse, error) {
+	out := new(ListNoteRevisionsResponse)
+	err := c.cc.Invoke(ctx, NotesService_ListNoteRevisions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notesServiceClient) UploadAttachment(ctx context.Context, opts ...grpc.CallOption) (NotesService_UploadAttachmentClient, error) {
+	stream, err := c.cc.NewStream(ctx, &NotesService_ServiceDesc.Streams[0], NotesService_UploadAttachment_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &notesServiceUploadAttachmentClient{stream}
+	return x, nil
+}
+
+type NotesService_UploadAttachmentClient interface {
+	Send(*UploadAttachmentRequest) error
+	CloseAndRecv() (*UploadAttachmentResponse, error)
+	grpc.ClientStream
+}
+
+type notesServiceUploadAttachmentClient struct {
+	grpc.ClientStream
+}
+
+func (x *notesServiceUploadAttachmentClient) Send(m *UploadAttachmentRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *notesServiceUploadAttachmentClient) CloseAndRecv() (*UploadAttachmentResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(UploadAttachmentResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *notesServiceClient) ListAttachments(ctx context.Context, in *ListAttachmentsRequest, opts ...grpc.CallOption) (*ListAttachmentsResponse, error) {
+	out := new(ListAttachmentsResponse)
+	err := c.cc.Invoke(ctx, NotesService_ListAttachments_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notesServiceClient) DownloadAttachment(ctx context.Context, in *DownloadAttachmentRequest, opts ...grpc.CallOption) (NotesService_DownloadAttachmentClient, error) {
+	stream, err := c.cc.NewStream(ctx, &NotesService_ServiceDesc.Streams[1], NotesService_DownloadAttachment_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &notesServiceDownloadAttachmentClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type NotesService_DownloadAttachmentClient interface {
+	Recv() (*DownloadAttachmentResponse, error)
+	grpc.ClientStream
+}
+
+type notesServiceDownloadAttachmentClient struct {
+	grpc.ClientStream
+}
+
+func (x *notesServiceDownloadAttachmentClient) Recv() (*DownloadAttachmentResponse, error) {
+	m := new(DownloadAttachmentResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *notesServiceClient) DeleteAttachment(ctx context.Context, in *DeleteAttachmentRequest, opts ...grpc.CallOption) (*DeleteAttachmentResponse, error) {
+	out := new(DeleteAttachmentResponse)
+	err := c.cc.Invoke(ctx, NotesService_DeleteAttachment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NotesServiceServer is the server API for NotesService service.
 // All implementations must embed UnimplementedNotesServiceServer
 // for forward compatibility
@@ -605,6 +914,18 @@ type NotesServiceServer interface {
 	UpdateNote(context.Context, *UpdateNoteRequest) (*UpdateNoteResponse, error)
 	// DeleteNote deletes a Note from an Item
 	DeleteNote(context.Context, *DeleteNoteRequest) (*DeleteNoteResponse, error)
+	// ListNoteRevisions gets every earlier version of a Note
+	ListNoteRevisions(context.Context, *ListNoteRevisionsRequest) (*ListNoteRevisionsResponse, error)
+	// UploadAttachment attaches a file to a Note
+	// the first message carries the AttachmentInfo and every following message a chunk of the file
+	UploadAttachment(NotesService_UploadAttachmentServer) error
+	// ListAttachments gets all Attachment of a Note
+	ListAttachments(context.Context, *ListAttachmentsRequest) (*ListAttachmentsResponse, error)
+	// DownloadAttachment gets the contents of an Attachment
+	// the first message carries the Attachment and every following message a chunk of the file
+	DownloadAttachment(*DownloadAttachmentRequest, NotesService_DownloadAttachmentServer) error
+	// DeleteAttachment deletes an Attachment
+	DeleteAttachment(context.Context, *DeleteAttachmentRequest) (*DeleteAttachmentResponse, error)
 	mustEmbedUnimplementedNotesServiceServer()
 }
 
@@ -627,6 +948,21 @@ func (UnimplementedNotesServiceServer) UpdateNote(context.Context, *UpdateNoteRe
 func (UnimplementedNotesServiceServer) DeleteNote(context.Context, *DeleteNoteRequest) (*DeleteNoteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteNote not implemented")
 }
+func (UnimplementedNotesServiceServer) ListNoteRevisions(context.Context, *ListNoteRevisionsRequest) (*ListNoteRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNoteRevisions not implemented")
+}
+func (UnimplementedNotesServiceServer) UploadAttachment(NotesService_UploadAttachmentServer) error {
+	return status.Errorf(codes.Unimplemented, "method UploadAttachment not implemented")
+}
+func (UnimplementedNotesServiceServer) ListAttachments(context.Context, *ListAttachmentsRequest) (*ListAttachmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAttachments not implemented")
+}
+func (UnimplementedNotesServiceServer) DownloadAttachment(*DownloadAttachmentRequest, NotesService_DownloadAttachmentServer) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadAttachment not implemented")
+}
+func (UnimplementedNotesServiceServer) DeleteAttachment(context.Context, *DeleteAttachmentRequest) (*DeleteAttachmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAttachment not implemented")
+}
 func (UnimplementedNotesServiceServer) mustEmbedUnimplementedNotesServiceServer() {}
 
 // UnsafeNotesServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -730,6 +1066,107 @@ func _NotesService_DeleteNote_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NotesService_ListNoteRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNoteRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotesServiceServer).ListNoteRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotesService_ListNoteRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotesServiceServer).ListNoteRevisions(ctx, req.(*ListNoteRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotesService_UploadAttachment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(NotesServiceServer).UploadAttachment(&notesServiceUploadAttachmentServer{stream})
+}
+
+type NotesService_UploadAttachmentServer interface {
+	SendAndClose(*UploadAttachmentResponse) error
+	Recv() (*UploadAttachmentRequest, error)
+	grpc.ServerStream
+}
+
+type notesServiceUploadAttachmentServer struct {
+	grpc.ServerStream
+}
+
+func (x *notesServiceUploadAttachmentServer) SendAndClose(m *UploadAttachmentResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *notesServiceUploadAttachmentServer) Recv() (*UploadAttachmentRequest, error) {
+	m := new(UploadAttachmentRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _NotesService_ListAttachments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAttachmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotesServiceServer).ListAttachments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotesService_ListAttachments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotesServiceServer).ListAttachments(ctx, req.(*ListAttachmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotesService_DownloadAttachment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadAttachmentRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NotesServiceServer).DownloadAttachment(m, &notesServiceDownloadAttachmentServer{stream})
+}
+
+type NotesService_DownloadAttachmentServer interface {
+	Send(*DownloadAttachmentResponse) error
+	grpc.ServerStream
+}
+
+type notesServiceDownloadAttachmentServer struct {
+	grpc.ServerStream
+}
+
+func (x *notesServiceDownloadAttachmentServer) Send(m *DownloadAttachmentResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _NotesService_DeleteAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAttachmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotesServiceServer).DeleteAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotesService_DeleteAttachment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotesServiceServer).DeleteAttachment(ctx, req.(*DeleteAttachmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // NotesService_ServiceDesc is the grpc.ServiceDesc for NotesService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -757,6 +1194,379 @@ var NotesService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteNote",
 			Handler:    _NotesService_DeleteNote_Handler,
 		},
+		{
+			MethodName: "ListNoteRevisions",
+			Handler:    _NotesService_ListNoteRevisions_Handler,
+		},
+		{
+			MethodName: "ListAttachments",
+			Handler:    _NotesService_ListAttachments_Handler,
+		},
+		{
+			MethodName: "DeleteAttachment",
+			Handler:    _NotesService_DeleteAttachment_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "UploadAttachment",
+			Handler:       _NotesService_UploadAttachment_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DownloadAttachment",
+			Handler:       _NotesService_DownloadAttachment_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "statusthing/v1/services.proto",
+}
+
+const (
+	DataService_ExportData_FullMethodName = "/statusthing.v1.DataService/ExportData"
+	DataService_ImportData_FullMethodName = "/statusthing.v1.DataService/ImportData"
+	DataService_BackupData_FullMethodName = "/statusthing.v1.DataService/BackupData"
+	DataService_PruneData_FullMethodName  = "/statusthing.v1.DataService/PruneData"
+)
+
+// DataServiceClient is the client API for DataService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DataServiceClient interface {
+	// ExportData exports all data except users
+	// only authenticated callers can export
+	ExportData(ctx context.Context, in *ExportDataRequest, opts ...grpc.CallOption) (*ExportDataResponse, error)
+	// ImportData imports previously exported data. datasets with users are rejected
+	// only authenticated callers can import
+	ImportData(ctx context.Context, in *ImportDataRequest, opts ...grpc.CallOption) (*ImportDataResponse, error)
+	// BackupData writes a consistent copy of the database to the server's backup directory
+	// only authenticated callers can write backups
+	BackupData(ctx context.Context, in *BackupDataRequest, opts ...grpc.CallOption) (*BackupDataResponse, error)
+	// PruneData removes data according to the server's retention policy
+	// only authenticated callers can prune
+	PruneData(ctx context.Context, in *PruneDataRequest, opts ...grpc.CallOption) (*PruneDataResponse, error)
+}
+
+type dataServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDataServiceClient(cc grpc.ClientConnInterface) DataServiceClient {
+	return &dataServiceClient{cc}
+}
+
+func (c *dataServiceClient) ExportData(ctx context.Context, in *ExportDataRequest, opts ...grpc.CallOption) (*ExportDataResponse, error) {
+	out := new(ExportDataResponse)
+	err := c.cc.Invoke(ctx, DataService_ExportData_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataServiceClient) ImportData(ctx context.Context, in *ImportDataRequest, opts ...grpc.CallOption) (*ImportDataResponse, error) {
+	out := new(ImportDataResponse)
+	err := c.cc.Invoke(ctx, DataService_ImportData_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataServiceClient) BackupData(ctx context.Context, in *BackupDataRequest, opts ...grpc.CallOption) (*BackupDataResponse, error) {
+	out := new(BackupDataResponse)
+	err := c.cc.Invoke(ctx, DataService_BackupData_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataServiceClient) PruneData(ctx context.Context, in *PruneDataRequest, opts ...grpc.CallOption) (*PruneDataResponse, error) {
+	out := new(PruneDataResponse)
+	err := c.cc.Invoke(ctx, DataService_PruneData_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DataServiceServer is the server API for DataService service.
+// All implementations must embed UnimplementedDataServiceServer
+// for forward compatibility
+type DataServiceServer interface {
+	// ExportData exports all data except users
+	// only authenticated callers can export
+	ExportData(context.Context, *ExportDataRequest) (*ExportDataResponse, error)
+	// ImportData imports previously exported data. datasets with users are rejected
+	// only authenticated callers can import
+	ImportData(context.Context, *ImportDataRequest) (*ImportDataResponse, error)
+	// BackupData writes a consistent copy of the database to the server's backup directory
+	// only authenticated callers can write backups
+	BackupData(context.Context, *BackupDataRequest) (*BackupDataResponse, error)
+	// PruneData removes data according to the server's retention policy
+	// only authenticated callers can prune
+	PruneData(context.Context, *PruneDataRequest) (*PruneDataResponse, error)
+	mustEmbedUnimplementedDataServiceServer()
+}
+
+// UnimplementedDataServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedDataServiceServer struct {
+}
+
+func (UnimplementedDataServiceServer) ExportData(context.Context, *ExportDataRequest) (*ExportDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportData not implemented")
+}
+func (UnimplementedDataServiceServer) ImportData(context.Context, *ImportDataRequest) (*ImportDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportData not implemented")
+}
+func (UnimplementedDataServiceServer) BackupData(context.Context, *BackupDataRequest) (*BackupDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BackupData not implemented")
+}
+func (UnimplementedDataServiceServer) PruneData(context.Context, *PruneDataRequest) (*PruneDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PruneData not implemented")
+}
+func (UnimplementedDataServiceServer) mustEmbedUnimplementedDataServiceServer() {}
+
+// UnsafeDataServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DataServiceServer will
+// result in compilation errors.
+type UnsafeDataServiceServer interface {
+	mustEmbedUnimplementedDataServiceServer()
+}
+
+func RegisterDataServiceServer(s grpc.ServiceRegistrar, srv DataServiceServer) {
+	s.RegisterService(&DataService_ServiceDesc, srv)
+}
+
+func _DataService_ExportData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServiceServer).ExportData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataService_ExportData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServiceServer).ExportData(ctx, req.(*ExportDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataService_ImportData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServiceServer).ImportData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataService_ImportData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServiceServer).ImportData(ctx, req.(*ImportDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataService_BackupData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackupDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServiceServer).BackupData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataService_BackupData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServiceServer).BackupData(ctx, req.(*BackupDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataService_PruneData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PruneDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServiceServer).PruneData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataService_PruneData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServiceServer).PruneData(ctx, req.(*PruneDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DataService_ServiceDesc is the grpc.ServiceDesc for DataService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DataService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "statusthing.v1.DataService",
+	HandlerType: (*DataServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ExportData",
+			Handler:    _DataService_ExportData_Handler,
+		},
+		{
+			MethodName: "ImportData",
+			Handler:    _DataService_ImportData_Handler,
+		},
+		{
+			MethodName: "BackupData",
+			Handler:    _DataService_BackupData_Handler,
+		},
+		{
+			MethodName: "PruneData",
+			Handler:    _DataService_PruneData_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "statusthing/v1/services.proto",
+}
+
+const (
+	SettingsService_GetSettings_FullMethodName    = "/statusthing.v1.SettingsService/GetSettings"
+	SettingsService_UpdateSettings_FullMethodName = "/statusthing.v1.SettingsService/UpdateSettings"
+)
+
+// SettingsServiceClient is the client API for SettingsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SettingsServiceClient interface {
+	// GetSettings gets the site Settings
+	GetSettings(ctx context.Context, in *GetSettingsRequest, opts ...grpc.CallOption) (*GetSettingsResponse, error)
+	// UpdateSettings changes the site Settings
+	// only authenticated callers can change settings
+	UpdateSettings(ctx context.Context, in *UpdateSettingsRequest, opts ...grpc.CallOption) (*UpdateSettingsResponse, error)
+}
+
+type settingsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSettingsServiceClient(cc grpc.ClientConnInterface) SettingsServiceClient {
+	return &settingsServiceClient{cc}
+}
+
+func (c *settingsServiceClient) GetSettings(ctx context.Context, in *GetSettingsRequest, opts ...grpc.CallOption) (*GetSettingsResponse, error) {
+	out := new(GetSettingsResponse)
+	err := c.cc.Invoke(ctx, SettingsService_GetSettings_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *settingsServiceClient) UpdateSettings(ctx context.Context, in *UpdateSettingsRequest, opts ...grpc.CallOption) (*UpdateSettingsResponse, error) {
+	out := new(UpdateSettingsResponse)
+	err := c.cc.Invoke(ctx, SettingsService_UpdateSettings_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SettingsServiceServer is the server API for SettingsService service.
+// All implementations must embed UnimplementedSettingsServiceServer
+// for forward compatibility
+type SettingsServiceServer interface {
+	// GetSettings gets the site Settings
+	GetSettings(context.Context, *GetSettingsRequest) (*GetSettingsResponse, error)
+	// UpdateSettings changes the site Settings
+	// only authenticated callers can change settings
+	UpdateSettings(context.Context, *UpdateSettingsRequest) (*UpdateSettingsResponse, error)
+	mustEmbedUnimplementedSettingsServiceServer()
+}
+
+// UnimplementedSettingsServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedSettingsServiceServer struct {
+}
+
+func (UnimplementedSettingsServiceServer) GetSettings(context.Context, *GetSettingsRequest) (*GetSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSettings not implemented")
+}
+func (UnimplementedSettingsServiceServer) UpdateSettings(context.Context, *UpdateSettingsRequest) (*UpdateSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSettings not implemented")
+}
+func (UnimplementedSettingsServiceServer) mustEmbedUnimplementedSettingsServiceServer() {}
+
+// UnsafeSettingsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SettingsServiceServer will
+// result in compilation errors.
+type UnsafeSettingsServiceServer interface {
+	mustEmbedUnimplementedSettingsServiceServer()
+}
+
+func RegisterSettingsServiceServer(s grpc.ServiceRegistrar, srv SettingsServiceServer) {
+	s.RegisterService(&SettingsService_ServiceDesc, srv)
+}
+
+func _SettingsService_GetSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SettingsServiceServer).GetSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SettingsService_GetSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SettingsServiceServer).GetSettings(ctx, req.(*GetSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SettingsService_UpdateSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SettingsServiceServer).UpdateSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SettingsService_UpdateSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SettingsServiceServer).UpdateSettings(ctx, req.(*UpdateSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SettingsService_ServiceDesc is the grpc.ServiceDesc for SettingsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SettingsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "statusthing.v1.SettingsService",
+	HandlerType: (*SettingsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetSettings",
+			Handler:    _SettingsService_GetSettings_Handler,
+		},
+		{
+			MethodName: "UpdateSettings",
+			Handler:    _SettingsService_UpdateSettings_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "statusthing/v1/services.proto",
@@ -27,6 +27,10 @@ const (
 	StatusServiceName = "statusthing.v1.StatusService"
 	// NotesServiceName is the fully-qualified name of the NotesService service.
 	NotesServiceName = "statusthing.v1.NotesService"
+	// DataServiceName is the fully-qualified name of the DataService service.
+	DataServiceName = "statusthing.v1.DataService"
+	// SettingsServiceName is the fully-qualified name of the SettingsService service.
+	SettingsServiceName = "statusthing.v1.SettingsService"
 )
 
 // These constants are the fully-qualified names of the RPCs defined in this package. They're
@@ -47,6 +51,21 @@ const (
 	ItemsServiceUpdateItemProcedure = "/statusthing.v1.ItemsService/UpdateItem"
 	// ItemsServiceDeleteItemProcedure is the fully-qualified name of the ItemsService's DeleteItem RPC.
 	ItemsServiceDeleteItemProcedure = "/statusthing.v1.ItemsService/DeleteItem"
+	// ItemsServiceBatchUpdateItemsProcedure is the fully-qualified name of the ItemsService's
+	// BatchUpdateItems RPC.
+	ItemsServiceBatchUpdateItemsProcedure = "/statusthing.v1.ItemsService/BatchUpdateItems"
+	// ItemsServiceBatchDeleteItemsProcedure is the fully-qualified name of the ItemsService's
+	// BatchDeleteItems RPC.
+	ItemsServiceBatchDeleteItemsProcedure = "/statusthing.v1.ItemsService/BatchDeleteItems"
+	// ItemsServiceAddItemDependencyProcedure is the fully-qualified name of the ItemsService's
+	// AddItemDependency RPC.
+	ItemsServiceAddItemDependencyProcedure = "/statusthing.v1.ItemsService/AddItemDependency"
+	// ItemsServiceRemoveItemDependencyProcedure is the fully-qualified name of the ItemsService's
+	// RemoveItemDependency RPC.
+	ItemsServiceRemoveItemDependencyProcedure = "/statusthing.v1.ItemsService/RemoveItemDependency"
+	// ItemsServiceGetItemImpactProcedure is the fully-qualified name of the ItemsService's
+	// GetItemImpact RPC.
+	ItemsServiceGetItemImpactProcedure = "/statusthing.v1.ItemsService/GetItemImpact"
 	// StatusServiceGetStatusProcedure is the fully-qualified name of the StatusService's GetStatus RPC.
 	StatusServiceGetStatusProcedure = "/statusthing.v1.StatusService/GetStatus"
 	// StatusServiceListStatusProcedure is the fully-qualified name of the StatusService's ListStatus
@@ -70,6 +89,35 @@ const (
 	NotesServiceUpdateNoteProcedure = "/statusthing.v1.NotesService/UpdateNote"
 	// NotesServiceDeleteNoteProcedure is the fully-qualified name of the NotesService's DeleteNote RPC.
 	NotesServiceDeleteNoteProcedure = "/statusthing.v1.NotesService/DeleteNote"
+	// NotesServiceListNoteRevisionsProcedure is the fully-qualified name of the NotesService's
+	// ListNoteRevisions RPC.
+	NotesServiceListNoteRevisionsProcedure = "/statusthing.v1.NotesService/ListNoteRevisions"
+	// NotesServiceUploadAttachmentProcedure is the fully-qualified name of the NotesService's
+	// UploadAttachment RPC.
+	NotesServiceUploadAttachmentProcedure = "/statusthing.v1.NotesService/UploadAttachment"
+	// NotesServiceListAttachmentsProcedure is the fully-qualified name of the NotesService's
+	// ListAttachments RPC.
+	NotesServiceListAttachmentsProcedure = "/statusthing.v1.NotesService/ListAttachments"
+	// NotesServiceDownloadAttachmentProcedure is the fully-qualified name of the NotesService's
+	// DownloadAttachment RPC.
+	NotesServiceDownloadAttachmentProcedure = "/statusthing.v1.NotesService/DownloadAttachment"
+	// NotesServiceDeleteAttachmentProcedure is the fully-qualified name of the NotesService's
+	// DeleteAttachment RPC.
+	NotesServiceDeleteAttachmentProcedure = "/statusthing.v1.NotesService/DeleteAttachment"
+	// DataServiceExportDataProcedure is the fully-qualified name of the DataService's ExportData RPC.
+	DataServiceExportDataProcedure = "/statusthing.v1.DataService/ExportData"
+	// DataServiceImportDataProcedure is the fully-qualified name of the DataService's ImportData RPC.
+	DataServiceImportDataProcedure = "/statusthing.v1.DataService/ImportData"
+	// DataServiceBackupDataProcedure is the fully-qualified name of the DataService's BackupData RPC.
+	DataServiceBackupDataProcedure = "/statusthing.v1.DataService/BackupData"
+	// DataServicePruneDataProcedure is the fully-qualified name of the DataService's PruneData RPC.
+	DataServicePruneDataProcedure = "/statusthing.v1.DataService/PruneData"
+	// SettingsServiceGetSettingsProcedure is the fully-qualified name of the SettingsService's
+	// GetSettings RPC.
+	SettingsServiceGetSettingsProcedure = "/statusthing.v1.SettingsService/GetSettings"
+	// SettingsServiceUpdateSettingsProcedure is the fully-qualified name of the SettingsService's
+	// UpdateSettings RPC.
+	SettingsServiceUpdateSettingsProcedure = "/statusthing.v1.SettingsService/UpdateSettings"
 )
 
 // ItemsServiceClient is a client for the statusthing.v1.ItemsService service.
@@ -84,6 +132,18 @@ type ItemsServiceClient interface {
 	UpdateItem(context.Context, *connect_go.Request[v1.UpdateItemRequest]) (*connect_go.Response[v1.UpdateItemResponse], error)
 	// DeleteItem deletes an exisiting Item
 	DeleteItem(context.Context, *connect_go.Request[v1.DeleteItemRequest]) (*connect_go.Response[v1.DeleteItemResponse], error)
+	// BatchUpdateItems sets the status of and optionally adds the same note to many Items in a single transaction
+	// no Items are changed if any Item fails. the error for each failed Item is returned as a BatchItemError detail
+	BatchUpdateItems(context.Context, *connect_go.Request[v1.BatchUpdateItemsRequest]) (*connect_go.Response[v1.BatchUpdateItemsResponse], error)
+	// BatchDeleteItems deletes many Items in a single transaction
+	// no Items are deleted if any Item fails. the error for each failed Item is returned as a BatchItemError detail
+	BatchDeleteItems(context.Context, *connect_go.Request[v1.BatchDeleteItemsRequest]) (*connect_go.Response[v1.BatchDeleteItemsResponse], error)
+	// AddItemDependency declares that an Item depends on another Item
+	AddItemDependency(context.Context, *connect_go.Request[v1.AddItemDependencyRequest]) (*connect_go.Response[v1.AddItemDependencyResponse], error)
+	// RemoveItemDependency removes a dependency between two Items
+	RemoveItemDependency(context.Context, *connect_go.Request[v1.RemoveItemDependencyRequest]) (*connect_go.Response[v1.RemoveItemDependencyResponse], error)
+	// GetItemImpact gets the effective status of an Item along with the Items it impacts and the dependencies degrading it
+	GetItemImpact(context.Context, *connect_go.Request[v1.GetItemImpactRequest]) (*connect_go.Response[v1.GetItemImpactResponse], error)
 }
 
 // NewItemsServiceClient constructs a client for the statusthing.v1.ItemsService service. By
@@ -121,16 +181,46 @@ func NewItemsServiceClient(httpClient connect_go.HTTPClient, baseURL string, opt
 			baseURL+ItemsServiceDeleteItemProcedure,
 			opts...,
 		),
+		batchUpdateItems: connect_go.NewClient[v1.BatchUpdateItemsRequest, v1.BatchUpdateItemsResponse](
+			httpClient,
+			baseURL+ItemsServiceBatchUpdateItemsProcedure,
+			opts...,
+		),
+		batchDeleteItems: connect_go.NewClient[v1.BatchDeleteItemsRequest, v1.BatchDeleteItemsResponse](
+			httpClient,
+			baseURL+ItemsServiceBatchDeleteItemsProcedure,
+			opts...,
+		),
+		addItemDependency: connect_go.NewClient[v1.AddItemDependencyRequest, v1.AddItemDependencyResponse](
+			httpClient,
+			baseURL+ItemsServiceAddItemDependencyProcedure,
+			opts...,
+		),
+		removeItemDependency: connect_go.NewClient[v1.RemoveItemDependencyRequest, v1.RemoveItemDependencyResponse](
+			httpClient,
+			baseURL+ItemsServiceRemoveItemDependencyProcedure,
+			opts...,
+		),
+		getItemImpact: connect_go.NewClient[v1.GetItemImpactRequest, v1.GetItemImpactResponse](
+			httpClient,
+			baseURL+ItemsServiceGetItemImpactProcedure,
+			opts...,
+		),
 	}
 }
 
 // itemsServiceClient implements ItemsServiceClient.
 type itemsServiceClient struct {
-	getItem    *connect_go.Client[v1.GetItemRequest, v1.GetItemResponse]
-	listItems  *connect_go.Client[v1.ListItemsRequest, v1.ListItemsResponse]
-	addItem    *connect_go.Client[v1.AddItemRequest, v1.AddItemResponse]
-	updateItem *connect_go.Client[v1.UpdateItemRequest, v1.UpdateItemResponse]
-	deleteItem *connect_go.Client[v1.DeleteItemRequest, v1.DeleteItemResponse]
+	getItem              *connect_go.Client[v1.GetItemRequest, v1.GetItemResponse]
+	listItems            *connect_go.Client[v1.ListItemsRequest, v1.ListItemsResponse]
+	addItem              *connect_go.Client[v1.AddItemRequest, v1.AddItemResponse]
+	updateItem           *connect_go.Client[v1.UpdateItemRequest, v1.UpdateItemResponse]
+	deleteItem           *connect_go.Client[v1.DeleteItemRequest, v1.DeleteItemResponse]
+	batchUpdateItems     *connect_go.Client[v1.BatchUpdateItemsRequest, v1.BatchUpdateItemsResponse]
+	batchDeleteItems     *connect_go.Client[v1.BatchDeleteItemsRequest, v1.BatchDeleteItemsResponse]
+	addItemDependency    *connect_go.Client[v1.AddItemDependencyRequest, v1.AddItemDependencyResponse]
+	removeItemDependency *connect_go.Client[v1.RemoveItemDependencyRequest, v1.RemoveItemDependencyResponse]
+	getItemImpact        *connect_go.Client[v1.GetItemImpactRequest, v1.GetItemImpactResponse]
 }
 
 // GetItem calls statusthing.v1.ItemsService.GetItem.
@@ -158,6 +248,31 @@ func (c *itemsServiceClient) DeleteItem(ctx context.Context, req *connect_go.Req
 	return c.deleteItem.CallUnary(ctx, req)
 }
 
+// BatchUpdateItems calls statusthing.v1.ItemsService.BatchUpdateItems.
+func (c *itemsServiceClient) BatchUpdateItems(ctx context.Context, req *connect_go.Request[v1.BatchUpdateItemsRequest]) (*connect_go.Response[v1.BatchUpdateItemsResponse], error) {
+	return c.batchUpdateItems.CallUnary(ctx, req)
+}
+
+// BatchDeleteItems calls statusthing.v1.ItemsService.BatchDeleteItems.
+func (c *itemsServiceClient) BatchDeleteItems(ctx context.Context, req *connect_go.Request[v1.BatchDeleteItemsRequest]) (*connect_go.Response[v1.BatchDeleteItemsResponse], error) {
+	return c.batchDeleteItems.CallUnary(ctx, req)
+}
+
+// AddItemDependency calls statusthing.v1.ItemsService.AddItemDependency.
+func (c *itemsServiceClient) AddItemDependency(ctx context.Context, req *connect_go.Request[v1.AddItemDependencyRequest]) (*connect_go.Response[v1.AddItemDependencyResponse], error) {
+	return c.addItemDependency.CallUnary(ctx, req)
+}
+
+// RemoveItemDependency calls statusthing.v1.ItemsService.RemoveItemDependency.
+func (c *itemsServiceClient) RemoveItemDependency(ctx context.Context, req *connect_go.Request[v1.RemoveItemDependencyRequest]) (*connect_go.Response[v1.RemoveItemDependencyResponse], error) {
+	return c.removeItemDependency.CallUnary(ctx, req)
+}
+
+// GetItemImpact calls statusthing.v1.ItemsService.GetItemImpact.
+func (c *itemsServiceClient) GetItemImpact(ctx context.Context, req *connect_go.Request[v1.GetItemImpactRequest]) (*connect_go.Response[v1.GetItemImpactResponse], error) {
+	return c.getItemImpact.CallUnary(ctx, req)
+}
+
 // ItemsServiceHandler is an implementation of the statusthing.v1.ItemsService service.
 type ItemsServiceHandler interface {
 	// GetItem gets an Item by its Id
@@ -170,6 +285,18 @@ type ItemsServiceHandler interface {
 	UpdateItem(context.Context, *connect_go.Request[v1.UpdateItemRequest]) (*connect_go.Response[v1.UpdateItemResponse], error)
 	// DeleteItem deletes an exisiting Item
 	DeleteItem(context.Context, *connect_go.Request[v1.DeleteItemRequest]) (*connect_go.Response[v1.DeleteItemResponse], error)
+	// BatchUpdateItems sets the status of and optionally adds the same note to many Items in a single transaction
+	// no Items are changed if any Item fails. the error for each failed Item is returned as a BatchItemError detail
+	BatchUpdateItems(context.Context, *connect_go.Request[v1.BatchUpdateItemsRequest]) (*connect_go.Response[v1.BatchUpdateItemsResponse], error)
+	// BatchDeleteItems deletes many Items in a single transaction
+	// no Items are deleted if any Item fails. the error for each failed Item is returned as a BatchItemError detail
+	BatchDeleteItems(context.Context, *connect_go.Request[v1.BatchDeleteItemsRequest]) (*connect_go.Response[v1.BatchDeleteItemsResponse], error)
+	// AddItemDependency declares that an Item depends on another Item
+	AddItemDependency(context.Context, *connect_go.Request[v1.AddItemDependencyRequest]) (*connect_go.Response[v1.AddItemDependencyResponse], error)
+	// RemoveItemDependency removes a dependency between two Items
+	RemoveItemDependency(context.Context, *connect_go.Request[v1.RemoveItemDependencyRequest]) (*connect_go.Response[v1.RemoveItemDependencyResponse], error)
+	// GetItemImpact gets the effective status of an Item along with the Items it impacts and the dependencies degrading it
+	GetItemImpact(context.Context, *connect_go.Request[v1.GetItemImpactRequest]) (*connect_go.Response[v1.GetItemImpactResponse], error)
 }
 
 // NewItemsServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -204,6 +331,31 @@ func NewItemsServiceHandler(svc ItemsServiceHandler, opts ...connect_go.HandlerO
 		svc.DeleteItem,
 		opts...,
 	))
+	mux.Handle(ItemsServiceBatchUpdateItemsProcedure, connect_go.NewUnaryHandler(
+		ItemsServiceBatchUpdateItemsProcedure,
+		svc.BatchUpdateItems,
+		opts...,
+	))
+	mux.Handle(ItemsServiceBatchDeleteItemsProcedure, connect_go.NewUnaryHandler(
+		ItemsServiceBatchDeleteItemsProcedure,
+		svc.BatchDeleteItems,
+		opts...,
+	))
+	mux.Handle(ItemsServiceAddItemDependencyProcedure, connect_go.NewUnaryHandler(
+		ItemsServiceAddItemDependencyProcedure,
+		svc.AddItemDependency,
+		opts...,
+	))
+	mux.Handle(ItemsServiceRemoveItemDependencyProcedure, connect_go.NewUnaryHandler(
+		ItemsServiceRemoveItemDependencyProcedure,
+		svc.RemoveItemDependency,
+		opts...,
+	))
+	mux.Handle(ItemsServiceGetItemImpactProcedure, connect_go.NewUnaryHandler(
+		ItemsServiceGetItemImpactProcedure,
+		svc.GetItemImpact,
+		opts...,
+	))
 	return "/statusthing.v1.ItemsService/", mux
 }
 
@@ -230,6 +382,26 @@ func (UnimplementedItemsServiceHandler) DeleteItem(context.Context, *connect_go.
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("statusthing.v1.ItemsService.DeleteItem is not implemented"))
 }
 
+func (UnimplementedItemsServiceHandler) BatchUpdateItems(context.Context, *connect_go.Request[v1.BatchUpdateItemsRequest]) (*connect_go.Response[v1.BatchUpdateItemsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("statusthing.v1.ItemsService.BatchUpdateItems is not implemented"))
+}
+
+func (UnimplementedItemsServiceHandler) BatchDeleteItems(context.Context, *connect_go.Request[v1.BatchDeleteItemsRequest]) (*connect_go.Response[v1.BatchDeleteItemsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("statusthing.v1.ItemsService.BatchDeleteItems is not implemented"))
+}
+
+func (UnimplementedItemsServiceHandler) AddItemDependency(context.Context, *connect_go.Request[v1.AddItemDependencyRequest]) (*connect_go.Response[v1.AddItemDependencyResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("statusthing.v1.ItemsService.AddItemDependency is not implemented"))
+}
+
+func (UnimplementedItemsServiceHandler) RemoveItemDependency(context.Context, *connect_go.Request[v1.RemoveItemDependencyRequest]) (*connect_go.Response[v1.RemoveItemDependencyResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("statusthing.v1.ItemsService.RemoveItemDependency is not implemented"))
+}
+
+func (UnimplementedItemsServiceHandler) GetItemImpact(context.Context, *connect_go.Request[v1.GetItemImpactRequest]) (*connect_go.Response[v1.GetItemImpactResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("statusthing.v1.ItemsService.GetItemImpact is not implemented"))
+}
+
 // StatusServiceClient is a client for the statusthing.v1.StatusService service.
 type StatusServiceClient interface {
 	// GetStatus gets a Status by its Id
@@ -400,6 +572,18 @@ type NotesServiceClient interface {
 	UpdateNote(context.Context, *connect_go.Request[v1.UpdateNoteRequest]) (*connect_go.Response[v1.UpdateNoteResponse], error)
 	// DeleteNote deletes a Note from an Item
 	DeleteNote(context.Context, *connect_go.Request[v1.DeleteNoteRequest]) (*connect_go.Response[v1.DeleteNoteResponse], error)
+	// ListNoteRevisions gets every earlier version of a Note
+	ListNoteRevisions(context.Context, *connect_go.Request[v1.ListNoteRevisionsRequest]) (*connect_go.Response[v1.ListNoteRevisionsResponse], error)
+	// UploadAttachment attaches a file to a Note
+	// the first message carries the AttachmentInfo and every following message a chunk of the file
+	UploadAttachment(context.Context) *connect_go.ClientStreamForClient[v1.UploadAttachmentRequest, v1.UploadAttachmentResponse]
+	// ListAttachments gets all Attachment of a Note
+	ListAttachments(context.Context, *connect_go.Request[v1.ListAttachmentsRequest]) (*connect_go.Response[v1.ListAttachmentsResponse], error)
+	// DownloadAttachment gets the contents of an Attachment
+	// the first message carries the Attachment and every following message a chunk of the file
+	DownloadAttachment(context.Context, *connect_go.Request[v1.DownloadAttachmentRequest]) (*connect_go.ServerStreamForClient[v1.DownloadAttachmentResponse], error)
+	// DeleteAttachment deletes an Attachment
+	DeleteAttachment(context.Context, *connect_go.Request[v1.DeleteAttachmentRequest]) (*connect_go.Response[v1.DeleteAttachmentResponse], error)
 }
 
 // NewNotesServiceClient constructs a client for the statusthing.v1.NotesService service. By
@@ -437,16 +621,46 @@ func NewNotesServiceClient(httpClient connect_go.HTTPClient, baseURL string, opt
 			baseURL+NotesServiceDeleteNoteProcedure,
 			opts...,
 		),
+		listNoteRevisions: connect_go.NewClient[v1.ListNoteRevisionsRequest, v1.ListNoteRevisionsResponse](
+			httpClient,
+			baseURL+NotesServiceListNoteRevisionsProcedure,
+			opts...,
+		),
+		uploadAttachment: connect_go.NewClient[v1.UploadAttachmentRequest, v1.UploadAttachmentResponse](
+			httpClient,
+			baseURL+NotesServiceUploadAttachmentProcedure,
+			opts...,
+		),
+		listAttachments: connect_go.NewClient[v1.ListAttachmentsRequest, v1.ListAttachmentsResponse](
+			httpClient,
+			baseURL+NotesServiceListAttachmentsProcedure,
+			opts...,
+		),
+		downloadAttachment: connect_go.NewClient[v1.DownloadAttachmentRequest, v1.DownloadAttachmentResponse](
+			httpClient,
+			baseURL+NotesServiceDownloadAttachmentProcedure,
+			opts...,
+		),
+		deleteAttachment: connect_go.NewClient[v1.DeleteAttachmentRequest, v1.DeleteAttachmentResponse](
+			httpClient,
+			baseURL+NotesServiceDeleteAttachmentProcedure,
+			opts...,
+		),
 	}
 }
 
 // notesServiceClient implements NotesServiceClient.
 type notesServiceClient struct {
-	getNote    *connect_go.Client[v1.GetNoteRequest, v1.GetNoteResponse]
-	listNotes  *connect_go.Client[v1.ListNotesRequest, v1.ListNotesResponse]
-	addNote    *connect_go.Client[v1.AddNoteRequest, v1.AddNoteResponse]
-	updateNote *connect_go.Client[v1.UpdateNoteRequest, v1.UpdateNoteResponse]
-	deleteNote *connect_go.Client[v1.DeleteNoteRequest, v1.DeleteNoteResponse]
+	getNote            *connect_go.Client[v1.GetNoteRequest, v1.GetNoteResponse]
+	listNotes          *connect_go.Client[v1.ListNotesRequest, v1.ListNotesResponse]
+	addNote            *connect_go.Client[v1.AddNoteRequest, v1.AddNoteResponse]
+	updateNote         *connect_go.Client[v1.UpdateNoteRequest, v1.UpdateNoteResponse]
+	deleteNote         *connect_go.Client[v1.DeleteNoteRequest, v1.DeleteNoteResponse]
+	listNoteRevisions  *connect_go.Client[v1.ListNoteRevisionsRequest, v1.ListNoteRevisionsResponse]
+	uploadAttachment   *connect_go.Client[v1.UploadAttachmentRequest, v1.UploadAttachmentResponse]
+	listAttachments    *connect_go.Client[v1.ListAttachmentsRequest, v1.ListAttachmentsResponse]
+	downloadAttachment *connect_go.Client[v1.DownloadAttachmentRequest, v1.DownloadAttachmentResponse]
+	deleteAttachment   *connect_go.Client[v1.DeleteAttachmentRequest, v1.DeleteAttachmentResponse]
 }
 
 // GetNote calls statusthing.v1.NotesService.GetNote.
@@ -474,6 +688,31 @@ func (c *notesServiceClient) DeleteNote(ctx context.Context, req *connect_go.Req
 	return c.deleteNote.CallUnary(ctx, req)
 }
 
+// ListNoteRevisions calls statusthing.v1.NotesService.ListNoteRevisions.
+func (c *notesServiceClient) ListNoteRevisions(ctx context.Context, req *connect_go.Request[v1.ListNoteRevisionsRequest]) (*connect_go.Response[v1.ListNoteRevisionsResponse], error) {
+	return c.listNoteRevisions.CallUnary(ctx, req)
+}
+
+// UploadAttachment calls statusthing.v1.NotesService.UploadAttachment.
+func (c *notesServiceClient) UploadAttachment(ctx context.Context) *connect_go.ClientStreamForClient[v1.UploadAttachmentRequest, v1.UploadAttachmentResponse] {
+	return c.uploadAttachment.CallClientStream(ctx)
+}
+
+// ListAttachments calls statusthing.v1.NotesService.ListAttachments.
+func (c *notesServiceClient) ListAttachments(ctx context.Context, req *connect_go.Request[v1.ListAttachmentsRequest]) (*connect_go.Response[v1.ListAttachmentsResponse], error) {
+	return c.listAttachments.CallUnary(ctx, req)
+}
+
+// DownloadAttachment calls statusthing.v1.NotesService.DownloadAttachment.
+func (c *notesServiceClient) DownloadAttachment(ctx context.Context, req *connect_go.Request[v1.DownloadAttachmentRequest]) (*connect_go.ServerStreamForClient[v1.DownloadAttachmentResponse], error) {
+	return c.downloadAttachment.CallServerStream(ctx, req)
+}
+
+// DeleteAttachment calls statusthing.v1.NotesService.DeleteAttachment.
+func (c *notesServiceClient) DeleteAttachment(ctx context.Context, req *connect_go.Request[v1.DeleteAttachmentRequest]) (*connect_go.Response[v1.DeleteAttachmentResponse], error) {
+	return c.deleteAttachment.CallUnary(ctx, req)
+}
+
 // NotesServiceHandler is an implementation of the statusthing.v1.NotesService service.
 type NotesServiceHandler interface {
 	// GetNote gets a Note by its Id
@@ -486,6 +725,18 @@ type NotesServiceHandler interface {
 	UpdateNote(context.Context, *connect_go.Request[v1.UpdateNoteRequest]) (*connect_go.Response[v1.UpdateNoteResponse], error)
 	// DeleteNote deletes a Note from an Item
 	DeleteNote(context.Context, *connect_go.Request[v1.DeleteNoteRequest]) (*connect_go.Response[v1.DeleteNoteResponse], error)
+	// ListNoteRevisions gets every earlier version of a Note
+	ListNoteRevisions(context.Context, *connect_go.Request[v1.ListNoteRevisionsRequest]) (*connect_go.Response[v1.ListNoteRevisionsResponse], error)
+	// UploadAttachment attaches a file to a Note
+	// the first message carries the AttachmentInfo and every following message a chunk of the file
+	UploadAttachment(context.Context, *connect_go.ClientStream[v1.UploadAttachmentRequest]) (*connect_go.Response[v1.UploadAttachmentResponse], error)
+	// ListAttachments gets all Attachment of a Note
+	ListAttachments(context.Context, *connect_go.Request[v1.ListAttachmentsRequest]) (*connect_go.Response[v1.ListAttachmentsResponse], error)
+	// DownloadAttachment gets the contents of an Attachment
+	// the first message carries the Attachment and every following message a chunk of the file
+	DownloadAttachment(context.Context, *connect_go.Request[v1.DownloadAttachmentRequest], *connect_go.ServerStream[v1.DownloadAttachmentResponse]) error
+	// DeleteAttachment deletes an Attachment
+	DeleteAttachment(context.Context, *connect_go.Request[v1.DeleteAttachmentRequest]) (*connect_go.Response[v1.DeleteAttachmentResponse], error)
 }
 
 // NewNotesServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -520,6 +771,31 @@ func NewNotesServiceHandler(svc NotesServiceHandler, opts ...connect_go.HandlerO
 		svc.DeleteNote,
 		opts...,
 	))
+	mux.Handle(NotesServiceListNoteRevisionsProcedure, connect_go.NewUnaryHandler(
+		NotesServiceListNoteRevisionsProcedure,
+		svc.ListNoteRevisions,
+		opts...,
+	))
+	mux.Handle(NotesServiceUploadAttachmentProcedure, connect_go.NewClientStreamHandler(
+		NotesServiceUploadAttachmentProcedure,
+		svc.UploadAttachment,
+		opts...,
+	))
+	mux.Handle(NotesServiceListAttachmentsProcedure, connect_go.NewUnaryHandler(
+		NotesServiceListAttachmentsProcedure,
+		svc.ListAttachments,
+		opts...,
+	))
+	mux.Handle(NotesServiceDownloadAttachmentProcedure, connect_go.NewServerStreamHandler(
+		NotesServiceDownloadAttachmentProcedure,
+		svc.DownloadAttachment,
+		opts...,
+	))
+	mux.Handle(NotesServiceDeleteAttachmentProcedure, connect_go.NewUnaryHandler(
+		NotesServiceDeleteAttachmentProcedure,
+		svc.DeleteAttachment,
+		opts...,
+	))
 	return "/statusthing.v1.NotesService/", mux
 }
 
@@ -545,3 +821,253 @@ func (UnimplementedNotesServiceHandler) UpdateNote(context.Context, *connect_go.
 func (UnimplementedNotesServiceHandler) DeleteNote(context.Context, *connect_go.Request[v1.DeleteNoteRequest]) (*connect_go.Response[v1.DeleteNoteResponse], error) {
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("statusthing.v1.NotesService.DeleteNote is not implemented"))
 }
+
+func (UnimplementedNotesServiceHandler) ListNoteRevisions(context.Context, *connect_go.Request[v1.ListNoteRevisionsRequest]) (*connect_go.Response[v1.ListNoteRevisionsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("statusthing.v1.NotesService.ListNoteRevisions is not implemented"))
+}
+
+func (UnimplementedNotesServiceHandler) UploadAttachment(context.Context, *connect_go.ClientStream[v1.UploadAttachmentRequest]) (*connect_go.Response[v1.UploadAttachmentResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("statusthing.v1.NotesService.UploadAttachment is not implemented"))
+}
+
+func (UnimplementedNotesServiceHandler) ListAttachments(context.Context, *connect_go.Request[v1.ListAttachmentsRequest]) (*connect_go.Response[v1.ListAttachmentsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("statusthing.v1.NotesService.ListAttachments is not implemented"))
+}
+
+func (UnimplementedNotesServiceHandler) DownloadAttachment(context.Context, *connect_go.Request[v1.DownloadAttachmentRequest], *connect_go.ServerStream[v1.DownloadAttachmentResponse]) error {
+	return connect_go.NewError(connect_go.CodeUnimplemented, errors.New("statusthing.v1.NotesService.DownloadAttachment is not implemented"))
+}
+
+func (UnimplementedNotesServiceHandler) DeleteAttachment(context.Context, *connect_go.Request[v1.DeleteAttachmentRequest]) (*connect_go.Response[v1.DeleteAttachmentResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("statusthing.v1.NotesService.DeleteAttachment is not implemented"))
+}
+
+// DataServiceClient is a client for the statusthing.v1.DataService service.
+type DataServiceClient interface {
+	// ExportData exports all data except users
+	// only authenticated callers can export
+	ExportData(context.Context, *connect_go.Request[v1.ExportDataRequest]) (*connect_go.Response[v1.ExportDataResponse], error)
+	// ImportData imports previously exported data. datasets with users are rejected
+	// only authenticated callers can import
+	ImportData(context.Context, *connect_go.Request[v1.ImportDataRequest]) (*connect_go.Response[v1.ImportDataResponse], error)
+	// BackupData writes a consistent copy of the database to the server's backup directory
+	// only authenticated callers can write backups
+	BackupData(context.Context, *connect_go.Request[v1.BackupDataRequest]) (*connect_go.Response[v1.BackupDataResponse], error)
+	// PruneData removes data according to the server's retention policy
+	// only authenticated callers can prune
+	PruneData(context.Context, *connect_go.Request[v1.PruneDataRequest]) (*connect_go.Response[v1.PruneDataResponse], error)
+}
+
+// NewDataServiceClient constructs a client for the statusthing.v1.DataService service. By default,
+// it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and
+// sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC()
+// or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewDataServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...connect_go.ClientOption) DataServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &dataServiceClient{
+		exportData: connect_go.NewClient[v1.ExportDataRequest, v1.ExportDataResponse](
+			httpClient,
+			baseURL+DataServiceExportDataProcedure,
+			opts...,
+		),
+		importData: connect_go.NewClient[v1.ImportDataRequest, v1.ImportDataResponse](
+			httpClient,
+			baseURL+DataServiceImportDataProcedure,
+			opts...,
+		),
+		backupData: connect_go.NewClient[v1.BackupDataRequest, v1.BackupDataResponse](
+			httpClient,
+			baseURL+DataServiceBackupDataProcedure,
+			opts...,
+		),
+		pruneData: connect_go.NewClient[v1.PruneDataRequest, v1.PruneDataResponse](
+			httpClient,
+			baseURL+DataServicePruneDataProcedure,
+			opts...,
+		),
+	}
+}
+
+// dataServiceClient implements DataServiceClient.
+type dataServiceClient struct {
+	exportData *connect_go.Client[v1.ExportDataRequest, v1.ExportDataResponse]
+	importData *connect_go.Client[v1.ImportDataRequest, v1.ImportDataResponse]
+	backupData *connect_go.Client[v1.BackupDataRequest, v1.BackupDataResponse]
+	pruneData  *connect_go.Client[v1.PruneDataRequest, v1.PruneDataResponse]
+}
+
+// ExportData calls statusthing.v1.DataService.ExportData.
+func (c *dataServiceClient) ExportData(ctx context.Context, req *connect_go.Request[v1.ExportDataRequest]) (*connect_go.Response[v1.ExportDataResponse], error) {
+	return c.exportData.CallUnary(ctx, req)
+}
+
+// ImportData calls statusthing.v1.DataService.ImportData.
+func (c *dataServiceClient) ImportData(ctx context.Context, req *connect_go.Request[v1.ImportDataRequest]) (*connect_go.Response[v1.ImportDataResponse], error) {
+	return c.importData.CallUnary(ctx, req)
+}
+
+// BackupData calls statusthing.v1.DataService.BackupData.
+func (c *dataServiceClient) BackupData(ctx context.Context, req *connect_go.Request[v1.BackupDataRequest]) (*connect_go.Response[v1.BackupDataResponse], error) {
+	return c.backupData.CallUnary(ctx, req)
+}
+
+// PruneData calls statusthing.v1.DataService.PruneData.
+func (c *dataServiceClient) PruneData(ctx context.Context, req *connect_go.Request[v1.PruneDataRequest]) (*connect_go.Response[v1.PruneDataResponse], error) {
+	return c.pruneData.CallUnary(ctx, req)
+}
+
+// DataServiceHandler is an implementation of the statusthing.v1.DataService service.
+type DataServiceHandler interface {
+	// ExportData exports all data except users
+	// only authenticated callers can export
+	ExportData(context.Context, *connect_go.Request[v1.ExportDataRequest]) (*connect_go.Response[v1.ExportDataResponse], error)
+	// ImportData imports previously exported data. datasets with users are rejected
+	// only authenticated callers can import
+	ImportData(context.Context, *connect_go.Request[v1.ImportDataRequest]) (*connect_go.Response[v1.ImportDataResponse], error)
+	// BackupData writes a consistent copy of the database to the server's backup directory
+	// only authenticated callers can write backups
+	BackupData(context.Context, *connect_go.Request[v1.BackupDataRequest]) (*connect_go.Response[v1.BackupDataResponse], error)
+	// PruneData removes data according to the server's retention policy
+	// only authenticated callers can prune
+	PruneData(context.Context, *connect_go.Request[v1.PruneDataRequest]) (*connect_go.Response[v1.PruneDataResponse], error)
+}
+
+// NewDataServiceHandler builds an HTTP handler from the service implementation. It returns the path
+// on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewDataServiceHandler(svc DataServiceHandler, opts ...connect_go.HandlerOption) (string, http.Handler) {
+	mux := http.NewServeMux()
+	mux.Handle(DataServiceExportDataProcedure, connect_go.NewUnaryHandler(
+		DataServiceExportDataProcedure,
+		svc.ExportData,
+		opts...,
+	))
+	mux.Handle(DataServiceImportDataProcedure, connect_go.NewUnaryHandler(
+		DataServiceImportDataProcedure,
+		svc.ImportData,
+		opts...,
+	))
+	mux.Handle(DataServiceBackupDataProcedure, connect_go.NewUnaryHandler(
+		DataServiceBackupDataProcedure,
+		svc.BackupData,
+		opts...,
+	))
+	mux.Handle(DataServicePruneDataProcedure, connect_go.NewUnaryHandler(
+		DataServicePruneDataProcedure,
+		svc.PruneData,
+		opts...,
+	))
+	return "/statusthing.v1.DataService/", mux
+}
+
+// UnimplementedDataServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedDataServiceHandler struct{}
+
+func (UnimplementedDataServiceHandler) ExportData(context.Context, *connect_go.Request[v1.ExportDataRequest]) (*connect_go.Response[v1.ExportDataResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("statusthing.v1.DataService.ExportData is not implemented"))
+}
+
+func (UnimplementedDataServiceHandler) ImportData(context.Context, *connect_go.Request[v1.ImportDataRequest]) (*connect_go.Response[v1.ImportDataResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("statusthing.v1.DataService.ImportData is not implemented"))
+}
+
+func (UnimplementedDataServiceHandler) BackupData(context.Context, *connect_go.Request[v1.BackupDataRequest]) (*connect_go.Response[v1.BackupDataResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("statusthing.v1.DataService.BackupData is not implemented"))
+}
+
+func (UnimplementedDataServiceHandler) PruneData(context.Context, *connect_go.Request[v1.PruneDataRequest]) (*connect_go.Response[v1.PruneDataResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("statusthing.v1.DataService.PruneData is not implemented"))
+}
+
+// SettingsServiceClient is a client for the statusthing.v1.SettingsService service.
+type SettingsServiceClient interface {
+	// GetSettings gets the site Settings
+	GetSettings(context.Context, *connect_go.Request[v1.GetSettingsRequest]) (*connect_go.Response[v1.GetSettingsResponse], error)
+	// UpdateSettings changes the site Settings
+	// only authenticated callers can change settings
+	UpdateSettings(context.Context, *connect_go.Request[v1.UpdateSettingsRequest]) (*connect_go.Response[v1.UpdateSettingsResponse], error)
+}
+
+// NewSettingsServiceClient constructs a client for the statusthing.v1.SettingsService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewSettingsServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...connect_go.ClientOption) SettingsServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &settingsServiceClient{
+		getSettings: connect_go.NewClient[v1.GetSettingsRequest, v1.GetSettingsResponse](
+			httpClient,
+			baseURL+SettingsServiceGetSettingsProcedure,
+			opts...,
+		),
+		updateSettings: connect_go.NewClient[v1.UpdateSettingsRequest, v1.UpdateSettingsResponse](
+			httpClient,
+			baseURL+SettingsServiceUpdateSettingsProcedure,
+			opts...,
+		),
+	}
+}
+
+// settingsServiceClient implements SettingsServiceClient.
+type settingsServiceClient struct {
+	getSettings    *connect_go.Client[v1.GetSettingsRequest, v1.GetSettingsResponse]
+	updateSettings *connect_go.Client[v1.UpdateSettingsRequest, v1.UpdateSettingsResponse]
+}
+
+// GetSettings calls statusthing.v1.SettingsService.GetSettings.
+func (c *settingsServiceClient) GetSettings(ctx context.Context, req *connect_go.Request[v1.GetSettingsRequest]) (*connect_go.Response[v1.GetSettingsResponse], error) {
+	return c.getSettings.CallUnary(ctx, req)
+}
+
+// UpdateSettings calls statusthing.v1.SettingsService.UpdateSettings.
+func (c *settingsServiceClient) UpdateSettings(ctx context.Context, req *connect_go.Request[v1.UpdateSettingsRequest]) (*connect_go.Response[v1.UpdateSettingsResponse], error) {
+	return c.updateSettings.CallUnary(ctx, req)
+}
+
+// SettingsServiceHandler is an implementation of the statusthing.v1.SettingsService service.
+type SettingsServiceHandler interface {
+	// GetSettings gets the site Settings
+	GetSettings(context.Context, *connect_go.Request[v1.GetSettingsRequest]) (*connect_go.Response[v1.GetSettingsResponse], error)
+	// UpdateSettings changes the site Settings
+	// only authenticated callers can change settings
+	UpdateSettings(context.Context, *connect_go.Request[v1.UpdateSettingsRequest]) (*connect_go.Response[v1.UpdateSettingsResponse], error)
+}
+
+// NewSettingsServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewSettingsServiceHandler(svc SettingsServiceHandler, opts ...connect_go.HandlerOption) (string, http.Handler) {
+	mux := http.NewServeMux()
+	mux.Handle(SettingsServiceGetSettingsProcedure, connect_go.NewUnaryHandler(
+		SettingsServiceGetSettingsProcedure,
+		svc.GetSettings,
+		opts...,
+	))
+	mux.Handle(SettingsServiceUpdateSettingsProcedure, connect_go.NewUnaryHandler(
+		SettingsServiceUpdateSettingsProcedure,
+		svc.UpdateSettings,
+		opts...,
+	))
+	return "/statusthing.v1.SettingsService/", mux
+}
+
+// UnimplementedSettingsServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedSettingsServiceHandler struct{}
+
+func (UnimplementedSettingsServiceHandler) GetSettings(context.Context, *connect_go.Request[v1.GetSettingsRequest]) (*connect_go.Response[v1.GetSettingsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("statusthing.v1.SettingsService.GetSettings is not implemented"))
+}
+
+func (UnimplementedSettingsServiceHandler) UpdateSettings(context.Context, *connect_go.Request[v1.UpdateSettingsRequest]) (*connect_go.Response[v1.UpdateSettingsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("statusthing.v1.SettingsService.UpdateSettings is not implemented"))
+}
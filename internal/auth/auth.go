@@ -0,0 +1,25 @@
+// Package auth carries the identity of whoever made a request
+package auth
+
+import "context"
+
+// Identity is who made a request
+type Identity struct {
+	// Name is the name the caller is known by to the api
+	Name string
+	// Source is how the caller was identified. i.e. client-certificate
+	Source string
+}
+
+type identityKey struct{}
+
+// NewContext returns a copy of the provided context carrying the provided [Identity]
+func NewContext(ctx context.Context, id *Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, id)
+}
+
+// FromContext returns the [Identity] carried by the provided context, if any
+func FromContext(ctx context.Context) (*Identity, bool) {
+	id, ok := ctx.Value(identityKey{}).(*Identity)
+	return id, ok && id != nil
+}
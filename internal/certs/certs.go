@@ -0,0 +1,161 @@
+// Package certs serves tls with certificates that are reloaded when they change on disk
+// and identifies callers by their client certificate
+package certs
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"golang.org/x/exp/slog"
+
+	"github.com/lusis/statusthing/internal/auth"
+	"github.com/lusis/statusthing/internal/serrors"
+	"github.com/lusis/statusthing/internal/validation"
+)
+
+// ClientCertificateSource is the [auth.Identity] source of callers identified by [IdentityMiddleware]
+const ClientCertificateSource = "client-certificate"
+
+// Reloader serves a certificate and key pair that is reloaded when either file changes
+type Reloader struct {
+	certFile string
+	keyFile  string
+	l        sync.RWMutex
+	cert     *tls.Certificate
+	stamp    string
+}
+
+// NewReloader returns a [Reloader] for the provided certificate and key files
+// the pair is loaded immediately so a bad pair fails at startup
+func NewReloader(certFile, keyFile string) (*Reloader, error) {
+	if !validation.ValidString(certFile) {
+		return nil, serrors.NewError("certFile", serrors.ErrEmptyString)
+	}
+	if !validation.ValidString(keyFile) {
+		return nil, serrors.NewError("keyFile", serrors.ErrEmptyString)
+	}
+	r := &Reloader{certFile: certFile, keyFile: keyFile}
+	if _, err := r.Reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// GetCertificate returns the current certificate. it is meant for [tls.Config.GetCertificate]
+func (r *Reloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	r.l.RLock()
+	defer r.l.RUnlock()
+	return r.cert, nil
+}
+
+// Reload loads the pair again if either file changed since it was last loaded and reports if it did
+// the current certificate is kept if the new pair can't be loaded
+func (r *Reloader) Reload() (bool, error) {
+	stamp, err := r.fileStamp()
+	if err != nil {
+		return false, err
+	}
+	r.l.RLock()
+	unchanged := stamp == r.stamp
+	r.l.RUnlock()
+	if unchanged {
+		return false, nil
+	}
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return false, serrors.NewWrappedError("certificate", serrors.ErrInvalidData, err)
+	}
+	r.l.Lock()
+	r.cert = &cert
+	r.stamp = stamp
+	r.l.Unlock()
+	return true, nil
+}
+
+// Watch calls [Reloader.Reload] every interval until the provided context is done
+func (r *Reloader) Watch(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			reloaded, err := r.Reload()
+			if err != nil {
+				// a new pair is often written one file at a time so this is retried on the next tick
+				slog.Warn("unable to reload certificate", "error", err, "tls.cert_file", r.certFile)
+				continue
+			}
+			if reloaded {
+				slog.Info("reloaded certificate", "tls.cert_file", r.certFile)
+			}
+		}
+	}
+}
+
+// fileStamp identifies the current contents of both files by their size and modification time
+func (r *Reloader) fileStamp() (string, error) {
+	stamp := ""
+	for _, path := range []string{r.certFile, r.keyFile} {
+		info, err := os.Stat(path)
+		if err != nil {
+			return "", serrors.NewWrappedError(path, serrors.ErrNotFound, err)
+		}
+		stamp += fmt.Sprintf("%d:%d;", info.Size(), info.ModTime().UnixNano())
+	}
+	return stamp, nil
+}
+
+// ClientCAs reads the pem encoded certificate authorities used to verify client certificates
+func ClientCAs(path string) (*x509.CertPool, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, serrors.NewWrappedError("client ca", serrors.ErrNotFound, err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(b) {
+		return nil, serrors.NewError("client ca "+path, serrors.ErrInvalidData)
+	}
+	return pool, nil
+}
+
+// IdentityMiddleware identifies callers with a verified client certificate
+// identities maps a certificate subject, either the full subject (i.e. CN=ci,O=Example) or just its common name, to the api identity of the caller
+// when identities is empty the common name is the identity. otherwise certificates with an unknown subject are forbidden
+// requests without a verified client certificate are passed through without an identity
+func IdentityMiddleware(identities map[string]string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.TLS == nil || len(r.TLS.VerifiedChains) == 0 || len(r.TLS.VerifiedChains[0]) == 0 {
+				next.ServeHTTP(w, r)
+				return
+			}
+			subject := r.TLS.VerifiedChains[0][0].Subject
+			name := subject.CommonName
+			if len(identities) != 0 {
+				mapped, ok := identities[subject.String()]
+				if !ok {
+					mapped, ok = identities[subject.CommonName]
+				}
+				if !ok {
+					slog.Warn("unknown client certificate", "tls.subject", subject.String())
+					http.Error(w, "unknown client certificate", http.StatusForbidden)
+					return
+				}
+				name = mapped
+			}
+			id := &auth.Identity{Name: name, Source: ClientCertificateSource}
+			next.ServeHTTP(w, r.WithContext(auth.NewContext(r.Context(), id)))
+		})
+	}
+}
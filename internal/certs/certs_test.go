@@ -0,0 +1,151 @@
+package certs
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/lusis/statusthing/internal/auth"
+	"github.com/lusis/statusthing/internal/serrors"
+	"github.com/stretchr/testify/require"
+)
+
+// newCert returns a certificate for the provided subject signed by parent, or self-signed if parent is nil
+func newCert(t *testing.T, subject pkix.Name, parent *tls.Certificate, isCA bool) *tls.Certificate {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	serial, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               subject,
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		DNSNames:              []string{"localhost"},
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  isCA,
+	}
+	signer, signerKey := tmpl, any(key)
+	if parent != nil {
+		signer, signerKey = parent.Leaf, parent.PrivateKey
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, signer, &key.PublicKey, signerKey)
+	require.NoError(t, err)
+	leaf, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return &tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key, Leaf: leaf}
+}
+
+func writePair(t *testing.T, cert *tls.Certificate, certFile, keyFile string) {
+	t.Helper()
+	keyDER, err := x509.MarshalPKCS8PrivateKey(cert.PrivateKey)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Certificate[0]}), 0o600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER}), 0o600))
+}
+
+func TestReloader(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem")
+
+	_, err := NewReloader(certFile, keyFile)
+	require.ErrorIs(t, err, serrors.ErrNotFound)
+
+	first := newCert(t, pkix.Name{CommonName: "first"}, nil, false)
+	writePair(t, first, certFile, keyFile)
+	r, err := NewReloader(certFile, keyFile)
+	require.NoError(t, err)
+	current, err := r.GetCertificate(nil)
+	require.NoError(t, err)
+	require.Equal(t, first.Certificate, current.Certificate)
+
+	reloaded, err := r.Reload()
+	require.NoError(t, err)
+	require.False(t, reloaded, "unchanged files should not be reloaded")
+
+	// a half written pair keeps the current certificate
+	require.NoError(t, os.WriteFile(keyFile, []byte("partial"), 0o600))
+	_, err = r.Reload()
+	require.ErrorIs(t, err, serrors.ErrInvalidData)
+	current, err = r.GetCertificate(nil)
+	require.NoError(t, err)
+	require.Equal(t, first.Certificate, current.Certificate)
+
+	second := newCert(t, pkix.Name{CommonName: "second"}, nil, false)
+	writePair(t, second, certFile, keyFile)
+	reloaded, err = r.Reload()
+	require.NoError(t, err)
+	require.True(t, reloaded)
+	current, err = r.GetCertificate(nil)
+	require.NoError(t, err)
+	require.Equal(t, second.Certificate, current.Certificate)
+}
+
+func TestIdentityMiddleware(t *testing.T) {
+	ca := newCert(t, pkix.Name{CommonName: "test ca"}, nil, true)
+	serverCert := newCert(t, pkix.Name{CommonName: "localhost"}, ca, false)
+	pool := x509.NewCertPool()
+	pool.AddCert(ca.Leaf)
+
+	testCases := map[string]struct {
+		identities map[string]string
+		client     *pkix.Name
+		status     int
+		expected   string
+	}{
+		"no-certificate":   {status: http.StatusOK},
+		"common-name":      {client: &pkix.Name{CommonName: "ci"}, status: http.StatusOK, expected: "ci"},
+		"mapped-subject":   {identities: map[string]string{"CN=ci,O=Example": "ci-bot"}, client: &pkix.Name{CommonName: "ci", Organization: []string{"Example"}}, status: http.StatusOK, expected: "ci-bot"},
+		"mapped-cn":        {identities: map[string]string{"ci": "ci-bot"}, client: &pkix.Name{CommonName: "ci"}, status: http.StatusOK, expected: "ci-bot"},
+		"unmapped-subject": {identities: map[string]string{"ci": "ci-bot"}, client: &pkix.Name{CommonName: "someone"}, status: http.StatusForbidden},
+	}
+	for n, tc := range testCases {
+		t.Run(n, func(t *testing.T) {
+			srv := httptest.NewUnstartedServer(IdentityMiddleware(tc.identities)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if id, ok := auth.FromContext(r.Context()); ok {
+					_, _ = io.WriteString(w, id.Name)
+				}
+			})))
+			srv.TLS = &tls.Config{
+				Certificates: []tls.Certificate{*serverCert},
+				ClientAuth:   tls.VerifyClientCertIfGiven,
+				ClientCAs:    pool,
+			}
+			srv.StartTLS()
+			defer srv.Close()
+
+			clientTLS := &tls.Config{RootCAs: pool}
+			if tc.client != nil {
+				clientTLS.Certificates = []tls.Certificate{*newCert(t, *tc.client, ca, false)}
+			}
+			client := &http.Client{Transport: &http.Transport{TLSClientConfig: clientTLS}}
+			res, err := client.Get(srv.URL)
+			require.NoError(t, err)
+			defer res.Body.Close()
+			require.Equal(t, tc.status, res.StatusCode)
+			if tc.status != http.StatusOK {
+				return
+			}
+			body, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, string(body))
+		})
+	}
+}
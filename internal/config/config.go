@@ -0,0 +1,443 @@
+// Package config is the configuration of the statusthing server
+// a [Config] starts from [Default] and is layered with a yaml, json or toml file and STATUSTHING_* environment variables
+// command line flags are applied last by binding them to the fields of the loaded [Config]
+package config
+
+import (
+	"bytes"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/exp/slog"
+	"gopkg.in/yaml.v3"
+
+	"github.com/lusis/statusthing/internal/oidc"
+	"github.com/lusis/statusthing/internal/serrors"
+	"github.com/lusis/statusthing/internal/services"
+	"github.com/lusis/statusthing/internal/session"
+)
+
+// EnvPrefix is the prefix of every environment variable read by [Config.ApplyEnv]
+const EnvPrefix = "STATUSTHING_"
+
+const (
+	// defaultPruneInterval is how often the pruner runs by default
+	defaultPruneInterval = time.Hour
+	// defaultReloadInterval is how often tls certificates are checked for changes by default
+	defaultReloadInterval = time.Minute
+)
+
+// Config is the configuration of the statusthing server
+// the yaml key of each field also names its environment variable. i.e. db.dsn is STATUSTHING_DB_DSN
+type Config struct {
+	// DB is the database the server stores data in
+	DB DB `yaml:"db"`
+	// Listen is where the server listens
+	Listen Listen `yaml:"listen"`
+	// TLS is the certificate the server listens with
+	TLS TLS `yaml:"tls"`
+	// Log is the logging configuration
+	Log Log `yaml:"log"`
+	// Session is the admin ui session cookie configuration
+	Session session.Config `yaml:"session"`
+	// OIDC is the single sign-on configuration of the admin ui
+	OIDC oidc.Config `yaml:"oidc"`
+	// Seed is the data created at startup
+	Seed Seed `yaml:"seed"`
+	// Backup is the periodic backup configuration
+	Backup Backup `yaml:"backup"`
+	// Retention is the pruning configuration
+	Retention Retention `yaml:"retention"`
+	// Attachments is the note attachment configuration
+	Attachments Attachments `yaml:"attachments"`
+	// IdempotencyTTL is how long idempotency keys are remembered
+	IdempotencyTTL time.Duration `yaml:"idempotency_ttl"`
+	// ThemeDir is a directory of templates and static files used in place of the built-in ones
+	ThemeDir string `yaml:"theme_dir"`
+	// DevMode enables grpc reflection and template reloading for development
+	DevMode bool `yaml:"devmode"`
+}
+
+// DB is the database configuration
+type DB struct {
+	// Driver is the database driver. only sqlite3 is supported
+	Driver string `yaml:"driver"`
+	// DSN is the data source name. for sqlite3 this is the path to the database file
+	DSN string `yaml:"dsn"`
+}
+
+// Listen is the listener configuration
+// every listener is optional but at least one is required
+type Listen struct {
+	// API is the address to serve the api on
+	// the admin ui is served here too unless Admin or AdminSocket is set
+	API string `yaml:"api"`
+	// Admin is the address to serve the admin ui on
+	Admin string `yaml:"admin"`
+	// AdminSocket is the path of a unix socket to serve the admin ui on. only the owner can connect
+	AdminSocket string `yaml:"admin_socket"`
+	// Public is the address to serve the read-only public status page on
+	Public string `yaml:"public"`
+	// H2C serves plaintext http/2 for deployments behind a proxy that doesn't terminate it. it can't be used with tls
+	H2C bool `yaml:"h2c"`
+}
+
+// TLS is the tls configuration
+// the server listens with tls when both files are set
+type TLS struct {
+	// CertFile is the path to the pem encoded certificate
+	CertFile string `yaml:"cert_file"`
+	// KeyFile is the path to the pem encoded private key
+	KeyFile string `yaml:"key_file"`
+	// ReloadInterval is how often the certificate and key are checked for changes. 0 disables reloading
+	ReloadInterval time.Duration `yaml:"reload_interval"`
+	// ClientAuth is none, optional or require. optional and require verify client certificates against ClientCAFile
+	ClientAuth string `yaml:"client_auth"`
+	// ClientCAFile is the path to the pem encoded certificate authorities that sign client certificates
+	ClientCAFile string `yaml:"client_ca_file"`
+	// ClientIdentities maps client certificate subjects to api identities. it can only be set in the config file
+	ClientIdentities []ClientIdentity `yaml:"client_identities"`
+}
+
+// ClientIdentity maps a client certificate subject to an api identity
+type ClientIdentity struct {
+	// Subject is the full subject, i.e. CN=ci,O=Example, or just the common name of the certificate
+	Subject string `yaml:"subject"`
+	// Identity is the name the caller is known by
+	Identity string `yaml:"identity"`
+}
+
+// ClientAuthType returns the [tls.ClientAuthType] for ClientAuth
+func (t TLS) ClientAuthType() (tls.ClientAuthType, error) {
+	switch t.ClientAuth {
+	case "", "none":
+		return tls.NoClientCert, nil
+	case "optional":
+		return tls.VerifyClientCertIfGiven, nil
+	case "require":
+		return tls.RequireAndVerifyClientCert, nil
+	default:
+		return tls.NoClientCert, serrors.NewError("tls.client_auth "+t.ClientAuth, serrors.ErrInvalidData)
+	}
+}
+
+// Identities returns ClientIdentities as a map of subject to identity
+func (t TLS) Identities() map[string]string {
+	identities := map[string]string{}
+	for _, ci := range t.ClientIdentities {
+		identities[ci.Subject] = ci.Identity
+	}
+	return identities
+}
+
+// SharedAdmin is true when the admin ui is served on the api listener
+func (l Listen) SharedAdmin() bool {
+	return l.Admin == "" && l.AdminSocket == ""
+}
+
+// Enabled is true when a certificate and key are configured
+func (t TLS) Enabled() bool {
+	return t.CertFile != "" && t.KeyFile != ""
+}
+
+// Log is the logging configuration
+type Log struct {
+	// Level is the minimum level logged: debug, info, warn or error
+	Level string `yaml:"level"`
+	// Format is json or text
+	Format string `yaml:"format"`
+}
+
+// Seed is the data created at startup
+type Seed struct {
+	// DefaultStatuses creates a default set of statuses if there are none
+	DefaultStatuses bool `yaml:"default_statuses"`
+	// PageFile is a page config file of statuses and items to apply
+	PageFile string `yaml:"page_file"`
+	// PagePrune deletes statuses and items that aren't in PageFile
+	PagePrune bool `yaml:"page_prune"`
+}
+
+// Backup is the periodic backup configuration
+type Backup struct {
+	// Dir is the directory backups are written to
+	Dir string `yaml:"dir"`
+	// Interval is how often a backup is written. 0 disables periodic backups
+	Interval time.Duration `yaml:"interval"`
+	// Retention is how many backups are kept in Dir
+	Retention int `yaml:"retention"`
+}
+
+// Retention is the pruning configuration
+type Retention struct {
+	// NoteMaxAge prunes notes older than this. 0 keeps notes forever
+	NoteMaxAge time.Duration `yaml:"note_max_age"`
+	// NoteMaxCount prunes all but this many of the newest unpinned notes on each item. 0 keeps every note
+	NoteMaxCount int `yaml:"note_max_count"`
+	// PruneInterval is how often data is pruned. 0 disables the pruner
+	PruneInterval time.Duration `yaml:"prune_interval"`
+}
+
+// Attachments is the note attachment configuration
+type Attachments struct {
+	// Dir is the directory attachment contents are kept in. empty keeps them in the database
+	Dir string `yaml:"dir"`
+	// MaxSize is the largest attachment in bytes
+	MaxSize int `yaml:"max_size"`
+	// Types are the content types that can be attached
+	Types []string `yaml:"types"`
+}
+
+// Default returns the default [Config]
+func Default() *Config {
+	return &Config{
+		DB:             DB{Driver: "sqlite3", DSN: "statusthing.db"},
+		Listen:         Listen{API: "127.0.0.1:9000"},
+		TLS:            TLS{ReloadInterval: defaultReloadInterval, ClientAuth: "none"},
+		Log:            Log{Level: "info", Format: "json"},
+		Session:        session.DefaultConfig(),
+		OIDC:           oidc.DefaultConfig(),
+		Backup:         Backup{Retention: services.DefaultBackupRetention},
+		Retention:      Retention{PruneInterval: defaultPruneInterval},
+		Attachments:    Attachments{MaxSize: int(services.DefaultAttachmentMaxSize), Types: append([]string{}, services.DefaultAttachmentTypes...)},
+		IdempotencyTTL: services.DefaultIdempotencyTTL,
+	}
+}
+
+// LoadFile reads the yaml, json or toml file at the provided path over the current values
+// files ending in .toml are read as toml and anything else as yaml
+// keys missing from the file keep their current values
+func (c *Config) LoadFile(path string) error {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return serrors.NewWrappedError("config file", serrors.ErrNotFound, err)
+	}
+	if strings.EqualFold(filepath.Ext(path), ".toml") {
+		return c.ParseTOML(b)
+	}
+	return c.Parse(b)
+}
+
+// Parse reads the provided yaml or json over the current values
+// unknown keys are an error
+func (c *Config) Parse(data []byte) error {
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(c); err != nil && !errors.Is(err, io.EOF) {
+		return serrors.NewWrappedError("config", serrors.ErrInvalidData, err)
+	}
+	return nil
+}
+
+// ParseTOML reads the provided toml over the current values
+// keys are the same as in yaml and unknown keys are an error
+func (c *Config) ParseTOML(data []byte) error {
+	values, err := parseTOML(string(data))
+	if err != nil {
+		return serrors.NewWrappedError("config", serrors.ErrInvalidData, err)
+	}
+	b, err := yaml.Marshal(values)
+	if err != nil {
+		return serrors.NewWrappedError("config", serrors.ErrInvalidData, err)
+	}
+	dec := yaml.NewDecoder(bytes.NewReader(b))
+	dec.KnownFields(true)
+	if err := dec.Decode(c); err != nil {
+		// the line numbers are of the yaml the toml was converted to so they're left out
+		var typeErr *yaml.TypeError
+		if errors.As(err, &typeErr) {
+			msgs := make([]string, len(typeErr.Errors))
+			for i, msg := range typeErr.Errors {
+				msgs[i] = yamlLinePrefix.ReplaceAllString(msg, "")
+			}
+			err = errors.New(strings.Join(msgs, "; "))
+		}
+		return serrors.NewWrappedError("config", serrors.ErrInvalidData, err)
+	}
+	return nil
+}
+
+// yamlLinePrefix is the position yaml puts in front of each decoding error
+var yamlLinePrefix = regexp.MustCompile(`^line \d+: `)
+
+// ApplyEnv sets values from STATUSTHING_* environment variables found with the provided lookup, usually [os.LookupEnv]
+func (c *Config) ApplyEnv(lookup func(string) (string, bool)) error {
+	return walk(reflect.ValueOf(c).Elem(), strings.TrimSuffix(EnvPrefix, "_"), func(name string, field reflect.Value) error {
+		value, ok := lookup(name)
+		if !ok {
+			return nil
+		}
+		if err := setField(field, value); err != nil {
+			return serrors.NewWrappedError(name, serrors.ErrInvalidData, err)
+		}
+		return nil
+	})
+}
+
+// EnvVars returns the name of every environment variable read by [Config.ApplyEnv]
+func EnvVars() []string {
+	names := []string{}
+	_ = walk(reflect.ValueOf(Default()).Elem(), strings.TrimSuffix(EnvPrefix, "_"), func(name string, _ reflect.Value) error {
+		names = append(names, name)
+		return nil
+	})
+	return names
+}
+
+// Validate checks the [Config] for errors
+func (c *Config) Validate() error {
+	switch c.DB.Driver {
+	case "sqlite", "sqlite3":
+	default:
+		return serrors.NewError("db.driver "+c.DB.Driver, serrors.ErrNotImplemented)
+	}
+	if strings.TrimSpace(c.DB.DSN) == "" {
+		return serrors.NewError("db.dsn", serrors.ErrEmptyString)
+	}
+	if strings.TrimSpace(c.Listen.API+c.Listen.Admin+c.Listen.AdminSocket+c.Listen.Public) == "" {
+		return serrors.NewError("listen", serrors.ErrAtLeastOne)
+	}
+	if (c.TLS.CertFile == "") != (c.TLS.KeyFile == "") {
+		return serrors.NewError("tls.cert_file and tls.key_file must be set together", serrors.ErrInvalidData)
+	}
+	clientAuth, err := c.TLS.ClientAuthType()
+	if err != nil {
+		return err
+	}
+	if clientAuth != tls.NoClientCert && (!c.TLS.Enabled() || c.TLS.ClientCAFile == "") {
+		return serrors.NewError("tls.client_auth requires tls.cert_file, tls.key_file and tls.client_ca_file", serrors.ErrInvalidData)
+	}
+	for _, ci := range c.TLS.ClientIdentities {
+		if strings.TrimSpace(ci.Subject) == "" || strings.TrimSpace(ci.Identity) == "" {
+			return serrors.NewError("tls.client_identities", serrors.ErrEmptyString)
+		}
+	}
+	if c.Listen.H2C && c.TLS.Enabled() {
+		return serrors.NewError("listen.h2c can't be used with tls", serrors.ErrInvalidData)
+	}
+	if _, err := c.Log.level(); err != nil {
+		return err
+	}
+	switch c.Log.Format {
+	case "json", "text":
+	default:
+		return serrors.NewError("log.format "+c.Log.Format, serrors.ErrInvalidData)
+	}
+	if err := c.Session.Validate(); err != nil {
+		return serrors.NewWrappedError("session", serrors.ErrInvalidData, err)
+	}
+	if err := c.OIDC.Validate(); err != nil {
+		return serrors.NewWrappedError("oidc", serrors.ErrInvalidData, err)
+	}
+	if c.Backup.Interval > 0 && c.Backup.Dir == "" {
+		return serrors.NewError("backup.interval requires backup.dir", serrors.ErrInvalidData)
+	}
+	if c.Backup.Interval < 0 || c.Retention.PruneInterval < 0 || c.TLS.ReloadInterval < 0 {
+		return serrors.NewError("interval", serrors.ErrAtLeastOne)
+	}
+	if c.Attachments.MaxSize < 1 {
+		return serrors.NewError("attachments.max_size", serrors.ErrAtLeastOne)
+	}
+	for _, ct := range c.Attachments.Types {
+		if _, _, err := mime.ParseMediaType(ct); err != nil {
+			return serrors.NewWrappedError("attachments.types "+ct, serrors.ErrInvalidData, err)
+		}
+	}
+	return nil
+}
+
+// LogHandler returns a [slog.Handler] writing to the provided writer with the configured level and format
+func (c *Config) LogHandler(w io.Writer) (slog.Handler, error) {
+	level, err := c.Log.level()
+	if err != nil {
+		return nil, err
+	}
+	opts := &slog.HandlerOptions{Level: level}
+	if c.Log.Format == "text" {
+		return slog.NewTextHandler(w, opts), nil
+	}
+	return slog.NewJSONHandler(w, opts), nil
+}
+
+func (l Log) level() (slog.Level, error) {
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(l.Level)); err != nil {
+		return level, serrors.NewWrappedError("log.level "+l.Level, serrors.ErrInvalidData, err)
+	}
+	return level, nil
+}
+
+// walk calls fn with the environment variable name of every settable field in v
+// lists of strings are set as comma separated values. other lists and maps can't be set from the environment and are skipped
+func walk(v reflect.Value, prefix string, fn func(name string, field reflect.Value) error) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		tag := strings.Split(t.Field(i).Tag.Get("yaml"), ",")[0]
+		if tag == "" || tag == "-" {
+			continue
+		}
+		name := prefix + "_" + strings.ToUpper(tag)
+		field := v.Field(i)
+		if field.Kind() == reflect.Map || field.Kind() == reflect.Slice && field.Type().Elem().Kind() != reflect.String {
+			continue
+		}
+		if field.Kind() == reflect.Struct {
+			if err := walk(field, name, fn); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := fn(name, field); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+func setField(field reflect.Value, value string) error {
+	switch {
+	case field.Type() == durationType:
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return err
+		}
+		field.SetInt(int64(d))
+	case field.Kind() == reflect.String:
+		field.SetString(value)
+	case field.Kind() == reflect.Bool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		field.SetBool(b)
+	case field.Kind() == reflect.Int:
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return err
+		}
+		field.SetInt(int64(n))
+	case field.Kind() == reflect.Slice && field.Type().Elem().Kind() == reflect.String:
+		values := []string{}
+		for _, v := range strings.Split(value, ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				values = append(values, v)
+			}
+		}
+		field.Set(reflect.ValueOf(values).Convert(field.Type()))
+	default:
+		return fmt.Errorf("unsupported type %s", field.Type())
+	}
+	return nil
+}
@@ -0,0 +1,224 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/lusis/statusthing/internal/serrors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "statusthing.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+db:
+  dsn: /var/lib/statusthing/statusthing.db
+listen:
+  api: 0.0.0.0:9000
+log:
+  level: debug
+session:
+  secure: true
+  lifetime: 8h
+retention:
+  note_max_count: 50
+`), 0o600))
+
+	cfg := Default()
+	require.NoError(t, cfg.LoadFile(path))
+	env := map[string]string{
+		"STATUSTHING_LISTEN_API":             "0.0.0.0:8080",
+		"STATUSTHING_SESSION_SAME_SITE":      "strict",
+		"STATUSTHING_RETENTION_NOTE_MAX_AGE": "720h",
+		"STATUSTHING_SEED_DEFAULT_STATUSES":  "true",
+	}
+	require.NoError(t, cfg.ApplyEnv(func(name string) (string, bool) {
+		v, ok := env[name]
+		return v, ok
+	}))
+	require.NoError(t, cfg.Validate())
+
+	require.Equal(t, "sqlite3", cfg.DB.Driver, "defaults should be kept")
+	require.Equal(t, "/var/lib/statusthing/statusthing.db", cfg.DB.DSN)
+	require.Equal(t, "0.0.0.0:8080", cfg.Listen.API, "env should override the file")
+	require.Equal(t, "debug", cfg.Log.Level)
+	require.Equal(t, "json", cfg.Log.Format)
+	require.True(t, cfg.Session.Secure)
+	require.Equal(t, 8*time.Hour, cfg.Session.Lifetime)
+	require.Equal(t, "strict", cfg.Session.SameSite)
+	require.Equal(t, 50, cfg.Retention.NoteMaxCount)
+	require.Equal(t, 720*time.Hour, cfg.Retention.NoteMaxAge)
+	require.Equal(t, time.Hour, cfg.Retention.PruneInterval)
+	require.True(t, cfg.Seed.DefaultStatuses)
+
+	t.Run("missing-file", func(t *testing.T) {
+		require.ErrorIs(t, Default().LoadFile(filepath.Join(t.TempDir(), "missing.yaml")), serrors.ErrNotFound)
+	})
+	t.Run("unknown-key", func(t *testing.T) {
+		require.ErrorIs(t, Default().Parse([]byte("listen:\n  grpc: localhost:9001\n")), serrors.ErrInvalidData)
+	})
+	t.Run("bad-env", func(t *testing.T) {
+		err := Default().ApplyEnv(func(name string) (string, bool) {
+			return "forever", name == "STATUSTHING_BACKUP_INTERVAL"
+		})
+		require.ErrorIs(t, err, serrors.ErrInvalidData)
+	})
+	t.Run("env-vars", func(t *testing.T) {
+		names := EnvVars()
+		require.Contains(t, names, "STATUSTHING_DB_DSN")
+		require.Contains(t, names, "STATUSTHING_SESSION_COOKIE_NAME")
+		require.Contains(t, names, "STATUSTHING_IDEMPOTENCY_TTL")
+		require.Contains(t, names, "STATUSTHING_OIDC_ADMIN_GROUPS")
+		require.NotContains(t, names, "STATUSTHING_TLS_CLIENT_IDENTITIES", "lists of tables can only be set in the file")
+	})
+	t.Run("env-lists", func(t *testing.T) {
+		cfg := Default()
+		env := map[string]string{
+			"STATUSTHING_ATTACHMENTS_TYPES":  "image/png, text/plain,",
+			"STATUSTHING_OIDC_ADMIN_GROUPS":  "ops",
+			"STATUSTHING_OIDC_VIEWER_GROUPS": "",
+		}
+		require.NoError(t, cfg.ApplyEnv(func(name string) (string, bool) {
+			v, ok := env[name]
+			return v, ok
+		}))
+		require.Equal(t, []string{"image/png", "text/plain"}, cfg.Attachments.Types)
+		require.Equal(t, []string{"ops"}, cfg.OIDC.AdminGroups)
+		require.Empty(t, cfg.OIDC.ViewerGroups)
+	})
+}
+
+func TestLoadTOML(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "statusthing.toml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+idempotency_ttl = "12h" # durations are strings
+
+[db]
+dsn = '/var/lib/statusthing/statusthing.db'
+
+[listen]
+api = "0.0.0.0:9000"
+h2c = true
+
+[session]
+cookie_name = "st\u0073ession"
+lifetime = "8h"
+
+[retention]
+note_max_count = 1_000
+
+[attachments]
+types = [
+  "image/png", # comments can follow values
+  "text/plain",
+]
+
+[[tls.client_identities]]
+subject = "CN=ci,O=Example"
+identity = "ci-bot"
+
+[[tls.client_identities]]
+subject = "deploy"
+identity = "deploy-bot"
+
+[oidc]
+admin_groups = ["ops"]
+`), 0o600))
+
+	cfg := Default()
+	require.NoError(t, cfg.LoadFile(path))
+	require.Equal(t, "sqlite3", cfg.DB.Driver, "defaults should be kept")
+	require.Equal(t, "/var/lib/statusthing/statusthing.db", cfg.DB.DSN)
+	require.Equal(t, "0.0.0.0:9000", cfg.Listen.API)
+	require.True(t, cfg.Listen.H2C)
+	require.Equal(t, "stsession", cfg.Session.CookieName)
+	require.Equal(t, 8*time.Hour, cfg.Session.Lifetime)
+	require.Equal(t, 12*time.Hour, cfg.IdempotencyTTL)
+	require.Equal(t, 1000, cfg.Retention.NoteMaxCount)
+	require.Equal(t, []string{"image/png", "text/plain"}, cfg.Attachments.Types)
+	require.Equal(t, []ClientIdentity{{Subject: "CN=ci,O=Example", Identity: "ci-bot"}, {Subject: "deploy", Identity: "deploy-bot"}}, cfg.TLS.ClientIdentities)
+	require.Equal(t, []string{"ops"}, cfg.OIDC.AdminGroups)
+
+	testCases := map[string]string{
+		"unknown-key":        "[listen]\ngrpc = \"localhost:9001\"\n",
+		"duplicate-key":      "[db]\ndsn = \"a\"\ndsn = \"b\"\n",
+		"unterminated":       "[db]\ndsn = \"a\n",
+		"trailing-text":      "[db]\ndsn = \"a\" b\n",
+		"multi-line-string":  "[db]\ndsn = \"\"\"a\"\"\"\n",
+		"date":               "[db]\ndsn = 2023-06-01\n",
+		"table-over-value":   "db = 1\n[db]\n",
+		"wrong-type":         "[retention]\nnote_max_count = \"many\"\n",
+		"unclosed-table":     "[db\n",
+		"inline-table-comma": "tls = { cert_file = \"a\" key_file = \"b\" }\n",
+	}
+	for n, tc := range testCases {
+		t.Run(n, func(t *testing.T) {
+			require.ErrorIs(t, Default().ParseTOML([]byte(tc)), serrors.ErrInvalidData)
+		})
+	}
+	t.Run("error-lines", func(t *testing.T) {
+		err := Default().ParseTOML([]byte("\n\n[listen]\ngrpc = \"localhost:9001\"\n"))
+		require.ErrorContains(t, err, "field grpc not found")
+		require.NotContains(t, err.Error(), "line", "yaml line numbers don't match the toml")
+		require.ErrorContains(t, Default().ParseTOML([]byte("\n\n[db]\ndsn = 2023-06-01\n")), "line 4")
+	})
+	t.Run("inline-table", func(t *testing.T) {
+		cfg := Default()
+		require.NoError(t, cfg.ParseTOML([]byte("tls = { cert_file = \"cert.pem\", key_file = \"key.pem\" }\nlog.level = \"debug\"\n")))
+		require.Equal(t, "cert.pem", cfg.TLS.CertFile)
+		require.Equal(t, "key.pem", cfg.TLS.KeyFile)
+		require.Equal(t, "debug", cfg.Log.Level)
+	})
+}
+
+func TestValidate(t *testing.T) {
+	testCases := map[string]struct {
+		modify func(*Config)
+		err    error
+	}{
+		"defaults":       {modify: func(c *Config) {}},
+		"unknown-driver": {modify: func(c *Config) { c.DB.Driver = "postgres" }, err: serrors.ErrNotImplemented},
+		"empty-dsn":      {modify: func(c *Config) { c.DB.DSN = "" }, err: serrors.ErrEmptyString},
+		"no-listeners":   {modify: func(c *Config) { c.Listen.API = " " }, err: serrors.ErrAtLeastOne},
+		"admin-socket-only": {modify: func(c *Config) {
+			c.Listen = Listen{AdminSocket: "/run/statusthing/admin.sock"}
+		}},
+		"cert-without-key": {modify: func(c *Config) { c.TLS.CertFile = "cert.pem" }, err: serrors.ErrInvalidData},
+		"bad-client-auth":  {modify: func(c *Config) { c.TLS.ClientAuth = "sometimes" }, err: serrors.ErrInvalidData},
+		"client-auth-no-ca": {modify: func(c *Config) {
+			c.TLS = TLS{CertFile: "cert.pem", KeyFile: "key.pem", ClientAuth: "require"}
+		}, err: serrors.ErrInvalidData},
+		"mtls": {modify: func(c *Config) {
+			c.TLS = TLS{CertFile: "cert.pem", KeyFile: "key.pem", ClientAuth: "require", ClientCAFile: "ca.pem", ClientIdentities: []ClientIdentity{{Subject: "ci", Identity: "ci-bot"}}}
+		}},
+		"empty-identity": {modify: func(c *Config) {
+			c.TLS = TLS{CertFile: "cert.pem", KeyFile: "key.pem", ClientAuth: "optional", ClientCAFile: "ca.pem", ClientIdentities: []ClientIdentity{{Subject: "ci"}}}
+		}, err: serrors.ErrEmptyString},
+		"h2c-with-tls": {modify: func(c *Config) {
+			c.Listen.H2C = true
+			c.TLS = TLS{CertFile: "cert.pem", KeyFile: "key.pem"}
+		}, err: serrors.ErrInvalidData},
+		"bad-level":     {modify: func(c *Config) { c.Log.Level = "loud" }, err: serrors.ErrInvalidData},
+		"bad-format":    {modify: func(c *Config) { c.Log.Format = "xml" }, err: serrors.ErrInvalidData},
+		"bad-same-site": {modify: func(c *Config) { c.Session.SameSite = "sometimes" }, err: serrors.ErrInvalidData},
+		"backup-no-dir": {modify: func(c *Config) { c.Backup.Interval = time.Hour }, err: serrors.ErrInvalidData},
+		"zero-max-size": {modify: func(c *Config) { c.Attachments.MaxSize = 0 }, err: serrors.ErrAtLeastOne},
+		"bad-attachment-type": {modify: func(c *Config) {
+			c.Attachments.Types = []string{"image/png", "not a type/"}
+		}, err: serrors.ErrInvalidData},
+	}
+	for n, tc := range testCases {
+		t.Run(n, func(t *testing.T) {
+			cfg := Default()
+			tc.modify(cfg)
+			err := cfg.Validate()
+			if tc.err == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tc.err)
+		})
+	}
+}
@@ -0,0 +1,339 @@
+package config
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// parseTOML reads the subset of toml a config file needs into the same shape yaml decodes to
+// supported are tables, arrays of tables, dotted keys, strings, integers, floats, booleans, arrays and inline tables
+// multi-line strings and dates aren't. durations are strings like in yaml
+func parseTOML(data string) (map[string]any, error) {
+	p := &tomlParser{data: data, line: 1}
+	root := map[string]any{}
+	current := root
+	for {
+		p.skipBlank(true)
+		if p.done() {
+			return root, nil
+		}
+		var err error
+		switch {
+		case strings.HasPrefix(p.data[p.pos:], "[["):
+			p.pos += 2
+			current, err = p.arrayTable(root)
+		case p.data[p.pos] == '[':
+			p.pos++
+			current, err = p.table(root)
+		default:
+			err = p.keyValue(current)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", p.line, err)
+		}
+		if err := p.endOfLine(); err != nil {
+			return nil, fmt.Errorf("line %d: %w", p.line, err)
+		}
+	}
+}
+
+type tomlParser struct {
+	data string
+	pos  int
+	line int
+}
+
+func (p *tomlParser) done() bool {
+	return p.pos >= len(p.data)
+}
+
+// skipBlank skips spaces and comments, and newlines too when newlines is true
+func (p *tomlParser) skipBlank(newlines bool) {
+	for !p.done() {
+		switch p.data[p.pos] {
+		case ' ', '\t', '\r':
+			p.pos++
+		case '\n':
+			if !newlines {
+				return
+			}
+			p.line++
+			p.pos++
+		case '#':
+			for !p.done() && p.data[p.pos] != '\n' {
+				p.pos++
+			}
+		default:
+			return
+		}
+	}
+}
+
+func (p *tomlParser) endOfLine() error {
+	p.skipBlank(false)
+	if p.done() {
+		return nil
+	}
+	if p.data[p.pos] != '\n' {
+		return fmt.Errorf("unexpected %q after value", p.data[p.pos])
+	}
+	return nil
+}
+
+func (p *tomlParser) expect(c byte) error {
+	p.skipBlank(false)
+	if p.done() || p.data[p.pos] != c {
+		return fmt.Errorf("expected %q", c)
+	}
+	p.pos++
+	return nil
+}
+
+// key reads a bare, quoted or dotted key
+func (p *tomlParser) key() ([]string, error) {
+	parts := []string{}
+	for {
+		p.skipBlank(false)
+		if p.done() {
+			return nil, fmt.Errorf("expected a key")
+		}
+		var part string
+		switch p.data[p.pos] {
+		case '"', '\'':
+			s, err := p.str()
+			if err != nil {
+				return nil, err
+			}
+			part = s
+		default:
+			start := p.pos
+			for !p.done() && isBareKeyChar(p.data[p.pos]) {
+				p.pos++
+			}
+			if start == p.pos {
+				return nil, fmt.Errorf("unexpected %q in key", p.data[p.pos])
+			}
+			part = p.data[start:p.pos]
+		}
+		parts = append(parts, part)
+		p.skipBlank(false)
+		if p.done() || p.data[p.pos] != '.' {
+			return parts, nil
+		}
+		p.pos++
+	}
+}
+
+func isBareKeyChar(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_' || c == '-'
+}
+
+func (p *tomlParser) table(root map[string]any) (map[string]any, error) {
+	path, err := p.key()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expect(']'); err != nil {
+		return nil, err
+	}
+	return subtable(root, path)
+}
+
+func (p *tomlParser) arrayTable(root map[string]any) (map[string]any, error) {
+	path, err := p.key()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expect(']'); err != nil {
+		return nil, err
+	}
+	if err := p.expect(']'); err != nil {
+		return nil, err
+	}
+	parent, err := subtable(root, path[:len(path)-1])
+	if err != nil {
+		return nil, err
+	}
+	name := path[len(path)-1]
+	existing, ok := parent[name]
+	if !ok {
+		existing = []any{}
+	}
+	list, ok := existing.([]any)
+	if !ok {
+		return nil, fmt.Errorf("%s is not an array of tables", strings.Join(path, "."))
+	}
+	table := map[string]any{}
+	parent[name] = append(list, table)
+	return table, nil
+}
+
+// subtable returns the table at path under t, creating any that are missing
+// a path ending in an array of tables continues in its last table
+func subtable(t map[string]any, path []string) (map[string]any, error) {
+	for i, name := range path {
+		switch v := t[name].(type) {
+		case nil:
+			next := map[string]any{}
+			t[name] = next
+			t = next
+		case map[string]any:
+			t = v
+		case []any:
+			last, ok := any(nil), false
+			if len(v) > 0 {
+				last = v[len(v)-1]
+			}
+			if t, ok = last.(map[string]any); !ok {
+				return nil, fmt.Errorf("%s is not a table", strings.Join(path[:i+1], "."))
+			}
+		default:
+			return nil, fmt.Errorf("%s is not a table", strings.Join(path[:i+1], "."))
+		}
+	}
+	return t, nil
+}
+
+func (p *tomlParser) keyValue(t map[string]any) error {
+	path, err := p.key()
+	if err != nil {
+		return err
+	}
+	if err := p.expect('='); err != nil {
+		return err
+	}
+	value, err := p.value()
+	if err != nil {
+		return err
+	}
+	parent, err := subtable(t, path[:len(path)-1])
+	if err != nil {
+		return err
+	}
+	name := path[len(path)-1]
+	if _, ok := parent[name]; ok {
+		return fmt.Errorf("%s is defined more than once", strings.Join(path, "."))
+	}
+	parent[name] = value
+	return nil
+}
+
+func (p *tomlParser) value() (any, error) {
+	p.skipBlank(false)
+	if p.done() {
+		return nil, fmt.Errorf("expected a value")
+	}
+	switch p.data[p.pos] {
+	case '"', '\'':
+		return p.str()
+	case '[':
+		p.pos++
+		return p.array()
+	case '{':
+		p.pos++
+		return p.inlineTable()
+	}
+	start := p.pos
+	for !p.done() && !strings.ContainsRune(" \t\r\n,]}#", rune(p.data[p.pos])) {
+		p.pos++
+	}
+	token := p.data[start:p.pos]
+	switch token {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	}
+	number := strings.ReplaceAll(token, "_", "")
+	if n, err := strconv.ParseInt(number, 0, 64); err == nil {
+		return n, nil
+	}
+	if f, err := strconv.ParseFloat(number, 64); err == nil {
+		return f, nil
+	}
+	return nil, fmt.Errorf("unsupported value %q", token)
+}
+
+func (p *tomlParser) str() (string, error) {
+	quote := p.data[p.pos]
+	if strings.HasPrefix(p.data[p.pos:], strings.Repeat(string(quote), 3)) {
+		return "", fmt.Errorf("multi-line strings are not supported")
+	}
+	start := p.pos
+	p.pos++
+	for !p.done() && p.data[p.pos] != quote && p.data[p.pos] != '\n' {
+		if quote == '"' && p.data[p.pos] == '\\' {
+			p.pos++
+		}
+		p.pos++
+	}
+	if p.done() || p.data[p.pos] != quote {
+		return "", fmt.Errorf("unterminated string")
+	}
+	p.pos++
+	if quote == '\'' {
+		return p.data[start+1 : p.pos-1], nil
+	}
+	s, err := strconv.Unquote(p.data[start:p.pos])
+	if err != nil {
+		return "", fmt.Errorf("invalid string %s: %w", p.data[start:p.pos], err)
+	}
+	return s, nil
+}
+
+// array reads the values up to the closing bracket. arrays can span lines
+func (p *tomlParser) array() ([]any, error) {
+	values := []any{}
+	for {
+		p.skipBlank(true)
+		if p.done() {
+			return nil, fmt.Errorf("unterminated array")
+		}
+		if p.data[p.pos] == ']' {
+			p.pos++
+			return values, nil
+		}
+		v, err := p.value()
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, v)
+		p.skipBlank(true)
+		if !p.done() && p.data[p.pos] == ',' {
+			p.pos++
+			continue
+		}
+		if p.done() || p.data[p.pos] != ']' {
+			return nil, fmt.Errorf("expected ',' or ']' in array")
+		}
+	}
+}
+
+// inlineTable reads key/value pairs up to the closing brace. inline tables can't span lines
+func (p *tomlParser) inlineTable() (map[string]any, error) {
+	t := map[string]any{}
+	p.skipBlank(false)
+	if !p.done() && p.data[p.pos] == '}' {
+		p.pos++
+		return t, nil
+	}
+	for {
+		if err := p.keyValue(t); err != nil {
+			return nil, err
+		}
+		p.skipBlank(false)
+		if p.done() {
+			return nil, fmt.Errorf("unterminated inline table")
+		}
+		switch p.data[p.pos] {
+		case ',':
+			p.pos++
+		case '}':
+			p.pos++
+			return t, nil
+		default:
+			return nil, fmt.Errorf("expected ',' or '}' in inline table")
+		}
+	}
+}
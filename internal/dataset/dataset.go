@@ -0,0 +1,124 @@
+// Package dataset reads and writes [statusthingv1.Dataset] files
+package dataset
+
+import (
+	"bytes"
+	"encoding/json"
+	"path/filepath"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/yaml.v3"
+
+	statusthingv1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
+	"github.com/lusis/statusthing/internal/serrors"
+)
+
+// Format is a supported file format
+type Format string
+
+const (
+	// FormatJSON is protojson formatted JSON
+	FormatJSON Format = "json"
+	// FormatYAML is protojson formatted JSON converted to YAML
+	FormatYAML Format = "yaml"
+)
+
+// FormatFromFilename returns the [Format] for the provided filename based on its extension
+// [FormatJSON] is returned for anything that isn't yaml
+func FormatFromFilename(filename string) Format {
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".yaml", ".yml":
+		return FormatYAML
+	default:
+		return FormatJSON
+	}
+}
+
+// ParseFormat returns the [Format] with the provided name
+func ParseFormat(name string) (Format, error) {
+	switch f := Format(strings.ToLower(strings.TrimSpace(name))); f {
+	case FormatJSON, FormatYAML:
+		return f, nil
+	case "yml":
+		return FormatYAML, nil
+	case "":
+		return "", serrors.NewError("format", serrors.ErrEmptyString)
+	default:
+		return "", serrors.NewError("format "+name, serrors.ErrUnknownField)
+	}
+}
+
+// Marshal returns the provided [statusthingv1.Dataset] in the provided [Format]
+// field names are the protobuf json names so files can be read by anything that understands protojson
+func Marshal(ds *statusthingv1.Dataset, format Format) ([]byte, error) {
+	if ds == nil {
+		return nil, serrors.NewError("dataset", serrors.ErrNilVal)
+	}
+	return MarshalMessage(ds, format)
+}
+
+// MarshalMessage returns any [proto.Message] in the provided [Format] the same way [Marshal] does
+func MarshalMessage(msg proto.Message, format Format) ([]byte, error) {
+	b, err := protojson.MarshalOptions{Multiline: true, Indent: "  "}.Marshal(msg)
+	if err != nil {
+		return nil, serrors.NewWrappedError("json", serrors.ErrInvalidData, err)
+	}
+	switch format {
+	case FormatJSON:
+		return append(b, '\n'), nil
+	case FormatYAML:
+		// json is valid yaml so we round trip through a yaml node to get yaml output
+		var node yaml.Node
+		if err := yaml.Unmarshal(b, &node); err != nil {
+			return nil, serrors.NewWrappedError("yaml", serrors.ErrInvalidData, err)
+		}
+		clearStyle(&node)
+		var out bytes.Buffer
+		enc := yaml.NewEncoder(&out)
+		enc.SetIndent(2)
+		if err := enc.Encode(&node); err != nil {
+			return nil, serrors.NewWrappedError("yaml", serrors.ErrInvalidData, err)
+		}
+		if err := enc.Close(); err != nil {
+			return nil, serrors.NewWrappedError("yaml", serrors.ErrInvalidData, err)
+		}
+		return out.Bytes(), nil
+	default:
+		return nil, serrors.NewError("format "+string(format), serrors.ErrUnknownField)
+	}
+}
+
+// Unmarshal returns the [statusthingv1.Dataset] in the provided data
+func Unmarshal(data []byte, format Format) (*statusthingv1.Dataset, error) {
+	switch format {
+	case FormatJSON:
+	case FormatYAML:
+		var v any
+		if err := yaml.Unmarshal(data, &v); err != nil {
+			return nil, serrors.NewWrappedError("yaml", serrors.ErrInvalidData, err)
+		}
+		b, err := json.Marshal(v)
+		if err != nil {
+			return nil, serrors.NewWrappedError("yaml", serrors.ErrInvalidData, err)
+		}
+		data = b
+	default:
+		return nil, serrors.NewError("format "+string(format), serrors.ErrUnknownField)
+	}
+	ds := &statusthingv1.Dataset{}
+	if err := protojson.Unmarshal(data, ds); err != nil {
+		return nil, serrors.NewWrappedError("json", serrors.ErrInvalidData, err)
+	}
+	return ds, nil
+}
+
+// clearStyle removes the flow and quoting styles json input gives every node so the output is plain block style yaml
+// strings that would be read back as another type are still quoted by the encoder
+func clearStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, n := range node.Content {
+		clearStyle(n)
+	}
+}
@@ -0,0 +1,81 @@
+package dataset
+
+import (
+	"testing"
+
+	statusthingv1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
+	"github.com/lusis/statusthing/internal/serrors"
+	"github.com/lusis/statusthing/internal/testutils"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestRoundTrip(t *testing.T) {
+	item := testutils.MakeItem(t.Name())
+	item.Notes = []*statusthingv1.Note{testutils.MakeNote(t.Name())}
+	// values that look like other types in yaml
+	item.Description = "123"
+	item.Notes[0].Text = "true"
+	ds := &statusthingv1.Dataset{
+		Statuses: []*statusthingv1.Status{testutils.MakeStatus(t.Name())},
+		Items:    []*statusthingv1.Item{item},
+		Users:    []*statusthingv1.User{testutils.MakeUser(t.Name())},
+	}
+	for _, format := range []Format{FormatJSON, FormatYAML} {
+		t.Run(string(format), func(t *testing.T) {
+			b, err := Marshal(ds, format)
+			require.NoError(t, err)
+			require.Contains(t, string(b), "statuses")
+			res, err := Unmarshal(b, format)
+			require.NoError(t, err)
+			require.True(t, proto.Equal(ds, res), "dataset should survive a round trip")
+		})
+	}
+	t.Run("yaml-is-block-style", func(t *testing.T) {
+		b, err := Marshal(ds, FormatYAML)
+		require.NoError(t, err)
+		require.NotContains(t, string(b), "{")
+	})
+	t.Run("any-message", func(t *testing.T) {
+		b, err := MarshalMessage(&statusthingv1.Item{Name: "api"}, FormatYAML)
+		require.NoError(t, err)
+		require.Equal(t, "name: api\n", string(b))
+	})
+	t.Run("nil-dataset", func(t *testing.T) {
+		_, err := Marshal(nil, FormatJSON)
+		require.ErrorIs(t, err, serrors.ErrNilVal)
+	})
+	t.Run("invalid-data", func(t *testing.T) {
+		_, err := Unmarshal([]byte(`{"statuses": "nope"}`), FormatJSON)
+		require.ErrorIs(t, err, serrors.ErrInvalidData)
+		_, err = Unmarshal([]byte("statuses: [\n"), FormatYAML)
+		require.ErrorIs(t, err, serrors.ErrInvalidData)
+	})
+}
+
+func TestFormats(t *testing.T) {
+	testCases := map[string]struct {
+		name     string
+		filename string
+		expected Format
+		err      error
+	}{
+		"json":       {name: "json", filename: "export.json", expected: FormatJSON},
+		"yaml":       {name: "YAML", filename: "export.yaml", expected: FormatYAML},
+		"yml":        {name: "yml", filename: "export.YML", expected: FormatYAML},
+		"no-ext":     {name: "xml", filename: "export", expected: FormatJSON, err: serrors.ErrUnknownField},
+		"empty-name": {name: "", filename: "-", expected: FormatJSON, err: serrors.ErrEmptyString},
+	}
+	for n, tc := range testCases {
+		t.Run(n, func(t *testing.T) {
+			require.Equal(t, tc.expected, FormatFromFilename(tc.filename))
+			f, err := ParseFormat(tc.name)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, f)
+		})
+	}
+}
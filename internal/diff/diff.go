@@ -0,0 +1,122 @@
+// Package diff compares text line by line
+package diff
+
+import "strings"
+
+// maxTableSize is the most cells the table of common subsequences can have
+// larger changes show every changed line as deleted and inserted instead
+const maxTableSize = 250_000
+
+// Op is what happened to a [Line]
+type Op int
+
+const (
+	// Equal lines are in both texts
+	Equal Op = iota
+	// Insert lines are only in the new text
+	Insert
+	// Delete lines are only in the old text
+	Delete
+)
+
+// String returns the name of the op as used in css classes
+func (o Op) String() string {
+	switch o {
+	case Insert:
+		return "insert"
+	case Delete:
+		return "delete"
+	default:
+		return "equal"
+	}
+}
+
+// Line is a single line of a diff
+type Line struct {
+	Op   Op
+	Text string
+}
+
+// Lines returns the lines needed to turn the old text into the new text
+// deleted lines come before the lines inserted in their place
+func Lines(oldText, newText string) []Line {
+	a, b := split(oldText), split(newText)
+	// lines shared at the start and end don't need the table
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+	res := []Line{}
+	for _, text := range a[:prefix] {
+		res = append(res, Line{Op: Equal, Text: text})
+	}
+	res = append(res, changed(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix])...)
+	for _, text := range a[len(a)-suffix:] {
+		res = append(res, Line{Op: Equal, Text: text})
+	}
+	return res
+}
+
+// changed returns the lines needed to turn a into b
+func changed(a, b []string) []Line {
+	res := []Line{}
+	if (len(a)+1)*(len(b)+1) > maxTableSize {
+		for _, text := range a {
+			res = append(res, Line{Op: Delete, Text: text})
+		}
+		for _, text := range b {
+			res = append(res, Line{Op: Insert, Text: text})
+		}
+		return res
+	}
+	// lcs[i][j] is the length of the longest common subsequence of a[i:] and b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			switch {
+			case a[i] == b[j]:
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			case lcs[i+1][j] >= lcs[i][j+1]:
+				lcs[i][j] = lcs[i+1][j]
+			default:
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			res = append(res, Line{Op: Equal, Text: a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			res = append(res, Line{Op: Delete, Text: a[i]})
+			i++
+		default:
+			res = append(res, Line{Op: Insert, Text: b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		res = append(res, Line{Op: Delete, Text: a[i]})
+	}
+	for ; j < len(b); j++ {
+		res = append(res, Line{Op: Insert, Text: b[j]})
+	}
+	return res
+}
+
+func split(text string) []string {
+	if text == "" {
+		return nil
+	}
+	return strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+}
@@ -0,0 +1,68 @@
+package diff
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLines(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]struct {
+		old      string
+		new      string
+		expected []Line
+	}{
+		"unchanged": {old: "a\nb", new: "a\nb", expected: []Line{{Equal, "a"}, {Equal, "b"}}},
+		"empty":     {expected: []Line{}},
+		"added":     {new: "a", expected: []Line{{Insert, "a"}}},
+		"removed":   {old: "a", expected: []Line{{Delete, "a"}}},
+		"changed": {
+			old:      "investigating\nall users\nmore soon",
+			new:      "investigating\nsome users\nmore soon",
+			expected: []Line{{Equal, "investigating"}, {Delete, "all users"}, {Insert, "some users"}, {Equal, "more soon"}},
+		},
+		"appended": {old: "a\nb", new: "a\nb\nc", expected: []Line{{Equal, "a"}, {Equal, "b"}, {Insert, "c"}}},
+		"crlf":     {old: "a\r\nb", new: "a\nb", expected: []Line{{Equal, "a"}, {Equal, "b"}}},
+		"middle": {
+			old:      "a\nb\nc\nd",
+			new:      "a\nx\nc\nd",
+			expected: []Line{{Equal, "a"}, {Delete, "b"}, {Insert, "x"}, {Equal, "c"}, {Equal, "d"}},
+		},
+		// the shared line in the middle isn't found when the change is too large to compare
+		"too-large": {
+			old: "start\n" + strings.Repeat("a\n", 300) + "same\n" + strings.Repeat("a\n", 300) + "end",
+			new: "start\n" + strings.Repeat("b\n", 300) + "same\n" + strings.Repeat("b\n", 300) + "end",
+			expected: join(
+				[]Line{{Equal, "start"}},
+				repeat(Delete, "a", 300), []Line{{Delete, "same"}}, repeat(Delete, "a", 300),
+				repeat(Insert, "b", 300), []Line{{Insert, "same"}}, repeat(Insert, "b", 300),
+				[]Line{{Equal, "end"}},
+			),
+		},
+	}
+	for n, tc := range testCases {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tc.expected, Lines(tc.old, tc.new))
+		})
+	}
+}
+
+func repeat(op Op, text string, n int) []Line {
+	res := []Line{}
+	for i := 0; i < n; i++ {
+		res = append(res, Line{op, text})
+	}
+	return res
+}
+
+func join(parts ...[]Line) []Line {
+	res := []Line{}
+	for _, p := range parts {
+		res = append(res, p...)
+	}
+	return res
+}
@@ -28,3 +28,24 @@ func (f *Filters) AvatarURL() string {
 	}
 	return *f.avatarURL
 }
+
+// WithAvatar provides an avatar image
+func WithAvatar(avatar []byte) FilterOption {
+	return func(f *Filters) error {
+		if len(avatar) == 0 {
+			return serrors.NewError("avatar", serrors.ErrAtLeastOne)
+		}
+		if f.avatar != nil {
+			return serrors.NewError("avatar", serrors.ErrAlreadySet)
+		}
+		f.avatar = avatar
+		return nil
+	}
+}
+
+// Avatar returns the configured avatar image
+func (f *Filters) Avatar() []byte {
+	f.l.RLock()
+	defer f.l.RUnlock()
+	return f.avatar
+}
@@ -0,0 +1,35 @@
+package filters
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/lusis/statusthing/internal/serrors"
+)
+
+// WithClearedFields provides the names of fields that should be explicitly cleared
+// names are the protobuf field names (i.e. description, parent_id) and it is up to the caller which fields can be cleared
+func WithClearedFields(fields ...string) FilterOption {
+	return func(f *Filters) error {
+		if len(fields) == 0 {
+			return fmt.Errorf("cleared fields: %w", serrors.ErrAtLeastOne)
+		}
+		if f.clearedFields != nil {
+			return fmt.Errorf("cleared fields: %w", serrors.ErrAlreadySet)
+		}
+		for _, field := range fields {
+			if strings.TrimSpace(field) == "" {
+				return fmt.Errorf("cleared fields: %w", serrors.ErrEmptyString)
+			}
+		}
+		f.clearedFields = fields
+		return nil
+	}
+}
+
+// ClearedFields returns the names of fields that should be cleared
+func (f *Filters) ClearedFields() []string {
+	f.l.RLock()
+	defer f.l.RUnlock()
+	return f.clearedFields
+}
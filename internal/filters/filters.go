@@ -58,6 +58,22 @@ type Filters struct {
 	avatar []byte
 	// stores a new password for a password change
 	password *string
+	// passwordChangeRequired stores whether a user has to change their password
+	passwordChangeRequired *bool
+	// role stores a [statusthingv1.Role]
+	role statusthingv1.Role
+	// parentID stores the id of a parent [statusthingv1.Item]
+	parentID *string
+	// rollupPolicy stores a [statusthingv1.RollupPolicy]
+	rollupPolicy statusthingv1.RollupPolicy
+	// expectedVersion stores the version a record is expected to have when updating it
+	expectedVersion *uint64
+	// noteVisibility stores a [statusthingv1.NoteVisibility]
+	noteVisibility statusthingv1.NoteVisibility
+	// pinned stores whether a [statusthingv1.Note] is pinned
+	pinned *bool
+	// clearedFields stores the names of fields that should be cleared
+	clearedFields []string
 }
 
 // New returns a new [Filters] configured with the provided [FilterOption]
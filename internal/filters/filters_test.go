@@ -49,6 +49,30 @@ func TestNew(t *testing.T) {
 			opts: []FilterOption{WithNoteID(t.Name()), WithNoteID(t.Name())},
 			err:  serrors.ErrAlreadySet,
 		},
+		"avatar-happy-path": {
+			opts:           []FilterOption{WithAvatar([]byte("png"))},
+			validationFunc: func(f *Filters) { require.Equal(t, []byte("png"), f.Avatar()) },
+		},
+		"avatar-empty": {
+			opts: []FilterOption{WithAvatar(nil)},
+			err:  serrors.ErrAtLeastOne,
+		},
+		"avatar-already-set": {
+			opts: []FilterOption{WithAvatar([]byte("png")), WithAvatar([]byte("png"))},
+			err:  serrors.ErrAlreadySet,
+		},
+		"role-happy-path": {
+			opts:           []FilterOption{WithRole(statusthingv1.Role_ROLE_EDITOR)},
+			validationFunc: func(f *Filters) { require.Equal(t, statusthingv1.Role_ROLE_EDITOR, f.Role()) },
+		},
+		"role-unknown": {
+			opts: []FilterOption{WithRole(statusthingv1.Role_ROLE_UNKNOWN)},
+			err:  serrors.ErrEmptyEnum,
+		},
+		"role-already-set": {
+			opts: []FilterOption{WithRole(statusthingv1.Role_ROLE_ADMIN), WithRole(statusthingv1.Role_ROLE_VIEWER)},
+			err:  serrors.ErrAlreadySet,
+		},
 		"statusID-status-already-set": {
 			opts: []FilterOption{WithStatus(&statusthingv1.Status{}), WithStatusID(t.Name())},
 			err:  serrors.ErrAlreadySet,
@@ -181,6 +205,98 @@ func TestNew(t *testing.T) {
 			opts: []FilterOption{WithStatusKinds(statusthingv1.StatusKind_STATUS_KIND_AVAILABLE), WithStatusKinds(statusthingv1.StatusKind_STATUS_KIND_AVAILABLE)},
 			err:  serrors.ErrAlreadySet,
 		},
+		"parentid-happy-path": {
+			opts:           []FilterOption{WithParentID(t.Name())},
+			validationFunc: func(f *Filters) { require.Equal(t, t.Name(), f.ParentID()) },
+		},
+		"parentid-empty": {
+			opts: []FilterOption{WithParentID("")},
+			err:  serrors.ErrEmptyString,
+		},
+		"parentid-already-set": {
+			opts: []FilterOption{WithParentID(t.Name()), WithParentID(t.Name())},
+			err:  serrors.ErrAlreadySet,
+		},
+		"rolluppolicy-happy-path": {
+			opts: []FilterOption{WithRollupPolicy(statusthingv1.RollupPolicy_ROLLUP_POLICY_MAJORITY)},
+			validationFunc: func(f *Filters) {
+				require.Equal(t, statusthingv1.RollupPolicy_ROLLUP_POLICY_MAJORITY, f.RollupPolicy())
+			},
+		},
+		"rolluppolicy-zero-val": {
+			opts: []FilterOption{WithRollupPolicy(statusthingv1.RollupPolicy_ROLLUP_POLICY_UNKNOWN)},
+			err:  serrors.ErrEmptyEnum,
+		},
+		"expectedversion-happy-path": {
+			opts:           []FilterOption{WithExpectedVersion(2)},
+			validationFunc: func(f *Filters) { require.Equal(t, uint64(2), f.ExpectedVersion()) },
+		},
+		"expectedversion-zero-val": {
+			opts: []FilterOption{WithExpectedVersion(0)},
+			err:  serrors.ErrAtLeastOne,
+		},
+		"expectedversion-already-set": {
+			opts: []FilterOption{WithExpectedVersion(1), WithExpectedVersion(2)},
+			err:  serrors.ErrAlreadySet,
+		},
+		"clearedfields-happy-path": {
+			opts:           []FilterOption{WithClearedFields("description", "color")},
+			validationFunc: func(f *Filters) { require.Equal(t, []string{"description", "color"}, f.ClearedFields()) },
+		},
+		"clearedfields-none": {
+			opts: []FilterOption{WithClearedFields()},
+			err:  serrors.ErrAtLeastOne,
+		},
+		"clearedfields-empty-string": {
+			opts: []FilterOption{WithClearedFields("description", " ")},
+			err:  serrors.ErrEmptyString,
+		},
+		"clearedfields-already-set": {
+			opts: []FilterOption{WithClearedFields("description"), WithClearedFields("color")},
+			err:  serrors.ErrAlreadySet,
+		},
+		"notevisibility-happy-path": {
+			opts: []FilterOption{WithNoteVisibility(statusthingv1.NoteVisibility_NOTE_VISIBILITY_INTERNAL)},
+			validationFunc: func(f *Filters) {
+				require.Equal(t, statusthingv1.NoteVisibility_NOTE_VISIBILITY_INTERNAL, f.NoteVisibility())
+			},
+		},
+		"notevisibility-zero-val": {
+			opts: []FilterOption{WithNoteVisibility(statusthingv1.NoteVisibility_NOTE_VISIBILITY_UNKNOWN)},
+			err:  serrors.ErrEmptyEnum,
+		},
+		"notevisibility-already-set": {
+			opts: []FilterOption{WithNoteVisibility(statusthingv1.NoteVisibility_NOTE_VISIBILITY_PUBLIC), WithNoteVisibility(statusthingv1.NoteVisibility_NOTE_VISIBILITY_INTERNAL)},
+			err:  serrors.ErrAlreadySet,
+		},
+		"pinned-happy-path": {
+			opts: []FilterOption{WithPinned(false)},
+			validationFunc: func(f *Filters) {
+				pinned, ok := f.Pinned()
+				require.False(t, pinned)
+				require.True(t, ok, "unpinning should be distinguishable from not setting pinned")
+			},
+		},
+		"pinned-already-set": {
+			opts: []FilterOption{WithPinned(true), WithPinned(false)},
+			err:  serrors.ErrAlreadySet,
+		},
+		"password-change-required-happy-path": {
+			opts: []FilterOption{WithPasswordChangeRequired(false)},
+			validationFunc: func(f *Filters) {
+				required, ok := f.PasswordChangeRequired()
+				require.False(t, required)
+				require.True(t, ok, "clearing the flag should be distinguishable from not setting it")
+			},
+		},
+		"password-change-required-already-set": {
+			opts: []FilterOption{WithPasswordChangeRequired(true), WithPasswordChangeRequired(false)},
+			err:  serrors.ErrAlreadySet,
+		},
+		"rolluppolicy-already-set": {
+			opts: []FilterOption{WithRollupPolicy(statusthingv1.RollupPolicy_ROLLUP_POLICY_IGNORE), WithRollupPolicy(statusthingv1.RollupPolicy_ROLLUP_POLICY_IGNORE)},
+			err:  serrors.ErrAlreadySet,
+		},
 	}
 	for n, tc := range cases {
 		t.Run(n, func(t *testing.T) {
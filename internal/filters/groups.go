@@ -0,0 +1,51 @@
+package filters
+
+import (
+	"fmt"
+
+	statusthingv1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
+	"github.com/lusis/statusthing/internal/serrors"
+	"github.com/lusis/statusthing/internal/validation"
+)
+
+// WithParentID provides the id of a parent [statusthingv1.Item]
+func WithParentID(id string) FilterOption {
+	return func(f *Filters) error {
+		if !validation.ValidString(id) {
+			return fmt.Errorf("parent id: %w", serrors.ErrEmptyString)
+		}
+		if f.parentID != nil {
+			return fmt.Errorf("parent id: %w", serrors.ErrAlreadySet)
+		}
+		f.parentID = &id
+		return nil
+	}
+}
+
+// ParentID returns the configured parent [statusthingv1.Item] id
+func (f *Filters) ParentID() string {
+	f.l.RLock()
+	defer f.l.RUnlock()
+	return safeString(f.parentID)
+}
+
+// WithRollupPolicy provides a custom [statusthingv1.RollupPolicy]
+func WithRollupPolicy(p statusthingv1.RollupPolicy) FilterOption {
+	return func(f *Filters) error {
+		if p == statusthingv1.RollupPolicy_ROLLUP_POLICY_UNKNOWN {
+			return fmt.Errorf("rollup policy: %w", serrors.ErrEmptyEnum)
+		}
+		if f.rollupPolicy != statusthingv1.RollupPolicy_ROLLUP_POLICY_UNKNOWN {
+			return fmt.Errorf("rollup policy: %w", serrors.ErrAlreadySet)
+		}
+		f.rollupPolicy = p
+		return nil
+	}
+}
+
+// RollupPolicy returns the configured [statusthingv1.RollupPolicy]
+func (f *Filters) RollupPolicy() statusthingv1.RollupPolicy {
+	f.l.RLock()
+	defer f.l.RUnlock()
+	return f.rollupPolicy
+}
@@ -1,6 +1,7 @@
 package filters
 
 import (
+	statusthingv1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
 	"github.com/lusis/statusthing/internal/serrors"
 
 	"fmt"
@@ -27,3 +28,45 @@ func WithNoteText(text string) FilterOption {
 		return nil
 	}
 }
+
+// WithNoteVisibility provides a custom [statusthingv1.NoteVisibility]
+func WithNoteVisibility(v statusthingv1.NoteVisibility) FilterOption {
+	return func(f *Filters) error {
+		if v == statusthingv1.NoteVisibility_NOTE_VISIBILITY_UNKNOWN {
+			return fmt.Errorf("note visibility: %w", serrors.ErrEmptyEnum)
+		}
+		if f.noteVisibility != statusthingv1.NoteVisibility_NOTE_VISIBILITY_UNKNOWN {
+			return fmt.Errorf("note visibility: %w", serrors.ErrAlreadySet)
+		}
+		f.noteVisibility = v
+		return nil
+	}
+}
+
+// NoteVisibility returns the configured [statusthingv1.NoteVisibility]
+func (f *Filters) NoteVisibility() statusthingv1.NoteVisibility {
+	f.l.RLock()
+	defer f.l.RUnlock()
+	return f.noteVisibility
+}
+
+// WithPinned provides whether a [statusthingv1.Note] is pinned
+func WithPinned(pinned bool) FilterOption {
+	return func(f *Filters) error {
+		if f.pinned != nil {
+			return fmt.Errorf("pinned: %w", serrors.ErrAlreadySet)
+		}
+		f.pinned = &pinned
+		return nil
+	}
+}
+
+// Pinned returns the configured pinned flag and whether one was provided
+func (f *Filters) Pinned() (bool, bool) {
+	f.l.RLock()
+	defer f.l.RUnlock()
+	if f.pinned == nil {
+		return false, false
+	}
+	return *f.pinned, true
+}
@@ -25,3 +25,24 @@ func (f *Filters) Password() string {
 	defer f.l.RUnlock()
 	return safeString(f.password)
 }
+
+// WithPasswordChangeRequired provides whether a user has to change their password before doing anything else
+func WithPasswordChangeRequired(required bool) FilterOption {
+	return func(f *Filters) error {
+		if f.passwordChangeRequired != nil {
+			return serrors.NewError("password change required", serrors.ErrAlreadySet)
+		}
+		f.passwordChangeRequired = &required
+		return nil
+	}
+}
+
+// PasswordChangeRequired returns the configured flag and whether one was provided
+func (f *Filters) PasswordChangeRequired() (bool, bool) {
+	f.l.RLock()
+	defer f.l.RUnlock()
+	if f.passwordChangeRequired == nil {
+		return false, false
+	}
+	return *f.passwordChangeRequired, true
+}
@@ -0,0 +1,27 @@
+package filters
+
+import (
+	statusthingv1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
+	"github.com/lusis/statusthing/internal/serrors"
+)
+
+// WithRole provides a [statusthingv1.Role]
+func WithRole(r statusthingv1.Role) FilterOption {
+	return func(f *Filters) error {
+		if r == statusthingv1.Role_ROLE_UNKNOWN {
+			return serrors.NewError("role", serrors.ErrEmptyEnum)
+		}
+		if f.role != statusthingv1.Role_ROLE_UNKNOWN {
+			return serrors.NewError("role", serrors.ErrAlreadySet)
+		}
+		f.role = r
+		return nil
+	}
+}
+
+// Role returns the configured [statusthingv1.Role]
+func (f *Filters) Role() statusthingv1.Role {
+	f.l.RLock()
+	defer f.l.RUnlock()
+	return f.role
+}
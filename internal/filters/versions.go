@@ -0,0 +1,32 @@
+package filters
+
+import (
+	"fmt"
+
+	"github.com/lusis/statusthing/internal/serrors"
+)
+
+// WithExpectedVersion provides the version a record is expected to have when updating it
+// updates are rejected with [serrors.ErrConflict] when the stored version does not match
+func WithExpectedVersion(version uint64) FilterOption {
+	return func(f *Filters) error {
+		if version == 0 {
+			return fmt.Errorf("expected version: %w", serrors.ErrAtLeastOne)
+		}
+		if f.expectedVersion != nil {
+			return fmt.Errorf("expected version: %w", serrors.ErrAlreadySet)
+		}
+		f.expectedVersion = &version
+		return nil
+	}
+}
+
+// ExpectedVersion returns the configured expected version. zero means no version was provided
+func (f *Filters) ExpectedVersion() uint64 {
+	f.l.RLock()
+	defer f.l.RUnlock()
+	if f.expectedVersion == nil {
+		return 0
+	}
+	return *f.expectedVersion
+}
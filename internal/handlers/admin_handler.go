@@ -2,19 +2,25 @@ package handlers
 
 import (
 	"context"
+	"errors"
+	"expvar"
 	"fmt"
+	"html/template"
+	"io"
 	"io/fs"
 	"net/http"
-	"os"
+	"net/url"
 	"strings"
-	"text/template"
+	"time"
 
 	"github.com/go-chi/chi"
 
 	"github.com/lusis/htmxtools"
-	"github.com/lusis/statusthing/assets"
 	v1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
+	"github.com/lusis/statusthing/internal/auth"
+	"github.com/lusis/statusthing/internal/diff"
 	"github.com/lusis/statusthing/internal/filters"
+	"github.com/lusis/statusthing/internal/oidc"
 	"github.com/lusis/statusthing/internal/serrors"
 	"github.com/lusis/statusthing/internal/services"
 	"github.com/lusis/statusthing/internal/session"
@@ -38,8 +44,7 @@ const (
 	hxRequestHeader     = "hx-request"
 	hxLocationHeader    = "hx-location"
 	hxReplaceURLHeader  = "hx-replace-url"
-	defaultUIDir        = "./assets/ui/"
-	defaultTemplateDir  = "./assets/templates/"
+	defaultAssetDir     = "./assets/"
 )
 
 // AdminHandler is the http handler for the admin site
@@ -52,10 +57,12 @@ type AdminHandler struct {
 	funcmap        template.FuncMap
 	mux            chi.Router
 	templateLoader templating.TemplateLoader
+	oidc           *oidc.Provider
 }
 
-// NewAdminHandler returns a new admin handler
-func NewAdminHandler(sts *services.StatusThingService, mux chi.Router, reloadable bool) (*AdminHandler, error) {
+// NewAdminHandler returns a new admin handler with sessions configured by the provided [session.Config]
+// templates and static files in the optional theme are used in place of the built-in ones. see [NewPublicHandler] for its layout
+func NewAdminHandler(sts *services.StatusThingService, mux chi.Router, reloadable bool, sessionCfg session.Config, theme fs.FS, provider *oidc.Provider) (*AdminHandler, error) {
 	funcMap := template.FuncMap{
 		"items": func() ([]*v1.Item, error) {
 			return sts.FindItems(context.TODO())
@@ -70,34 +77,73 @@ func NewAdminHandler(sts *services.StatusThingService, mux chi.Router, reloadabl
 		"notes": func(itemID string) ([]*v1.Note, error) {
 			return sts.FindNotes(context.TODO(), itemID)
 		},
+		"note": func(noteID string) (*v1.Note, error) {
+			return sts.GetNote(context.TODO(), noteID)
+		},
+		"user": func(username string) (*v1.User, error) {
+			return sts.GetUser(context.TODO(), username)
+		},
+		// externalUser is true for users who log in with a single sign-on provider and have no password
+		"externalUser": services.IsExternalUser,
+		// avatarURL returns the url of the user's avatar, falling back to one that serves the default avatar
+		"avatarURL": func(username string) string {
+			user, err := sts.GetUser(context.TODO(), username)
+			if err != nil || !validation.ValidString(user.GetAvatarUrl()) {
+				return "/avatars/" + url.PathEscape(username)
+			}
+			return user.GetAvatarUrl()
+		},
+		"noteHistory": func(noteID string) ([]noteChange, error) {
+			return noteHistory(context.TODO(), sts, noteID)
+		},
+		"itemTree": func() ([]*v1.Item, error) {
+			return sts.ItemTree(context.TODO())
+		},
+		"itemName": func(itemID string) string {
+			item, err := sts.GetItem(context.TODO(), itemID)
+			if err != nil {
+				return itemID
+			}
+			return item.GetName()
+		},
+		"impact": func(itemID string) (*services.ItemImpact, error) {
+			return sts.GetItemImpact(context.TODO(), itemID)
+		},
 		"kinds": func() []string {
 			return templating.AllStatusKind
 		},
+		"rollupPolicies": func() []string {
+			return templating.AllRollupPolicy
+		},
+		"noteVisibilities": func() []string {
+			return templating.AllNoteVisibility
+		},
+		"settings": func() *v1.Settings {
+			return siteSettings(context.TODO(), sts)
+		},
+		"themeURL": themeURL,
+		// localTime converts t to the time zone from the settings
+		"localTime": func(t time.Time) time.Time {
+			return t.In(sts.Location(context.TODO()))
+		},
+		"markdown": renderMarkdown,
+		"isImage":  services.IsImage,
 	}
 
-	var uifs fs.FS
-	var templatefs fs.FS
+	baseUI, baseTemplates, err := assetFS(reloadable, "templates")
+	if err != nil {
+		return nil, err
+	}
+	uifs := templating.NewLayeredFS(themeSub(theme, "ui"), baseUI)
+	templatefs := templating.NewOverrideFS(baseTemplates, themeSub(theme, "templates"), "*.html", templating.HTMLCheck(funcMap))
 	var loader templating.TemplateLoader
-
 	if reloadable {
-		uifs = os.DirFS(defaultUIDir)
-		templatefs = os.DirFS(defaultTemplateDir)
 		l, err := templating.NewReloadingFSTemplateLoader(templatefs, "*.html", funcMap)
 		if err != nil {
 			return nil, err
 		}
 		loader = l
 	} else {
-		ui, err := fs.Sub(assets.UIFs, "ui")
-		if err != nil {
-			return nil, err
-		}
-		uifs = ui
-		tfs, err := fs.Sub(assets.TemplateFS, "templates")
-		if err != nil {
-			return nil, err
-		}
-		templatefs = tfs
 		templates, err := template.New("").Funcs(funcMap).ParseFS(templatefs, "*.html")
 		if err != nil {
 			return nil, err
@@ -108,10 +154,10 @@ func NewAdminHandler(sts *services.StatusThingService, mux chi.Router, reloadabl
 		}
 	}
 
-	return newAdminHandler(sts, uifs, templatefs, loader, mux)
+	return newAdminHandler(sts, uifs, templatefs, loader, mux, sessionCfg, provider)
 }
 
-func newAdminHandler(sts *services.StatusThingService, uifs fs.FS, templatefs fs.FS, loader templating.TemplateLoader, mux chi.Router) (*AdminHandler, error) {
+func newAdminHandler(sts *services.StatusThingService, uifs fs.FS, templatefs fs.FS, loader templating.TemplateLoader, mux chi.Router, sessionCfg session.Config, provider *oidc.Provider) (*AdminHandler, error) {
 	if sts == nil {
 		return nil, serrors.NewError("sts", serrors.ErrNilVal)
 	}
@@ -130,20 +176,54 @@ func newAdminHandler(sts *services.StatusThingService, uifs fs.FS, templatefs fs
 		uiFS:           uifs,
 		templateFS:     templatefs,
 		templateLoader: loader,
+		oidc:           provider,
+	}
+	editor := func(next http.HandlerFunc) http.HandlerFunc {
+		return hxonly(handler.requireRole(v1.Role_ROLE_EDITOR, next))
+	}
+	admin := func(next http.HandlerFunc) http.HandlerFunc {
+		return hxonly(handler.requireRole(v1.Role_ROLE_ADMIN, next))
 	}
 
 	ourmux := chi.NewRouter()
-	session.NewSession()
+	if err := session.NewSession(sessionCfg, sts); err != nil {
+		return nil, err
+	}
+	ourmux.Use(securityHeaders)
 	ourmux.Use(session.Sessions.LoadAndSave)
+	ourmux.Use(csrfProtect)
 	ourmux.Use(htmxtools.Wrap)
+	ourmux.Use(handler.requirePasswordChange)
 	ourmux.Get("/*", handler.templateHandler(http.FileServer(http.FS(uifs))))
 	ourmux.Post("/login", hxonly(handler.login))
-	ourmux.Post("/add-status", hxonly(handler.addStatus))
-	ourmux.Post("/add-item", hxonly(handler.addItem))
-	ourmux.Post("/delete-item", hxonly(handler.deleteItem))
-	ourmux.Post("/delete-status", hxonly(handler.deleteStatus))
-	ourmux.Post("/edit-item", hxonly(handler.addItem))
-	ourmux.Post("/edit-status", hxonly(handler.addStatus))
+	ourmux.Post("/sign-out", hxonly(handler.signOut))
+	ourmux.Post("/sign-out-everywhere", hxonly(handler.signOutEverywhere))
+	if provider != nil {
+		ourmux.Get(oidcLoginPath, handler.oidcLogin)
+		ourmux.Get(oidcCallbackPath, handler.oidcCallback)
+	}
+	ourmux.Post("/add-status", editor(handler.addStatus))
+	ourmux.Post("/add-item", editor(handler.addItem))
+	ourmux.Post("/delete-item", editor(handler.deleteItem))
+	ourmux.Post("/delete-status", editor(handler.deleteStatus))
+	ourmux.Post("/add-dependency", editor(handler.addDependency))
+	ourmux.Post("/delete-dependency", editor(handler.deleteDependency))
+	ourmux.Post("/add-note", editor(handler.addNote))
+	ourmux.Post("/pin-note", editor(handler.pinNote))
+	ourmux.Post("/edit-note", editor(handler.editNote))
+	ourmux.Post("/add-attachment", editor(handler.addAttachment))
+	ourmux.Post("/delete-attachment", editor(handler.deleteAttachment))
+	ourmux.Get("/attachments/{id}", loggedIn(serveAttachment(sts, false)))
+	ourmux.Post("/edit-profile", hxonly(handler.editProfile))
+	ourmux.Post("/change-password", hxonly(handler.changePassword))
+	ourmux.Post("/upload-avatar", hxonly(handler.uploadAvatar))
+	ourmux.Get("/avatars/{username}", loggedIn(handler.serveAvatar))
+	ourmux.Post("/edit-settings", admin(handler.editSettings))
+	// expvar includes the command line and memory stats so only admins can see it
+	ourmux.Get("/debug/vars", handler.requireRole(v1.Role_ROLE_ADMIN, expvar.Handler().ServeHTTP))
+	brandingRoutes(ourmux, sts)
+	ourmux.Post("/edit-item", editor(handler.addItem))
+	ourmux.Post("/edit-status", editor(handler.addStatus))
 	ourmux.Route("/statuses", func(r chi.Router) {})
 	ourmux.Route("/items", func(r chi.Router) {})
 	handler.mux = ourmux
@@ -151,6 +231,17 @@ func newAdminHandler(sts *services.StatusThingService, uifs fs.FS, templatefs fs
 	return handler, nil
 }
 
+// loggedIn only calls the next handler for logged in users
+func loggedIn(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !session.Sessions.GetBool(r.Context(), session.LoggedInKey) {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		next(w, r)
+	}
+}
+
 func hxonly(next http.HandlerFunc) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		htmxreq := htmxtools.RequestFromContext(r.Context())
@@ -172,17 +263,30 @@ func (ah *AdminHandler) login(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusForbidden)
 		return
 	}
-	if u == "admin" && p == "password" {
+	if user, err := ah.sts.Login(r.Context(), u, p); err == nil {
 		session.Sessions.Put(r.Context(), session.LoggedInKey, true)
+		session.Sessions.Put(r.Context(), session.UsernameKey, u)
 		if err := session.Sessions.RenewToken(r.Context()); err != nil {
 			slog.Error("unable to renew token", "error", err)
 			w.Header().Add(buildHXLocation(loginUIBlock))
 			w.WriteHeader(http.StatusForbidden)
 			return
 		}
-		w.Header().Add(hxRedirectHeader, "/")
+		// the token the login form was sent with was handed out before login so it's replaced too
+		if _, err := session.RenewCSRFToken(r.Context()); err != nil {
+			slog.Error("unable to renew csrf token", "error", err)
+			w.Header().Add(buildHXLocation(loginUIBlock))
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		if user.GetPasswordChangeRequired() {
+			w.Header().Add(hxRedirectHeader, "/"+profilePage)
+		} else {
+			w.Header().Add(hxRedirectHeader, "/")
+		}
 		w.WriteHeader(http.StatusOK)
 	} else {
+		slog.Info("login failed", "username", u, "error", err)
 		w.Header().Add(buildHXLocation(loginUIBlock))
 		w.WriteHeader(http.StatusForbidden)
 	}
@@ -197,6 +301,8 @@ func (ah *AdminHandler) addItem(w http.ResponseWriter, r *http.Request) {
 	name := vars.Get("name")
 	statusid := vars.Get("status")
 	description := vars.Get("description")
+	parent := vars.Get("parent")
+	policy := v1.RollupPolicy(v1.RollupPolicy_value[vars.Get("rollup_policy")])
 	if !validation.ValidString(name) {
 		http.Error(w, "name required", http.StatusFailedDependency)
 		return
@@ -209,6 +315,12 @@ func (ah *AdminHandler) addItem(w http.ResponseWriter, r *http.Request) {
 	if validation.ValidString(description) {
 		opts = append(opts, filters.WithDescription(description))
 	}
+	if validation.ValidString(parent) {
+		opts = append(opts, filters.WithParentID(parent))
+	}
+	if policy != v1.RollupPolicy_ROLLUP_POLICY_UNKNOWN {
+		opts = append(opts, filters.WithRollupPolicy(policy))
+	}
 	res, err := ah.sts.AddItem(r.Context(), name, opts...)
 	if err != nil {
 		slog.Error("unable to add item", "error", err)
@@ -299,11 +411,218 @@ func (ah *AdminHandler) deleteStatus(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func (ah *AdminHandler) addDependency(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		slog.Error("unable to parse form", "error", err)
+		return
+	}
+	itemID, dependsOnID := r.Form.Get("item"), r.Form.Get("depends_on")
+	if _, err := ah.sts.AddDependency(r.Context(), itemID, dependsOnID); err != nil {
+		slog.Error("unable to add dependency", "error", err)
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+	slog.Info("added dependency", "item_id", itemID, "depends_on_id", dependsOnID)
+	w.Header().Add(htmxtools.RedirectResponse.String(), "dependencies.html")
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (ah *AdminHandler) deleteDependency(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		slog.Error("unable to parse form", "error", err)
+		return
+	}
+	itemID, dependsOnID := r.Form.Get("item"), r.Form.Get("depends_on")
+	if err := ah.sts.RemoveDependency(r.Context(), itemID, dependsOnID); err != nil {
+		slog.Error("unable to delete dependency", "error", err)
+		return
+	}
+	slog.Info("deleted dependency", "item_id", itemID, "depends_on_id", dependsOnID)
+	w.Header().Add(htmxtools.RedirectResponse.String(), "dependencies.html")
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (ah *AdminHandler) addNote(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		slog.Error("unable to parse form", "error", err)
+		return
+	}
+	itemID, text := r.Form.Get("item"), r.Form.Get("text")
+	opts := []filters.FilterOption{}
+	if visibility := v1.NoteVisibility(v1.NoteVisibility_value[r.Form.Get("visibility")]); visibility != v1.NoteVisibility_NOTE_VISIBILITY_UNKNOWN {
+		opts = append(opts, filters.WithNoteVisibility(visibility))
+	}
+	if r.Form.Get("pinned") != "" {
+		opts = append(opts, filters.WithPinned(true))
+	}
+	res, err := ah.sts.AddNote(sessionIdentity(r), itemID, text, opts...)
+	if err != nil {
+		slog.Error("unable to add note", "error", err)
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+	slog.Info("created note", "item_id", itemID, "note_id", res.GetId())
+	w.Header().Add(htmxtools.RedirectResponse.String(), "items.html")
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (ah *AdminHandler) pinNote(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		slog.Error("unable to parse form", "error", err)
+		return
+	}
+	noteID, pinned := r.Form.Get("note"), r.Form.Get("pinned") == "true"
+	if err := ah.sts.EditNote(r.Context(), noteID, "", filters.WithPinned(pinned)); err != nil {
+		slog.Error("unable to pin note", "error", err)
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+	slog.Info("pinned note", "note_id", noteID, "pinned", pinned)
+	w.Header().Add(htmxtools.RedirectResponse.String(), "items.html")
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (ah *AdminHandler) editNote(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		slog.Error("unable to parse form", "error", err)
+		return
+	}
+	noteID, text := r.Form.Get("note"), r.Form.Get("text")
+	if err := ah.sts.EditNote(r.Context(), noteID, text); err != nil {
+		slog.Error("unable to edit note", "error", err)
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+	slog.Info("edited note", "note_id", noteID)
+	w.Header().Add(htmxtools.RedirectResponse.String(), "items.html")
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// addAttachment streams the uploaded file to the service without buffering the whole form
+// the note field has to come before the file, which browsers do by sending fields in the order of the form
+func (ah *AdminHandler) addAttachment(w http.ResponseWriter, r *http.Request) {
+	mr, err := r.MultipartReader()
+	if err != nil {
+		slog.Error("unable to read form", "error", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	noteID := ""
+	for {
+		part, err := mr.NextPart()
+		if errors.Is(err, io.EOF) {
+			http.Error(w, "no file uploaded", http.StatusUnprocessableEntity)
+			return
+		}
+		if err != nil {
+			slog.Error("unable to read form", "error", err)
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		switch part.FormName() {
+		case "note":
+			b, err := io.ReadAll(io.LimitReader(part, 256))
+			if err != nil {
+				slog.Error("unable to read form", "error", err)
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			noteID = string(b)
+		case "file":
+			res, err := ah.sts.AddAttachment(r.Context(), noteID, part.FileName(), part)
+			if err != nil {
+				slog.Error("unable to add attachment", "error", err)
+				http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+				return
+			}
+			slog.Info("added attachment", "note_id", noteID, "attachment_id", res.GetId())
+			w.Header().Add(htmxtools.RedirectResponse.String(), "items.html")
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+	}
+}
+
+func (ah *AdminHandler) deleteAttachment(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		slog.Error("unable to parse form", "error", err)
+		return
+	}
+	attachmentID := r.Form.Get("attachment")
+	if err := ah.sts.RemoveAttachment(r.Context(), attachmentID); err != nil {
+		slog.Error("unable to delete attachment", "error", err)
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+	slog.Info("deleted attachment", "attachment_id", attachmentID)
+	w.Header().Add(htmxtools.RedirectResponse.String(), "items.html")
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// noteChange is a version of a note along with how its text changed from the version before it
+type noteChange struct {
+	Version    uint64
+	Written    time.Time
+	Visibility v1.NoteVisibility
+	Pinned     bool
+	Diff       []diff.Line
+}
+
+// noteHistory returns every version of the note with the provided id, newest first
+func noteHistory(ctx context.Context, sts *services.StatusThingService, noteID string) ([]noteChange, error) {
+	note, err := sts.GetNote(ctx, noteID)
+	if err != nil {
+		return nil, err
+	}
+	revisions, err := sts.ListNoteRevisions(ctx, noteID)
+	if err != nil {
+		return nil, err
+	}
+	revisions = append(revisions, &v1.NoteRevision{
+		Version:    note.GetVersion(),
+		Text:       note.GetText(),
+		Visibility: note.GetVisibility(),
+		Pinned:     note.GetPinned(),
+		Written:    note.GetTimestamps().GetUpdated(),
+	})
+	res := make([]noteChange, len(revisions))
+	previous := ""
+	for i, rev := range revisions {
+		res[len(revisions)-1-i] = noteChange{
+			Version:    rev.GetVersion(),
+			Written:    rev.GetWritten().AsTime(),
+			Visibility: rev.GetVisibility(),
+			Pinned:     rev.GetPinned(),
+			Diff:       diff.Lines(previous, rev.GetText()),
+		}
+		previous = rev.GetText()
+	}
+	return res, nil
+}
+
+// sessionIdentity returns the request context carrying the logged in user as an [auth.Identity]
+func sessionIdentity(r *http.Request) context.Context {
+	username := session.Sessions.GetString(r.Context(), session.UsernameKey)
+	if !validation.ValidString(username) {
+		return r.Context()
+	}
+	return auth.NewContext(r.Context(), &auth.Identity{Name: username, Source: "session"})
+}
+
 func (ah *AdminHandler) templateHandler(next http.Handler) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		// cut down on typos in the most convoluted way....
 		sd := siteData{
 			ContentDiv: contentDivID,
+			SSOLogin:   ah.oidc != nil,
+		}
+		if htmxreq := htmxtools.RequestFromContext(r.Context()); htmxreq != nil {
+			sd.HXRequest = hxRequest{
+				Trigger:     htmxreq.Trigger,
+				Target:      htmxreq.Target,
+				TriggerName: htmxreq.TriggerName,
+				CurrentURL:  htmxreq.CurrentURL,
+			}
 		}
 		// TODO: this is super brittle right now
 		path := strings.TrimLeft(r.URL.Path, "/")
@@ -318,8 +637,20 @@ func (ah *AdminHandler) templateHandler(next http.Handler) http.HandlerFunc {
 		} else {
 			loggedIn := session.Sessions.GetBool(r.Context(), session.LoggedInKey)
 			sd.LoggedIn = loggedIn
-			sd.UserID = session.Sessions.Token(r.Context())
+			if loggedIn {
+				sd.Username = session.Sessions.GetString(r.Context(), session.UsernameKey)
+				role := ah.sessionRole(r)
+				sd.CanEdit = role >= v1.Role_ROLE_EDITOR
+				sd.IsAdmin = role >= v1.Role_ROLE_ADMIN
+			}
 			slog.Info("session", session.LoggedInKey, loggedIn)
+			token, err := session.CSRFToken(r.Context())
+			if err != nil {
+				slog.Error("unable to get csrf token", "error", err)
+				http.Error(w, "internal error", http.StatusInternalServerError)
+				return
+			}
+			sd.CSRFToken = token
 			if err := t.Execute(w, sd); err != nil {
 				slog.Error("unable to execute template", "error", err)
 			}
@@ -333,11 +664,27 @@ type hxRequest struct {
 	TriggerName string
 	CurrentURL  string
 }
+
+// siteData is what admin templates are executed with
+// themes can rely on these fields along with the funcs passed to the templates
 type siteData struct {
-	LoggedIn   bool
-	UserID     string
+	// LoggedIn is true when the visitor is logged in
+	LoggedIn bool
+	// Username is the name of the logged in user
+	Username string
+	// ContentDiv is the id of the element htmx swaps pages into
 	ContentDiv string
-	HXRequest  hxRequest
+	// HXRequest is the htmx request that asked for the page, if any
+	HXRequest hxRequest
+	// CanEdit is true when the logged in user can add, edit and remove items, statuses and notes
+	CanEdit bool
+	// IsAdmin is true when the logged in user can change the site settings
+	IsAdmin bool
+	// SSOLogin is true when users can log in with an OpenID Connect provider at /oidc/login
+	SSOLogin bool
+	// CSRFToken has to be sent in the X-CSRF-Token header of every request that isn't a GET or HEAD
+	// the head template puts it in the csrf-token meta tag that ours.js reads it from
+	CSRFToken string
 }
 
 func buildHXLocation(path string) (string, string) {
@@ -0,0 +1,129 @@
+package handlers
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
+	"github.com/lusis/statusthing/internal/services"
+	"github.com/lusis/statusthing/internal/session"
+	"github.com/lusis/statusthing/internal/storers/memdb"
+)
+
+func TestAdminHandler(t *testing.T) {
+	ctx := context.TODO()
+	store, err := memdb.New()
+	require.NoError(t, err)
+	sts, err := services.NewStatusThingService(store)
+	require.NoError(t, err)
+	mux := chi.NewRouter()
+	_, err = NewAdminHandler(sts, mux, false, session.DefaultConfig(), nil, nil)
+	require.NoError(t, err)
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+	jar, err := cookiejar.New(nil)
+	require.NoError(t, err)
+	client := srv.Client()
+	client.Jar = jar
+
+	get := func(t *testing.T, path string) (*http.Response, string) {
+		res, err := client.Get(srv.URL + path)
+		require.NoError(t, err)
+		defer res.Body.Close()
+		body, err := io.ReadAll(res.Body)
+		require.NoError(t, err)
+		return res, string(body)
+	}
+
+	post := func(t *testing.T, path string, form url.Values, token string) *http.Response {
+		req, err := http.NewRequest(http.MethodPost, srv.URL+path, strings.NewReader(form.Encode()))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.Header.Set("HX-Request", "true")
+		if token != "" {
+			req.Header.Set(csrfHeader, token)
+		}
+		res, err := client.Do(req)
+		require.NoError(t, err)
+		require.NoError(t, res.Body.Close())
+		return res
+	}
+	csrfMeta := regexp.MustCompile(`<meta name="csrf-token" content="([^"]+)">`)
+	csrfToken := func(t *testing.T) string {
+		_, body := get(t, "/")
+		m := csrfMeta.FindStringSubmatch(body)
+		require.Len(t, m, 2, "pages should have the csrf token")
+		return m[1]
+	}
+
+	res, _ := get(t, "/debug/vars")
+	require.Equal(t, http.StatusForbidden, res.StatusCode, "metrics should only be shown to admins")
+
+	form := url.Values{"username": {services.DefaultAdminUsername}, "password": {services.DefaultAdminPassword}}
+	loginToken := csrfToken(t)
+	res = post(t, "/login", form, loginToken)
+	require.Equal(t, http.StatusForbidden, res.StatusCode, "logging in should not create the admin")
+
+	_, password, err := sts.CreateAdmin(ctx, services.DefaultAdminUsername, services.DefaultAdminEmail)
+	require.NoError(t, err)
+	form = url.Values{"username": {services.DefaultAdminUsername}, "password": {password}}
+	res = post(t, "/login", form, "")
+	require.Equal(t, http.StatusForbidden, res.StatusCode, "posts without a csrf token should be rejected")
+	res = post(t, "/login", form, "not-the-token")
+	require.Equal(t, http.StatusForbidden, res.StatusCode, "posts with the wrong csrf token should be rejected")
+	res = post(t, "/login", form, loginToken)
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	require.Equal(t, "/"+profilePage, res.Header.Get(hxRedirectHeader), "the generated password has to be changed first")
+	token := csrfToken(t)
+	require.NotEqual(t, loginToken, token, "the csrf token should change at login")
+
+	res, body := get(t, "/items.html")
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	require.Equal(t, "/"+profilePage, res.Request.URL.Path, "pages should send the user to their profile until the password is changed")
+	require.Contains(t, body, "Choose a new password")
+	res = post(t, "/add-status", url.Values{"name": {"up"}, "kind": {v1.StatusKind_STATUS_KIND_UP.String()}}, token)
+	require.Equal(t, http.StatusForbidden, res.StatusCode, "changes should be refused until the password is changed")
+	res = post(t, "/change-password", url.Values{"current_password": {password}, "new_password": {"better password"}, "confirm_password": {"better password"}}, token)
+	require.Equal(t, http.StatusAccepted, res.StatusCode)
+
+	sessions, err := sts.FindSessions(ctx)
+	require.NoError(t, err)
+	require.Len(t, sessions, 1, "sessions should be kept in the store")
+
+	_, err = sts.AddItem(ctx, `<img src=x onerror="alert(1)">`)
+	require.NoError(t, err)
+	res, body = get(t, "/items.html")
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	require.Equal(t, contentSecurityPolicy, res.Header.Get("Content-Security-Policy"))
+	require.NotContains(t, body, "<img src=x", "item names should be escaped")
+	require.Contains(t, body, "&lt;img src=x onerror=&#34;alert(1)&#34;&gt;")
+	require.NotContains(t, body, "https://", "pages should only load assets from the site itself")
+
+	res, _ = get(t, "/icons.svg")
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	res, body = get(t, "/debug/vars")
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	require.Contains(t, body, "cmdline")
+
+	res = post(t, "/sign-out-everywhere", url.Values{}, token)
+	require.Equal(t, http.StatusAccepted, res.StatusCode)
+	sessions, err = sts.FindSessions(ctx)
+	require.NoError(t, err)
+	for _, data := range sessions {
+		require.NotContains(t, string(data), services.DefaultAdminUsername, "no session should still be signed in")
+	}
+	_, body = get(t, "/items.html")
+	require.NotContains(t, body, "&lt;img src=x", "items should not be shown after signing out")
+	res, _ = get(t, "/debug/vars")
+	require.Equal(t, http.StatusForbidden, res.StatusCode)
+}
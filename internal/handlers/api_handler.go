@@ -4,18 +4,26 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"strings"
 
 	"github.com/bufbuild/connect-go"
 	"golang.org/x/exp/slog"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 
 	statusthingv1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
 	v1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
+	"github.com/lusis/statusthing/internal/auth"
 	"github.com/lusis/statusthing/internal/filters"
 	serrors "github.com/lusis/statusthing/internal/serrors"
 	"github.com/lusis/statusthing/internal/services"
+	"github.com/lusis/statusthing/internal/storers"
 )
 
+// IdempotencyKeyHeader is the header clients set to safely retry create requests
+// retries with the same key and request get the original response back instead of creating a duplicate
+const IdempotencyKeyHeader = "Idempotency-Key"
+
 // APIHandler is something that can handle api requests
 type APIHandler struct {
 	sts *services.StatusThingService
@@ -40,6 +48,7 @@ func (api *APIHandler) GetItem(ctx context.Context, req *connect.Request[v1.GetI
 	if err != nil {
 		return nil, handleError(err)
 	}
+	hideInternalNotes(ctx, res)
 	return connect.NewResponse(&statusthingv1.GetItemResponse{Item: res}), nil
 }
 
@@ -53,10 +62,15 @@ func (api *APIHandler) ListItems(ctx context.Context, req *connect.Request[v1.Li
 	if req.Msg.GetKinds() != nil {
 		opts = append(opts, filters.WithStatusKinds(req.Msg.GetKinds()...))
 	}
-	res, err := api.sts.FindItems(ctx, opts...)
+	find := api.sts.FindItems
+	if req.Msg.GetTree() {
+		find = api.sts.ItemTree
+	}
+	res, err := find(ctx, opts...)
 	if err != nil {
 		return nil, handleError(err)
 	}
+	hideInternalNotes(ctx, res...)
 	return connect.NewResponse(&v1.ListItemsResponse{
 		Items: res,
 	}), nil
@@ -83,16 +97,25 @@ func (api *APIHandler) AddItem(ctx context.Context, req *connect.Request[v1.AddI
 	if strings.TrimSpace(initialNote) != "" {
 		opts = append(opts, filters.WithNoteText(initialNote))
 	}
-	res, err := api.sts.AddItem(ctx, name, opts...)
-	if err != nil {
+	if strings.TrimSpace(msg.GetParentId()) != "" {
+		opts = append(opts, filters.WithParentID(msg.GetParentId()))
+	}
+	if msg.GetRollupPolicy() != v1.RollupPolicy_ROLLUP_POLICY_UNKNOWN {
+		opts = append(opts, filters.WithRollupPolicy(msg.GetRollupPolicy()))
+	}
+	res := &v1.AddItemResponse{}
+	if err := api.sts.Idempotent(ctx, req.Header().Get(IdempotencyKeyHeader), msg, res, func() error {
+		item, err := api.sts.AddItem(ctx, name, opts...)
+		res.Item = item
+		return err
+	}); err != nil {
 		return nil, handleError(err)
 	}
-	return connect.NewResponse(&v1.AddItemResponse{
-		Item: res,
-	}), nil
+	return connect.NewResponse(res), nil
 }
 
 // UpdateItem updates an existing Item
+// when an update mask is provided only the masked fields are changed and masked fields left empty are cleared
 func (api *APIHandler) UpdateItem(ctx context.Context, req *connect.Request[v1.UpdateItemRequest]) (*connect.Response[v1.UpdateItemResponse], error) {
 	msg := req.Msg
 	itemID := msg.GetItemId()
@@ -100,17 +123,31 @@ func (api *APIHandler) UpdateItem(ctx context.Context, req *connect.Request[v1.U
 	desc := msg.GetDescription()
 	statusID := msg.GetStatusId()
 
+	mask, err := newUpdateMask(msg.GetUpdateMask(), "name", "description", "status_id", "parent_id", "rollup_policy")
+	if err != nil {
+		return nil, handleError(err)
+	}
 	opts := []filters.FilterOption{}
 
-	if name != "" {
+	if mask.apply("name", name != "") {
 		opts = append(opts, filters.WithName(name))
 	}
-	if desc != "" {
+	if mask.apply("description", desc != "") {
 		opts = append(opts, filters.WithDescription(desc))
 	}
-	if statusID != "" {
+	if mask.apply("status_id", statusID != "") {
 		opts = append(opts, filters.WithStatusID(statusID))
 	}
+	if parentID := msg.GetParentId(); mask.apply("parent_id", parentID != "") {
+		opts = append(opts, filters.WithParentID(parentID))
+	}
+	if policy := msg.GetRollupPolicy(); mask.apply("rollup_policy", policy != v1.RollupPolicy_ROLLUP_POLICY_UNKNOWN) {
+		opts = append(opts, filters.WithRollupPolicy(policy))
+	}
+	opts = append(opts, mask.options()...)
+	if version := msg.GetExpectedVersion(); version != 0 {
+		opts = append(opts, filters.WithExpectedVersion(version))
+	}
 
 	if err := api.sts.EditItem(ctx, itemID, opts...); err != nil {
 		return nil, handleError(err)
@@ -127,6 +164,64 @@ func (api *APIHandler) DeleteItem(ctx context.Context, req *connect.Request[v1.D
 	return &connect.Response[v1.DeleteItemResponse]{}, nil
 }
 
+// BatchUpdateItems sets the status of and optionally adds the same note to many Items in a single transaction
+func (api *APIHandler) BatchUpdateItems(ctx context.Context, req *connect.Request[v1.BatchUpdateItemsRequest]) (*connect.Response[v1.BatchUpdateItemsResponse], error) {
+	opts := []filters.FilterOption{}
+	if statusID := req.Msg.GetStatusId(); strings.TrimSpace(statusID) != "" {
+		opts = append(opts, filters.WithStatusID(statusID))
+	}
+	if noteText := req.Msg.GetNoteText(); strings.TrimSpace(noteText) != "" {
+		opts = append(opts, filters.WithNoteText(noteText))
+	}
+	res, err := api.sts.BatchEditItems(ctx, req.Msg.GetItemIds(), opts...)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	hideInternalNotes(ctx, res...)
+	return connect.NewResponse(&v1.BatchUpdateItemsResponse{Items: res}), nil
+}
+
+// BatchDeleteItems deletes many Items in a single transaction
+func (api *APIHandler) BatchDeleteItems(ctx context.Context, req *connect.Request[v1.BatchDeleteItemsRequest]) (*connect.Response[v1.BatchDeleteItemsResponse], error) {
+	if err := api.sts.BatchRemoveItems(ctx, req.Msg.GetItemIds()); err != nil {
+		return nil, handleError(err)
+	}
+	return &connect.Response[v1.BatchDeleteItemsResponse]{}, nil
+}
+
+// AddItemDependency declares that an Item depends on another Item
+func (api *APIHandler) AddItemDependency(ctx context.Context, req *connect.Request[v1.AddItemDependencyRequest]) (*connect.Response[v1.AddItemDependencyResponse], error) {
+	res, err := api.sts.AddDependency(ctx, req.Msg.GetItemId(), req.Msg.GetDependsOnId())
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return connect.NewResponse(&v1.AddItemDependencyResponse{Dependency: res}), nil
+}
+
+// RemoveItemDependency removes a dependency between two Items
+func (api *APIHandler) RemoveItemDependency(ctx context.Context, req *connect.Request[v1.RemoveItemDependencyRequest]) (*connect.Response[v1.RemoveItemDependencyResponse], error) {
+	if err := api.sts.RemoveDependency(ctx, req.Msg.GetItemId(), req.Msg.GetDependsOnId()); err != nil {
+		return nil, handleError(err)
+	}
+	return &connect.Response[v1.RemoveItemDependencyResponse]{}, nil
+}
+
+// GetItemImpact gets the effective status of an Item along with the Items it impacts and the dependencies degrading it
+func (api *APIHandler) GetItemImpact(ctx context.Context, req *connect.Request[v1.GetItemImpactRequest]) (*connect.Response[v1.GetItemImpactResponse], error) {
+	res, err := api.sts.GetItemImpact(ctx, req.Msg.GetItemId())
+	if err != nil {
+		return nil, handleError(err)
+	}
+	hideInternalNotes(ctx, res.Item)
+	hideInternalNotes(ctx, res.Impacted...)
+	hideInternalNotes(ctx, res.DownDependencies...)
+	return connect.NewResponse(&v1.GetItemImpactResponse{
+		Item:             res.Item,
+		ImpactedItems:    res.Impacted,
+		DownDependencies: res.DownDependencies,
+	}), nil
+}
+
 // GetNote gets a Note by its Id
 func (api *APIHandler) GetNote(ctx context.Context, req *connect.Request[v1.GetNoteRequest]) (*connect.Response[v1.GetNoteResponse], error) {
 	noteID := req.Msg.GetNoteId()
@@ -134,6 +229,9 @@ func (api *APIHandler) GetNote(ctx context.Context, req *connect.Request[v1.GetN
 	if err != nil {
 		return nil, err
 	}
+	if len(visibleNotes(ctx, res)) == 0 {
+		return nil, handleError(serrors.NewError("note", serrors.ErrNotFound))
+	}
 	return connect.NewResponse(&v1.GetNoteResponse{Note: res}), nil
 }
 
@@ -144,39 +242,200 @@ func (api *APIHandler) ListNotes(ctx context.Context, req *connect.Request[v1.Li
 	if err != nil {
 		return nil, handleError(err)
 	}
-	return connect.NewResponse(&v1.ListNotesResponse{Notes: res}), nil
+	return connect.NewResponse(&v1.ListNotesResponse{Notes: visibleNotes(ctx, res...)}), nil
 }
 
 // AddNote adds a Note to an Item
+// only authenticated callers can add internal notes
 func (api *APIHandler) AddNote(ctx context.Context, req *connect.Request[v1.AddNoteRequest]) (*connect.Response[v1.AddNoteResponse], error) {
 	itemID := req.Msg.GetItemId()
 	noteText := req.Msg.GetNoteText()
-	res, err := api.sts.AddNote(ctx, itemID, noteText)
-	if err != nil {
+	opts := []filters.FilterOption{}
+	if visibility := req.Msg.GetVisibility(); visibility != v1.NoteVisibility_NOTE_VISIBILITY_UNKNOWN {
+		if visibility != v1.NoteVisibility_NOTE_VISIBILITY_PUBLIC {
+			if err := requireIdentity(ctx); err != nil {
+				return nil, err
+			}
+		}
+		opts = append(opts, filters.WithNoteVisibility(visibility))
+	}
+	if req.Msg.GetPinned() {
+		opts = append(opts, filters.WithPinned(true))
+	}
+	res := &v1.AddNoteResponse{}
+	if err := api.sts.Idempotent(ctx, req.Header().Get(IdempotencyKeyHeader), req.Msg, res, func() error {
+		note, err := api.sts.AddNote(ctx, itemID, noteText, opts...)
+		res.Note = note
+		return err
+	}); err != nil {
 		return nil, handleError(err)
 	}
-	return connect.NewResponse(&v1.AddNoteResponse{Note: res}), nil
+	return connect.NewResponse(res), nil
 }
 
 // UpdateNote edits an existing Note
+// when an update mask is provided only the masked fields are changed. note text can't be cleared
+// a masked visibility left empty resets the note to public and a masked pinned left empty unpins it
+// internal notes can only be changed by authenticated callers, and only they can change visibility
 func (api *APIHandler) UpdateNote(ctx context.Context, req *connect.Request[v1.UpdateNoteRequest]) (*connect.Response[v1.UpdateNoteResponse], error) {
 	noteID := req.Msg.GetNoteId()
 	noteText := req.Msg.GetNoteText()
-	if err := api.sts.EditNote(ctx, noteID, noteText); err != nil {
+	mask, err := newUpdateMask(req.Msg.GetUpdateMask(), "note_text", "visibility", "pinned")
+	if err != nil {
+		return nil, handleError(err)
+	}
+	if _, err := api.visibleNote(ctx, noteID); err != nil {
+		return nil, handleError(err)
+	}
+	if mask.paths["note_text"] && strings.TrimSpace(noteText) == "" {
+		return nil, handleError(serrors.NewError("note_text", serrors.ErrEmptyString))
+	}
+	if mask.paths != nil && !mask.paths["note_text"] {
+		noteText = ""
+	}
+	opts := []filters.FilterOption{}
+	if visibility := req.Msg.GetVisibility(); mask.write("visibility", visibility != v1.NoteVisibility_NOTE_VISIBILITY_UNKNOWN) {
+		if err := requireIdentity(ctx); err != nil {
+			return nil, err
+		}
+		if visibility == v1.NoteVisibility_NOTE_VISIBILITY_UNKNOWN {
+			visibility = v1.NoteVisibility_NOTE_VISIBILITY_PUBLIC
+		}
+		opts = append(opts, filters.WithNoteVisibility(visibility))
+	}
+	if pinned := req.Msg.GetPinned(); mask.write("pinned", pinned) {
+		opts = append(opts, filters.WithPinned(pinned))
+	}
+	if version := req.Msg.GetExpectedVersion(); version != 0 {
+		opts = append(opts, filters.WithExpectedVersion(version))
+	}
+	if err := api.sts.EditNote(ctx, noteID, noteText, opts...); err != nil {
 		return nil, handleError(err)
 	}
 	return &connect.Response[v1.UpdateNoteResponse]{}, nil
 }
 
 // DeleteNote deletes a Note from an Item
+// internal notes can only be deleted by authenticated callers
 func (api *APIHandler) DeleteNote(ctx context.Context, req *connect.Request[v1.DeleteNoteRequest]) (*connect.Response[v1.DeleteNoteResponse], error) {
 	noteID := req.Msg.GetNoteId()
+	if _, err := api.visibleNote(ctx, noteID); err != nil {
+		return nil, handleError(err)
+	}
 	if err := api.sts.RemoveNote(ctx, noteID); err != nil {
 		return nil, handleError(err)
 	}
 	return &connect.Response[v1.DeleteNoteResponse]{}, nil
 }
 
+// ListNoteRevisions gets every earlier version of a Note
+// revisions from while the note was internal are left out unless the caller is authenticated
+func (api *APIHandler) ListNoteRevisions(ctx context.Context, req *connect.Request[v1.ListNoteRevisionsRequest]) (*connect.Response[v1.ListNoteRevisionsResponse], error) {
+	noteID := req.Msg.GetNoteId()
+	if _, err := api.visibleNote(ctx, noteID); err != nil {
+		return nil, handleError(err)
+	}
+	res, err := api.sts.ListNoteRevisions(ctx, noteID)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	if _, ok := auth.FromContext(ctx); !ok {
+		public := []*v1.NoteRevision{}
+		for _, rev := range res {
+			if rev.GetVisibility() != v1.NoteVisibility_NOTE_VISIBILITY_INTERNAL {
+				public = append(public, rev)
+			}
+		}
+		res = public
+	}
+	return connect.NewResponse(&v1.ListNoteRevisionsResponse{Revisions: res}), nil
+}
+
+// UploadAttachment attaches a file to a Note
+// the first message must carry the AttachmentInfo and every following message a chunk of the file
+func (api *APIHandler) UploadAttachment(ctx context.Context, stream *connect.ClientStream[v1.UploadAttachmentRequest]) (*connect.Response[v1.UploadAttachmentResponse], error) {
+	if !stream.Receive() {
+		if err := stream.Err(); err != nil {
+			return nil, err
+		}
+		return nil, handleError(serrors.NewError("info", serrors.ErrNilVal))
+	}
+	info := stream.Msg().GetInfo()
+	if info == nil {
+		return nil, handleError(serrors.NewError("info", serrors.ErrNilVal))
+	}
+	if _, err := api.visibleNote(ctx, info.GetNoteId()); err != nil {
+		return nil, handleError(err)
+	}
+	res, err := api.sts.AddAttachment(ctx, info.GetNoteId(), info.GetFilename(), &uploadReader{stream: stream})
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return connect.NewResponse(&v1.UploadAttachmentResponse{Attachment: res}), nil
+}
+
+// ListAttachments gets all Attachment of a Note
+func (api *APIHandler) ListAttachments(ctx context.Context, req *connect.Request[v1.ListAttachmentsRequest]) (*connect.Response[v1.ListAttachmentsResponse], error) {
+	noteID := req.Msg.GetNoteId()
+	if _, err := api.visibleNote(ctx, noteID); err != nil {
+		return nil, handleError(err)
+	}
+	res, err := api.sts.ListAttachments(ctx, noteID)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return connect.NewResponse(&v1.ListAttachmentsResponse{Attachments: res}), nil
+}
+
+// DownloadAttachment sends the Attachment followed by the contents of the file in chunks
+func (api *APIHandler) DownloadAttachment(ctx context.Context, req *connect.Request[v1.DownloadAttachmentRequest], stream *connect.ServerStream[v1.DownloadAttachmentResponse]) error {
+	att, err := api.sts.GetAttachment(ctx, req.Msg.GetAttachmentId())
+	if err != nil {
+		return handleError(err)
+	}
+	if _, err := api.visibleNote(ctx, att.GetNoteId()); err != nil {
+		return handleError(err)
+	}
+	_, rc, err := api.sts.OpenAttachment(ctx, att.GetId())
+	if err != nil {
+		return handleError(err)
+	}
+	defer rc.Close()
+	if err := stream.Send(&v1.DownloadAttachmentResponse{Data: &v1.DownloadAttachmentResponse_Attachment{Attachment: att}}); err != nil {
+		return err
+	}
+	buf := make([]byte, attachmentChunkSize)
+	for {
+		n, err := rc.Read(buf)
+		if n > 0 {
+			if serr := stream.Send(&v1.DownloadAttachmentResponse{Data: &v1.DownloadAttachmentResponse_Chunk{Chunk: buf[:n]}}); serr != nil {
+				return serr
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return handleError(serrors.NewWrappedError("read", serrors.ErrUnrecoverable, err))
+		}
+	}
+}
+
+// DeleteAttachment deletes an Attachment
+func (api *APIHandler) DeleteAttachment(ctx context.Context, req *connect.Request[v1.DeleteAttachmentRequest]) (*connect.Response[v1.DeleteAttachmentResponse], error) {
+	att, err := api.sts.GetAttachment(ctx, req.Msg.GetAttachmentId())
+	if err != nil {
+		return nil, handleError(err)
+	}
+	if _, err := api.visibleNote(ctx, att.GetNoteId()); err != nil {
+		return nil, handleError(err)
+	}
+	if err := api.sts.RemoveAttachment(ctx, att.GetId()); err != nil {
+		return nil, handleError(err)
+	}
+	return connect.NewResponse(&v1.DeleteAttachmentResponse{}), nil
+}
+
 // GetStatus gets a Status by its Id
 func (api *APIHandler) GetStatus(ctx context.Context, req *connect.Request[v1.GetStatusRequest]) (*connect.Response[v1.GetStatusResponse], error) {
 	statusID := req.Msg.GetStatusId()
@@ -214,29 +473,42 @@ func (api *APIHandler) AddStatus(ctx context.Context, req *connect.Request[v1.Ad
 	if strings.TrimSpace(desc) != "" {
 		opts = append(opts, filters.WithDescription(desc))
 	}
-	res, err := api.sts.AddStatus(ctx, name, kind, opts...)
-	if err != nil {
+	res := &v1.AddStatusResponse{}
+	if err := api.sts.Idempotent(ctx, req.Header().Get(IdempotencyKeyHeader), req.Msg, res, func() error {
+		status, err := api.sts.AddStatus(ctx, name, kind, opts...)
+		res.Status = status
+		return err
+	}); err != nil {
 		return nil, handleError(err)
 	}
-	return connect.NewResponse(&v1.AddStatusResponse{Status: res}), nil
+	return connect.NewResponse(res), nil
 }
 
 // UpdateStatus updates an existing Status
+// when an update mask is provided only the masked fields are changed and masked fields left empty are cleared
 func (api *APIHandler) UpdateStatus(ctx context.Context, req *connect.Request[v1.UpdateStatusRequest]) (*connect.Response[v1.UpdateStatusResponse], error) {
 	id := req.Msg.GetStatusId()
+	mask, err := newUpdateMask(req.Msg.GetUpdateMask(), "name", "description", "color", "kind")
+	if err != nil {
+		return nil, handleError(err)
+	}
 	opts := []filters.FilterOption{}
-	if name := req.Msg.GetName(); name != "" {
+	if name := req.Msg.GetName(); mask.apply("name", name != "") {
 		opts = append(opts, filters.WithName(name))
 	}
-	if kind := req.Msg.GetKind(); kind != statusthingv1.StatusKind_STATUS_KIND_UNKNOWN {
+	if kind := req.Msg.GetKind(); mask.apply("kind", kind != statusthingv1.StatusKind_STATUS_KIND_UNKNOWN) {
 		opts = append(opts, filters.WithStatusKind(kind))
 	}
-	if description := req.Msg.GetDescription(); description != "" {
+	if description := req.Msg.GetDescription(); mask.apply("description", description != "") {
 		opts = append(opts, filters.WithDescription(description))
 	}
-	if color := req.Msg.GetColor(); color != "" {
+	if color := req.Msg.GetColor(); mask.apply("color", color != "") {
 		opts = append(opts, filters.WithColor(color))
 	}
+	opts = append(opts, mask.options()...)
+	if version := req.Msg.GetExpectedVersion(); version != 0 {
+		opts = append(opts, filters.WithExpectedVersion(version))
+	}
 
 	if err := api.sts.EditStatus(ctx, id, opts...); err != nil {
 		return nil, handleError(err)
@@ -253,9 +525,168 @@ func (api *APIHandler) DeleteStatus(ctx context.Context, req *connect.Request[v1
 	return &connect.Response[v1.DeleteStatusResponse]{}, nil
 }
 
+// GetSettings gets the site Settings
+func (api *APIHandler) GetSettings(ctx context.Context, req *connect.Request[v1.GetSettingsRequest]) (*connect.Response[v1.GetSettingsResponse], error) {
+	settings, err := api.sts.GetSettings(ctx)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return connect.NewResponse(&v1.GetSettingsResponse{Settings: settings}), nil
+}
+
+// UpdateSettings changes the site Settings
+// without an update mask every field with a value is changed
+// the settings are shown to every visitor of the public page so only authenticated callers can change them
+func (api *APIHandler) UpdateSettings(ctx context.Context, req *connect.Request[v1.UpdateSettingsRequest]) (*connect.Response[v1.UpdateSettingsResponse], error) {
+	if err := requireIdentity(ctx); err != nil {
+		return nil, err
+	}
+	if _, err := newUpdateMask(req.Msg.GetUpdateMask(), services.SettingsFields...); err != nil {
+		return nil, handleError(err)
+	}
+	update := services.SettingsUpdate{
+		Settings:        req.Msg.GetSettings(),
+		Fields:          req.Msg.GetUpdateMask().GetPaths(),
+		Logo:            req.Msg.GetLogo(),
+		Favicon:         req.Msg.GetFavicon(),
+		ExpectedVersion: req.Msg.GetExpectedVersion(),
+	}
+	if update.Settings == nil {
+		update.Settings = &v1.Settings{}
+	}
+	if len(update.Fields) == 0 {
+		update.Fields = settingsFieldsWithValues(req.Msg)
+	}
+	settings, err := api.sts.UpdateSettings(ctx, update)
+	if err != nil {
+		// every invalid setting was sent by the caller
+		if errors.Is(err, serrors.ErrInvalidData) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		return nil, handleError(err)
+	}
+	return connect.NewResponse(&v1.UpdateSettingsResponse{Settings: settings}), nil
+}
+
+// settingsFieldsWithValues returns the names of the settings fields with a value in the request
+func settingsFieldsWithValues(req *v1.UpdateSettingsRequest) []string {
+	settings := req.GetSettings()
+	fields := []string{}
+	values := []string{
+		settings.GetPageTitle(),
+		settings.GetAccentColor(),
+		settings.GetAccentTextColor(),
+		settings.GetSupportUrl(),
+		settings.GetFooterText(),
+		settings.GetTimezone(),
+	}
+	// the text fields come first in services.SettingsFields
+	for i, val := range values {
+		if strings.TrimSpace(val) != "" {
+			fields = append(fields, services.SettingsFields[i])
+		}
+	}
+	if len(req.GetLogo()) != 0 {
+		fields = append(fields, storers.SettingsLogo)
+	}
+	if len(req.GetFavicon()) != 0 {
+		fields = append(fields, storers.SettingsFavicon)
+	}
+	return fields
+}
+
+// requireIdentity returns an error unless the caller is authenticated
+// the data and settings rpcs read or change everything so anonymous callers can't use them
+func requireIdentity(ctx context.Context) error {
+	if _, ok := auth.FromContext(ctx); !ok {
+		return connect.NewError(connect.CodeUnauthenticated, serrors.NewError("identity", serrors.ErrMissingCredentials))
+	}
+	return nil
+}
+
+// ExportData exports all data except users
+// users and their password hashes are only exported by the statusthing export command
+func (api *APIHandler) ExportData(ctx context.Context, _ *connect.Request[v1.ExportDataRequest]) (*connect.Response[v1.ExportDataResponse], error) {
+	if err := requireIdentity(ctx); err != nil {
+		return nil, err
+	}
+	ds, err := api.sts.ExportData(ctx, false)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	ds.Users = nil
+	// only authenticated callers get this far but exports follow note visibility like every other read
+	hideInternalNotes(ctx, ds.GetItems()...)
+	return connect.NewResponse(&v1.ExportDataResponse{Dataset: ds}), nil
+}
+
+// ImportData imports previously exported data
+// datasets with users are rejected. they can only be imported by the statusthing import command
+func (api *APIHandler) ImportData(ctx context.Context, req *connect.Request[v1.ImportDataRequest]) (*connect.Response[v1.ImportDataResponse], error) {
+	if err := requireIdentity(ctx); err != nil {
+		return nil, err
+	}
+	if req.Msg.GetDataset() == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, serrors.NewError("dataset", serrors.ErrNilVal))
+	}
+	if len(req.Msg.GetDataset().GetUsers()) != 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, serrors.NewError("users can only be imported with the statusthing import command", serrors.ErrInvalidData))
+	}
+	summary, err := api.sts.ImportData(ctx, req.Msg.GetDataset(), req.Msg.GetMode(), req.Msg.GetDryRun())
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return connect.NewResponse(&v1.ImportDataResponse{
+		Statuses:        uint32(summary.Statuses),
+		Items:           uint32(summary.Items),
+		Notes:           uint32(summary.Notes),
+		Dependencies:    uint32(summary.Dependencies),
+		RemovedItems:    uint32(summary.RemovedItems),
+		RemovedStatuses: uint32(summary.RemovedStatuses),
+		DryRun:          summary.DryRun,
+	}), nil
+}
+
+// BackupData writes a consistent copy of the database to the server's backup directory
+// only authenticated callers can write backups since each one rotates older backups out
+func (api *APIHandler) BackupData(ctx context.Context, _ *connect.Request[v1.BackupDataRequest]) (*connect.Response[v1.BackupDataResponse], error) {
+	if err := requireIdentity(ctx); err != nil {
+		return nil, err
+	}
+	path, err := api.sts.Backup(ctx)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return connect.NewResponse(&v1.BackupDataResponse{Path: path}), nil
+}
+
+// PruneData removes data according to the server's retention policy
+// only authenticated callers can prune since it deletes notes
+func (api *APIHandler) PruneData(ctx context.Context, req *connect.Request[v1.PruneDataRequest]) (*connect.Response[v1.PruneDataResponse], error) {
+	if err := requireIdentity(ctx); err != nil {
+		return nil, err
+	}
+	summary, err := api.sts.Prune(ctx, req.Msg.GetDryRun())
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return connect.NewResponse(&v1.PruneDataResponse{
+		NoteIds:         summary.NoteIDs,
+		IdempotencyKeys: uint32(summary.IdempotencyKeys),
+		SkippedItemIds:  summary.SkippedItemIDs,
+		DryRun:          summary.DryRun,
+		Blobs:           uint32(summary.Blobs),
+		Sessions:        uint32(summary.Sessions),
+	}), nil
+}
+
 func handleError(err error) *connect.Error {
 	slog.Error("handling error", "error", err)
-	if errors.Is(err, serrors.ErrEmptyString) {
+	var batchErr *serrors.BatchError
+	if errors.As(err, &batchErr) {
+		return handleBatchError(batchErr)
+	}
+	if errors.Is(err, serrors.ErrEmptyString) || errors.Is(err, serrors.ErrAtLeastOne) {
 		return connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
@@ -266,9 +697,168 @@ func handleError(err error) *connect.Error {
 	if errors.Is(err, serrors.ErrNotFound) {
 		return connect.NewError(connect.CodeInvalidArgument, err)
 	}
+	if errors.Is(err, serrors.ErrCycle) || errors.Is(err, serrors.ErrUnknownField) || errors.Is(err, serrors.ErrIdempotencyMismatch) {
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	}
 	if errors.Is(err, serrors.ErrStoreUnavailable) {
 		return connect.NewError(connect.CodeFailedPrecondition, err)
 	}
+	if errors.Is(err, serrors.ErrConflict) {
+		return connect.NewError(connect.CodeAborted, err)
+	}
+	if errors.Is(err, serrors.ErrTooLarge) {
+		return connect.NewError(connect.CodeResourceExhausted, err)
+	}
+	if errors.Is(err, serrors.ErrUnsupportedType) {
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	}
 	// fallthrough
 	return connect.NewError(connect.CodeInternal, fmt.Errorf("unexpected error"))
 }
+
+// handleBatchError returns a [connect.Error] with a [v1.BatchItemError] detail for each failed item
+// the code is taken from the first failed item
+func handleBatchError(batchErr *serrors.BatchError) *connect.Error {
+	ids := batchErr.IDs()
+	code := connect.CodeInternal
+	details := []*connect.ErrorDetail{}
+	for i, id := range ids {
+		itemErr := handleError(batchErr.Errors[id])
+		if i == 0 {
+			code = itemErr.Code()
+		}
+		detail, err := connect.NewErrorDetail(&v1.BatchItemError{ItemId: id, Message: itemErr.Message()})
+		if err != nil {
+			slog.Error("unable to create error detail", "error", err)
+			continue
+		}
+		details = append(details, detail)
+	}
+	cerr := connect.NewError(code, fmt.Errorf("%d items failed: %w", len(ids), serrors.ErrBatchFailed))
+	for _, detail := range details {
+		cerr.AddDetail(detail)
+	}
+	return cerr
+}
+
+// updateMask tracks which fields of an update request should be changed
+type updateMask struct {
+	// paths is nil when no mask was provided
+	paths   map[string]bool
+	cleared []string
+}
+
+// hideInternalNotes removes internal notes from the provided items and their children unless the caller is authenticated
+func hideInternalNotes(ctx context.Context, items ...*v1.Item) {
+	if _, ok := auth.FromContext(ctx); ok {
+		return
+	}
+	for _, item := range items {
+		if item == nil {
+			continue
+		}
+		item.Notes = services.PublicNotes(item.GetNotes())
+		hideInternalNotes(ctx, item.GetChildren()...)
+	}
+}
+
+// visibleNotes returns the provided notes without any internal notes unless the caller is authenticated
+func visibleNotes(ctx context.Context, notes ...*v1.Note) []*v1.Note {
+	if _, ok := auth.FromContext(ctx); ok {
+		return notes
+	}
+	return services.PublicNotes(notes)
+}
+
+// visibleNote gets the note with the provided id
+// [serrors.ErrNotFound] is returned for internal notes unless the caller is authenticated
+func (api *APIHandler) visibleNote(ctx context.Context, noteID string) (*v1.Note, error) {
+	note, err := api.sts.GetNote(ctx, noteID)
+	if err != nil {
+		return nil, err
+	}
+	if len(visibleNotes(ctx, note)) == 0 {
+		return nil, serrors.NewError("note", serrors.ErrNotFound)
+	}
+	return note, nil
+}
+
+// attachmentChunkSize is the size of each chunk of a downloaded attachment
+const attachmentChunkSize = 32 << 10
+
+// uploadReader reads the chunks of an UploadAttachment stream after the first message
+type uploadReader struct {
+	stream *connect.ClientStream[v1.UploadAttachmentRequest]
+	buf    []byte
+}
+
+func (u *uploadReader) Read(p []byte) (int, error) {
+	for len(u.buf) == 0 {
+		if !u.stream.Receive() {
+			if err := u.stream.Err(); err != nil {
+				return 0, err
+			}
+			return 0, io.EOF
+		}
+		if u.stream.Msg().GetInfo() != nil {
+			return 0, serrors.NewError("info sent after the first message", serrors.ErrInvalidData)
+		}
+		u.buf = u.stream.Msg().GetChunk()
+	}
+	n := copy(p, u.buf)
+	u.buf = u.buf[n:]
+	return n, nil
+}
+
+// newUpdateMask returns an [updateMask] for the provided [fieldmaskpb.FieldMask] after checking every path is one of the provided updatable fields
+// a nil or empty mask means every field with a value is changed
+func newUpdateMask(mask *fieldmaskpb.FieldMask, updatable ...string) (*updateMask, error) {
+	res := &updateMask{}
+	if len(mask.GetPaths()) == 0 {
+		return res, nil
+	}
+	allowed := map[string]bool{}
+	for _, path := range updatable {
+		allowed[path] = true
+	}
+	res.paths = map[string]bool{}
+	for _, path := range mask.GetPaths() {
+		if !allowed[path] {
+			return nil, serrors.NewError(path, serrors.ErrUnknownField)
+		}
+		res.paths[path] = true
+	}
+	return res, nil
+}
+
+// apply reports whether the field with the provided path should be set
+// masked fields without a value are recorded so they can be cleared
+func (m *updateMask) apply(path string, hasValue bool) bool {
+	if m.paths == nil {
+		return hasValue
+	}
+	if !m.paths[path] {
+		return false
+	}
+	if !hasValue {
+		m.cleared = append(m.cleared, path)
+	}
+	return hasValue
+}
+
+// write reports whether the field with the provided path should be written
+// unlike apply, masked fields without a value are written too. this is for fields that can't be null
+func (m *updateMask) write(path string, hasValue bool) bool {
+	if m.paths == nil {
+		return hasValue
+	}
+	return m.paths[path]
+}
+
+// options returns the [filters.FilterOption] needed to clear any masked fields without a value
+func (m *updateMask) options() []filters.FilterOption {
+	if len(m.cleared) == 0 {
+		return nil
+	}
+	return []filters.FilterOption{filters.WithClearedFields(m.cleared...)}
+}
@@ -2,27 +2,32 @@ package handlers
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/bufbuild/connect-go"
 	"github.com/go-chi/chi"
 	statusthingv1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
 	v1connect "github.com/lusis/statusthing/gen/go/statusthing/v1/statusthingv1connect"
+	"github.com/lusis/statusthing/internal/auth"
 	"github.com/lusis/statusthing/internal/filters"
 	"github.com/lusis/statusthing/internal/serrors"
 	"github.com/lusis/statusthing/internal/services"
 	"github.com/lusis/statusthing/internal/storers/memdb"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
 func TestImplements(t *testing.T) {
 	require.Implements(t, (*v1connect.ItemsServiceHandler)(nil), new(APIHandler), "should satisfy items rpc interface")
 	require.Implements(t, (*v1connect.NotesServiceHandler)(nil), new(APIHandler), "should satisfy notes rpc interface")
 	require.Implements(t, (*v1connect.StatusServiceHandler)(nil), new(APIHandler), "should sastify statuses rpc interface")
+	require.Implements(t, (*v1connect.SettingsServiceHandler)(nil), new(APIHandler), "should satisfy settings rpc interface")
 }
 
 func TestNew(t *testing.T) {
@@ -184,6 +189,54 @@ func TestUpdateStatus(t *testing.T) {
 		}))
 		require.ErrorIs(t, uerr, serrors.ErrNotFound)
 	})
+	t.Run("update-mask", func(t *testing.T) {
+		ctx := context.TODO()
+		api, _, httpSrv, err := apiTestSetup(t)
+		defer httpSrv.Close()
+		require.NoError(t, err)
+
+		status, err := api.sts.AddStatus(ctx, t.Name(), statusthingv1.StatusKind_STATUS_KIND_AVAILABLE, filters.WithColor("red"), filters.WithDescription("some-description"))
+		require.NoError(t, err)
+
+		// name is ignored since it isn't in the mask and the empty color is cleared
+		_, uerr := api.UpdateStatus(ctx, connect.NewRequest(&statusthingv1.UpdateStatusRequest{
+			StatusId:    status.GetId(),
+			Name:        "new-name",
+			Description: "new-description",
+			UpdateMask:  &fieldmaskpb.FieldMask{Paths: []string{"description", "color"}},
+		}))
+		require.NoError(t, uerr)
+		res, err := api.sts.GetStatus(ctx, status.GetId())
+		require.NoError(t, err)
+		require.Equal(t, t.Name(), res.GetName())
+		require.Equal(t, "new-description", res.GetDescription())
+		require.Empty(t, res.GetColor())
+		require.Equal(t, statusthingv1.StatusKind_STATUS_KIND_AVAILABLE, res.GetKind())
+	})
+	t.Run("update-mask-errors", func(t *testing.T) {
+		ctx := context.TODO()
+		api, _, httpSrv, err := apiTestSetup(t)
+		defer httpSrv.Close()
+		require.NoError(t, err)
+
+		status, err := api.sts.AddStatus(ctx, t.Name(), statusthingv1.StatusKind_STATUS_KIND_AVAILABLE)
+		require.NoError(t, err)
+
+		for _, path := range []string{"status_id", "expected_version", "not-a-field"} {
+			_, uerr := api.UpdateStatus(ctx, connect.NewRequest(&statusthingv1.UpdateStatusRequest{
+				StatusId:   status.GetId(),
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{path}},
+			}))
+			require.ErrorIs(t, uerr, serrors.ErrUnknownField, path)
+			require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(uerr), path)
+		}
+		// kind can't be cleared
+		_, uerr := api.UpdateStatus(ctx, connect.NewRequest(&statusthingv1.UpdateStatusRequest{
+			StatusId:   status.GetId(),
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"kind"}},
+		}))
+		require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(uerr))
+	})
 }
 func TestDeleteStatus(t *testing.T) {
 	t.Parallel()
@@ -597,6 +650,94 @@ func TestUpdateItem(t *testing.T) {
 		// assert
 		require.ErrorIs(t, err2, serrors.ErrNotFound)
 	})
+
+	t.Run("version-conflict", func(t *testing.T) {
+		// arrange
+		ctx := context.TODO()
+		api, _, httpSrv, err := apiTestSetup(t)
+		defer httpSrv.Close()
+		require.NoError(t, err)
+
+		item, err := api.sts.AddItem(ctx, t.Name())
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), item.GetVersion())
+		_, err = api.UpdateItem(ctx, connect.NewRequest(&statusthingv1.UpdateItemRequest{
+			ItemId:          item.GetId(),
+			Name:            "new-name",
+			ExpectedVersion: item.GetVersion(),
+		}))
+		require.NoError(t, err)
+
+		// act
+		_, err = api.UpdateItem(ctx, connect.NewRequest(&statusthingv1.UpdateItemRequest{
+			ItemId:          item.GetId(),
+			Name:            "newer-name",
+			ExpectedVersion: item.GetVersion(),
+		}))
+
+		// assert
+		require.ErrorIs(t, err, serrors.ErrConflict)
+		require.Equal(t, connect.CodeAborted, connect.CodeOf(err))
+		res, err := api.GetItem(ctx, connect.NewRequest(&statusthingv1.GetItemRequest{ItemId: item.GetId()}))
+		require.NoError(t, err)
+		require.Equal(t, "new-name", res.Msg.GetItem().GetName())
+		require.Equal(t, uint64(2), res.Msg.GetItem().GetVersion())
+	})
+
+	t.Run("update-mask", func(t *testing.T) {
+		// arrange
+		ctx := context.TODO()
+		api, _, httpSrv, err := apiTestSetup(t)
+		defer httpSrv.Close()
+		require.NoError(t, err)
+
+		parent, err := api.sts.AddItem(ctx, t.Name()+"_parent")
+		require.NoError(t, err)
+		item, err := api.sts.AddItem(ctx, t.Name(), filters.WithDescription("some-description"), filters.WithParentID(parent.GetId()))
+		require.NoError(t, err)
+		require.Equal(t, "some-description", item.GetDescription())
+		require.Equal(t, parent.GetId(), item.GetParentId())
+
+		// act
+		_, err = api.UpdateItem(ctx, connect.NewRequest(&statusthingv1.UpdateItemRequest{
+			ItemId:     item.GetId(),
+			Name:       "new-name",
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"description", "parent_id"}},
+		}))
+
+		// assert
+		require.NoError(t, err)
+		res, err := api.sts.GetItem(ctx, item.GetId())
+		require.NoError(t, err)
+		require.Equal(t, t.Name(), res.GetName())
+		require.Empty(t, res.GetDescription())
+		require.Empty(t, res.GetParentId())
+	})
+
+	t.Run("update-mask-unknown-path", func(t *testing.T) {
+		// arrange
+		ctx := context.TODO()
+		api, _, httpSrv, err := apiTestSetup(t)
+		defer httpSrv.Close()
+		require.NoError(t, err)
+
+		item, err := api.sts.AddItem(ctx, t.Name())
+		require.NoError(t, err)
+
+		// act
+		_, err = api.UpdateItem(ctx, connect.NewRequest(&statusthingv1.UpdateItemRequest{
+			ItemId:     item.GetId(),
+			Name:       "new-name",
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"name", "notes"}},
+		}))
+
+		// assert
+		require.ErrorIs(t, err, serrors.ErrUnknownField)
+		require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+		res, err := api.sts.GetItem(ctx, item.GetId())
+		require.NoError(t, err)
+		require.Equal(t, t.Name(), res.GetName())
+	})
 }
 func TestDeleteItem(t *testing.T) {
 	t.Run("existing", func(t *testing.T) {
@@ -704,6 +845,158 @@ func TestAddNote(t *testing.T) {
 		require.Nil(t, res)
 	})
 }
+func TestIdempotencyKey(t *testing.T) {
+	t.Parallel()
+	ctx := context.TODO()
+	api, _, httpSrv, err := apiTestSetup(t)
+	defer httpSrv.Close()
+	require.NoError(t, err)
+
+	item, err := api.sts.AddItem(ctx, t.Name())
+	require.NoError(t, err)
+
+	addNote := func(key, text string) (*connect.Response[statusthingv1.AddNoteResponse], error) {
+		req := connect.NewRequest(&statusthingv1.AddNoteRequest{ItemId: item.GetId(), NoteText: text})
+		req.Header().Set(IdempotencyKeyHeader, key)
+		return api.AddNote(ctx, req)
+	}
+	first, err := addNote("some-key", t.Name())
+	require.NoError(t, err)
+	retry, err := addNote("some-key", t.Name())
+	require.NoError(t, err)
+	require.Equal(t, first.Msg.GetNote().GetId(), retry.Msg.GetNote().GetId(), "retry should replay the original response")
+
+	_, err = addNote("some-key", "different text")
+	require.ErrorIs(t, err, serrors.ErrIdempotencyMismatch)
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	// without a key every request creates a note
+	_, err = addNote("", t.Name())
+	require.NoError(t, err)
+	notes, err := api.sts.FindNotes(ctx, item.GetId())
+	require.NoError(t, err)
+	require.Len(t, notes, 2)
+}
+
+func TestExportImportData(t *testing.T) {
+	t.Parallel()
+	ctx := context.TODO()
+	api, _, httpSrv, err := apiTestSetup(t)
+	defer httpSrv.Close()
+	require.NoError(t, err)
+
+	item, err := api.sts.AddItem(ctx, t.Name(), filters.WithNoteText(t.Name()))
+	require.NoError(t, err)
+	_, err = api.sts.AddUser(ctx, t.Name(), "password1", "user@example.com")
+	require.NoError(t, err)
+	_, err = api.sts.AddNote(ctx, item.GetId(), "internal", filters.WithNoteVisibility(statusthingv1.NoteVisibility_NOTE_VISIBILITY_INTERNAL))
+	require.NoError(t, err)
+
+	_, err = api.ExportData(ctx, connect.NewRequest(&statusthingv1.ExportDataRequest{}))
+	require.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err), "anonymous callers should not be able to export")
+	_, err = api.ImportData(ctx, connect.NewRequest(&statusthingv1.ImportDataRequest{Dataset: &statusthingv1.Dataset{}}))
+	require.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err), "anonymous callers should not be able to import")
+
+	ctx = auth.NewContext(ctx, &auth.Identity{Name: "ci-bot", Source: "test"})
+	exported, err := api.ExportData(ctx, connect.NewRequest(&statusthingv1.ExportDataRequest{}))
+	require.NoError(t, err)
+	require.Len(t, exported.Msg.GetDataset().GetItems(), 1)
+	require.Empty(t, exported.Msg.GetDataset().GetUsers(), "users should not be exported over the api")
+	require.Len(t, exported.Msg.GetDataset().GetItems()[0].GetNotes(), 2, "authenticated exports should include internal notes")
+
+	target, _, targetSrv, err := apiTestSetup(t)
+	defer targetSrv.Close()
+	require.NoError(t, err)
+
+	dryRun, err := target.ImportData(ctx, connect.NewRequest(&statusthingv1.ImportDataRequest{Dataset: exported.Msg.GetDataset(), DryRun: true}))
+	require.NoError(t, err)
+	require.True(t, dryRun.Msg.GetDryRun())
+	require.Equal(t, uint32(1), dryRun.Msg.GetItems())
+	_, err = target.sts.GetItem(ctx, item.GetId())
+	require.ErrorIs(t, err, serrors.ErrNotFound)
+
+	res, err := target.ImportData(ctx, connect.NewRequest(&statusthingv1.ImportDataRequest{Dataset: exported.Msg.GetDataset(), Mode: statusthingv1.ImportMode_IMPORT_MODE_REPLACE}))
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), res.Msg.GetItems())
+	require.Equal(t, uint32(2), res.Msg.GetNotes())
+	imported, err := target.sts.GetItem(ctx, item.GetId())
+	require.NoError(t, err)
+	require.Equal(t, item.GetName(), imported.GetName())
+
+	_, err = target.ImportData(ctx, connect.NewRequest(&statusthingv1.ImportDataRequest{}))
+	require.ErrorIs(t, err, serrors.ErrNilVal)
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	withUsers := &statusthingv1.Dataset{Users: []*statusthingv1.User{{Username: "intruder", Password: "$argon2id$v=19$m=65536,t=1,p=2$c2FsdA$aGFzaA", Role: statusthingv1.Role_ROLE_ADMIN}}}
+	_, err = target.ImportData(ctx, connect.NewRequest(&statusthingv1.ImportDataRequest{Dataset: withUsers}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), "users should not be imported over the api")
+	_, err = target.sts.GetUser(ctx, "intruder")
+	require.ErrorIs(t, err, serrors.ErrNotFound)
+}
+
+func TestBackupData(t *testing.T) {
+	t.Parallel()
+	ctx := context.TODO()
+	api, _, httpSrv, err := apiTestSetup(t)
+	defer httpSrv.Close()
+	require.NoError(t, err)
+
+	_, err = api.BackupData(ctx, connect.NewRequest(&statusthingv1.BackupDataRequest{}))
+	require.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err), "anonymous callers should not be able to write backups")
+
+	ctx = auth.NewContext(ctx, &auth.Identity{Name: "ci-bot", Source: "test"})
+	_, err = api.BackupData(ctx, connect.NewRequest(&statusthingv1.BackupDataRequest{}))
+	require.ErrorIs(t, err, serrors.ErrNotImplemented)
+	require.Equal(t, connect.CodeUnimplemented, connect.CodeOf(err))
+
+	store, err := memdb.New()
+	require.NoError(t, err)
+	sts, err := services.NewStatusThingService(store, services.WithBackupDir(t.TempDir()))
+	require.NoError(t, err)
+	api, err = NewAPIHandler(sts)
+	require.NoError(t, err)
+	res, err := api.BackupData(ctx, connect.NewRequest(&statusthingv1.BackupDataRequest{}))
+	require.NoError(t, err)
+	require.FileExists(t, res.Msg.GetPath())
+}
+
+func TestPruneData(t *testing.T) {
+	t.Parallel()
+	ctx := context.TODO()
+	store, err := memdb.New()
+	require.NoError(t, err)
+	sts, err := services.NewStatusThingService(store, services.WithRetentionPolicy(services.RetentionPolicy{NoteMaxCount: 1}))
+	require.NoError(t, err)
+	api, err := NewAPIHandler(sts)
+	require.NoError(t, err)
+
+	item, err := sts.AddItem(ctx, t.Name(), filters.WithNoteText("first"))
+	require.NoError(t, err)
+	_, err = sts.AddNote(ctx, item.GetId(), "second")
+	require.NoError(t, err)
+	require.NoError(t, store.StoreSession(ctx, "expired", []byte("data"), time.Now().Add(-time.Hour)))
+
+	_, err = api.PruneData(ctx, connect.NewRequest(&statusthingv1.PruneDataRequest{DryRun: true}))
+	require.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err), "anonymous callers should not be able to prune")
+
+	ctx = auth.NewContext(ctx, &auth.Identity{Name: "ci-bot", Source: "test"})
+	res, err := api.PruneData(ctx, connect.NewRequest(&statusthingv1.PruneDataRequest{DryRun: true}))
+	require.NoError(t, err)
+	require.True(t, res.Msg.GetDryRun())
+	require.Len(t, res.Msg.GetNoteIds(), 1)
+	notes, err := sts.FindNotes(ctx, item.GetId())
+	require.NoError(t, err)
+	require.Len(t, notes, 2)
+
+	res, err = api.PruneData(ctx, connect.NewRequest(&statusthingv1.PruneDataRequest{}))
+	require.NoError(t, err)
+	require.Len(t, res.Msg.GetNoteIds(), 1)
+	require.Equal(t, uint32(1), res.Msg.GetSessions())
+	notes, err = sts.FindNotes(ctx, item.GetId())
+	require.NoError(t, err)
+	require.Len(t, notes, 1)
+}
+
 func TestDeleteNote(t *testing.T) {
 	t.Parallel()
 	t.Run("happy-path", func(t *testing.T) {
@@ -779,6 +1072,52 @@ func TestUpdateNote(t *testing.T) {
 		require.ErrorIs(t, err, serrors.ErrNotFound)
 		require.Nil(t, res)
 	})
+	t.Run("not-found-without-text", func(t *testing.T) {
+		// arrange
+		ctx := context.TODO()
+		api, _, httpSrv, err := apiTestSetup(t)
+		defer httpSrv.Close()
+		require.NoError(t, err)
+
+		for _, path := range []string{"pinned", "visibility"} {
+			// act
+			res, err := api.UpdateNote(ctx, connect.NewRequest(&statusthingv1.UpdateNoteRequest{
+				NoteId:     t.Name(),
+				Pinned:     true,
+				Visibility: statusthingv1.NoteVisibility_NOTE_VISIBILITY_INTERNAL,
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{path}},
+			}))
+
+			// assert
+			require.ErrorIs(t, err, serrors.ErrNotFound, path)
+			require.Nil(t, res)
+		}
+	})
+	t.Run("version-conflict", func(t *testing.T) {
+		// arrange
+		ctx := context.TODO()
+		api, _, httpSrv, err := apiTestSetup(t)
+		defer httpSrv.Close()
+		require.NoError(t, err)
+
+		item, err := api.sts.AddItem(ctx, t.Name())
+		require.NoError(t, err)
+		note, err := api.sts.AddNote(ctx, item.GetId(), t.Name())
+		require.NoError(t, err)
+		require.NoError(t, api.sts.EditNote(ctx, note.GetId(), "changed-elsewhere"))
+
+		// act
+		res, err := api.UpdateNote(ctx, connect.NewRequest(&statusthingv1.UpdateNoteRequest{
+			NoteId:          note.GetId(),
+			NoteText:        "new-note-text",
+			ExpectedVersion: note.GetVersion(),
+		}))
+
+		// assert
+		require.ErrorIs(t, err, serrors.ErrConflict)
+		require.Equal(t, connect.CodeAborted, connect.CodeOf(err))
+		require.Nil(t, res)
+	})
 }
 func TestGetNote(t *testing.T) {
 	t.Run("happy-path", func(t *testing.T) {
@@ -819,6 +1158,302 @@ func TestGetNote(t *testing.T) {
 		require.Nil(t, res)
 	})
 }
+func TestNoteVisibility(t *testing.T) {
+	ctx := context.TODO()
+	api, _, httpSrv, err := apiTestSetup(t)
+	defer httpSrv.Close()
+	require.NoError(t, err)
+	authed := auth.NewContext(ctx, &auth.Identity{Name: "ci-bot", Source: "test"})
+
+	item, err := api.sts.AddItem(ctx, t.Name())
+	require.NoError(t, err)
+	public, err := api.AddNote(authed, connect.NewRequest(&statusthingv1.AddNoteRequest{ItemId: item.GetId(), NoteText: "public"}))
+	require.NoError(t, err)
+	require.Equal(t, "ci-bot", public.Msg.GetNote().GetAuthor(), "the author should be the authenticated caller")
+	require.Equal(t, statusthingv1.NoteVisibility_NOTE_VISIBILITY_PUBLIC, public.Msg.GetNote().GetVisibility())
+	internal, err := api.AddNote(authed, connect.NewRequest(&statusthingv1.AddNoteRequest{
+		ItemId:     item.GetId(),
+		NoteText:   "internal",
+		Visibility: statusthingv1.NoteVisibility_NOTE_VISIBILITY_INTERNAL,
+	}))
+	require.NoError(t, err)
+	pinned, err := api.AddNote(ctx, connect.NewRequest(&statusthingv1.AddNoteRequest{ItemId: item.GetId(), NoteText: "pinned", Pinned: true}))
+	require.NoError(t, err)
+	require.Empty(t, pinned.Msg.GetNote().GetAuthor())
+
+	noteTexts := func(notes []*statusthingv1.Note) []string {
+		res := []string{}
+		for _, note := range notes {
+			res = append(res, note.GetText())
+		}
+		return res
+	}
+	t.Run("authenticated", func(t *testing.T) {
+		res, err := api.ListNotes(authed, connect.NewRequest(&statusthingv1.ListNotesRequest{ItemId: item.GetId()}))
+		require.NoError(t, err)
+		texts := noteTexts(res.Msg.GetNotes())
+		require.Len(t, texts, 3)
+		require.Equal(t, "pinned", texts[0], "pinned notes should be first")
+		require.ElementsMatch(t, []string{"public", "internal"}, texts[1:])
+		_, err = api.GetNote(authed, connect.NewRequest(&statusthingv1.GetNoteRequest{NoteId: internal.Msg.GetNote().GetId()}))
+		require.NoError(t, err)
+	})
+	t.Run("unauthenticated", func(t *testing.T) {
+		res, err := api.ListNotes(ctx, connect.NewRequest(&statusthingv1.ListNotesRequest{ItemId: item.GetId()}))
+		require.NoError(t, err)
+		require.Equal(t, []string{"pinned", "public"}, noteTexts(res.Msg.GetNotes()), "internal notes should be hidden")
+		_, err = api.GetNote(ctx, connect.NewRequest(&statusthingv1.GetNoteRequest{NoteId: internal.Msg.GetNote().GetId()}))
+		require.ErrorIs(t, err, serrors.ErrNotFound)
+		items, err := api.ListItems(ctx, connect.NewRequest(&statusthingv1.ListItemsRequest{}))
+		require.NoError(t, err)
+		require.Len(t, items.Msg.GetItems(), 1)
+		require.Equal(t, []string{"pinned", "public"}, noteTexts(items.Msg.GetItems()[0].GetNotes()))
+	})
+	t.Run("unauthenticated-writes", func(t *testing.T) {
+		internalID := internal.Msg.GetNote().GetId()
+		_, err := api.AddNote(ctx, connect.NewRequest(&statusthingv1.AddNoteRequest{
+			ItemId:     item.GetId(),
+			NoteText:   "sneaky",
+			Visibility: statusthingv1.NoteVisibility_NOTE_VISIBILITY_INTERNAL,
+		}))
+		require.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err), "anonymous callers should not add internal notes")
+		_, err = api.UpdateNote(ctx, connect.NewRequest(&statusthingv1.UpdateNoteRequest{
+			NoteId:     internalID,
+			Visibility: statusthingv1.NoteVisibility_NOTE_VISIBILITY_PUBLIC,
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"visibility"}},
+		}))
+		require.ErrorIs(t, err, serrors.ErrNotFound, "anonymous callers should not publish internal notes")
+		_, err = api.UpdateNote(ctx, connect.NewRequest(&statusthingv1.UpdateNoteRequest{NoteId: internalID, NoteText: "edited"}))
+		require.ErrorIs(t, err, serrors.ErrNotFound)
+		_, err = api.UpdateNote(ctx, connect.NewRequest(&statusthingv1.UpdateNoteRequest{
+			NoteId:     internalID,
+			Pinned:     true,
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"pinned"}},
+		}))
+		require.ErrorIs(t, err, serrors.ErrNotFound)
+		_, err = api.DeleteNote(ctx, connect.NewRequest(&statusthingv1.DeleteNoteRequest{NoteId: internalID}))
+		require.ErrorIs(t, err, serrors.ErrNotFound)
+		_, err = api.UpdateNote(ctx, connect.NewRequest(&statusthingv1.UpdateNoteRequest{
+			NoteId:     public.Msg.GetNote().GetId(),
+			Visibility: statusthingv1.NoteVisibility_NOTE_VISIBILITY_INTERNAL,
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"visibility"}},
+		}))
+		require.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err), "anonymous callers should not change visibility")
+
+		note, err := api.GetNote(authed, connect.NewRequest(&statusthingv1.GetNoteRequest{NoteId: internalID}))
+		require.NoError(t, err)
+		require.Equal(t, "internal", note.Msg.GetNote().GetText())
+		require.Equal(t, statusthingv1.NoteVisibility_NOTE_VISIBILITY_INTERNAL, note.Msg.GetNote().GetVisibility())
+		require.False(t, note.Msg.GetNote().GetPinned())
+		note, err = api.GetNote(ctx, connect.NewRequest(&statusthingv1.GetNoteRequest{NoteId: public.Msg.GetNote().GetId()}))
+		require.NoError(t, err)
+		require.Equal(t, statusthingv1.NoteVisibility_NOTE_VISIBILITY_PUBLIC, note.Msg.GetNote().GetVisibility())
+	})
+	t.Run("update", func(t *testing.T) {
+		_, err := api.UpdateNote(authed, connect.NewRequest(&statusthingv1.UpdateNoteRequest{
+			NoteId:     internal.Msg.GetNote().GetId(),
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"visibility"}},
+		}))
+		require.NoError(t, err)
+		_, err = api.UpdateNote(authed, connect.NewRequest(&statusthingv1.UpdateNoteRequest{
+			NoteId:     pinned.Msg.GetNote().GetId(),
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"pinned"}},
+		}))
+		require.NoError(t, err)
+		_, err = api.UpdateNote(authed, connect.NewRequest(&statusthingv1.UpdateNoteRequest{
+			NoteId:     public.Msg.GetNote().GetId(),
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"note_text", "pinned"}},
+			Pinned:     true,
+		}))
+		require.ErrorIs(t, err, serrors.ErrEmptyString, "note text can't be cleared")
+
+		res, err := api.ListNotes(ctx, connect.NewRequest(&statusthingv1.ListNotesRequest{ItemId: item.GetId()}))
+		require.NoError(t, err)
+		require.ElementsMatch(t, []string{"public", "internal", "pinned"}, noteTexts(res.Msg.GetNotes()), "a masked empty visibility should make the note public")
+		for _, note := range res.Msg.GetNotes() {
+			require.False(t, note.GetPinned(), "a masked empty pinned should unpin the note")
+		}
+	})
+}
+
+func TestListNoteRevisions(t *testing.T) {
+	ctx := context.TODO()
+	api, _, httpSrv, err := apiTestSetup(t)
+	defer httpSrv.Close()
+	require.NoError(t, err)
+	authed := auth.NewContext(ctx, &auth.Identity{Name: "ci-bot", Source: "test"})
+
+	item, err := api.sts.AddItem(ctx, t.Name())
+	require.NoError(t, err)
+	note, err := api.AddNote(authed, connect.NewRequest(&statusthingv1.AddNoteRequest{
+		ItemId:     item.GetId(),
+		NoteText:   "checking the database",
+		Visibility: statusthingv1.NoteVisibility_NOTE_VISIBILITY_INTERNAL,
+	}))
+	require.NoError(t, err)
+	noteID := note.Msg.GetNote().GetId()
+	_, err = api.UpdateNote(authed, connect.NewRequest(&statusthingv1.UpdateNoteRequest{
+		NoteId:     noteID,
+		NoteText:   "the database is recovering",
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"note_text", "visibility"}},
+	}))
+	require.NoError(t, err)
+	_, err = api.UpdateNote(authed, connect.NewRequest(&statusthingv1.UpdateNoteRequest{NoteId: noteID, NoteText: "the database has recovered"}))
+	require.NoError(t, err)
+
+	current, err := api.GetNote(ctx, connect.NewRequest(&statusthingv1.GetNoteRequest{NoteId: noteID}))
+	require.NoError(t, err)
+	require.True(t, current.Msg.GetNote().GetEdited())
+
+	res, err := api.ListNoteRevisions(authed, connect.NewRequest(&statusthingv1.ListNoteRevisionsRequest{NoteId: noteID}))
+	require.NoError(t, err)
+	require.Len(t, res.Msg.GetRevisions(), 2)
+	require.Equal(t, "checking the database", res.Msg.GetRevisions()[0].GetText())
+	require.Equal(t, "the database is recovering", res.Msg.GetRevisions()[1].GetText())
+
+	res, err = api.ListNoteRevisions(ctx, connect.NewRequest(&statusthingv1.ListNoteRevisionsRequest{NoteId: noteID}))
+	require.NoError(t, err)
+	require.Len(t, res.Msg.GetRevisions(), 1, "internal revisions should be hidden")
+	require.Equal(t, "the database is recovering", res.Msg.GetRevisions()[0].GetText())
+
+	_, err = api.ListNoteRevisions(ctx, connect.NewRequest(&statusthingv1.ListNoteRevisionsRequest{NoteId: "missing"}))
+	require.ErrorIs(t, err, serrors.ErrNotFound)
+}
+
+func TestAttachments(t *testing.T) {
+	ctx := context.TODO()
+	api, httpClient, httpSrv, err := apiTestSetup(t)
+	defer httpSrv.Close()
+	require.NoError(t, err)
+	client := v1connect.NewNotesServiceClient(httpClient, httpSrv.URL)
+
+	item, err := api.sts.AddItem(ctx, t.Name())
+	require.NoError(t, err)
+	note, err := api.sts.AddNote(ctx, item.GetId(), "see the attached log")
+	require.NoError(t, err)
+	internal, err := api.sts.AddNote(ctx, item.GetId(), "paged the database team", filters.WithNoteVisibility(statusthingv1.NoteVisibility_NOTE_VISIBILITY_INTERNAL))
+	require.NoError(t, err)
+
+	upload := func(noteID string, chunks ...string) (*statusthingv1.Attachment, error) {
+		stream := client.UploadAttachment(ctx)
+		if err := stream.Send(&statusthingv1.UploadAttachmentRequest{Data: &statusthingv1.UploadAttachmentRequest_Info{Info: &statusthingv1.AttachmentInfo{NoteId: noteID, Filename: "app.log"}}}); err != nil {
+			return nil, err
+		}
+		for _, c := range chunks {
+			if err := stream.Send(&statusthingv1.UploadAttachmentRequest{Data: &statusthingv1.UploadAttachmentRequest_Chunk{Chunk: []byte(c)}}); err != nil {
+				return nil, err
+			}
+		}
+		res, err := stream.CloseAndReceive()
+		if err != nil {
+			return nil, err
+		}
+		return res.Msg.GetAttachment(), nil
+	}
+
+	att, err := upload(note.GetId(), "connection ", "refused")
+	require.NoError(t, err)
+	require.Equal(t, "app.log", att.GetFilename())
+	require.Equal(t, "text/plain", att.GetContentType())
+	require.Equal(t, int64(len("connection refused")), att.GetSize())
+
+	_, err = upload(internal.GetId(), "secret")
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), "internal notes should look missing to anonymous callers")
+	_, err = upload(note.GetId(), "<html><body>hi</body></html>")
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	_, err = upload(note.GetId())
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	list, err := client.ListAttachments(ctx, connect.NewRequest(&statusthingv1.ListAttachmentsRequest{NoteId: note.GetId()}))
+	require.NoError(t, err)
+	require.Len(t, list.Msg.GetAttachments(), 1)
+	got, err := client.GetNote(ctx, connect.NewRequest(&statusthingv1.GetNoteRequest{NoteId: note.GetId()}))
+	require.NoError(t, err)
+	require.Len(t, got.Msg.GetNote().GetAttachments(), 1, "notes should include their attachments")
+
+	stream, err := client.DownloadAttachment(ctx, connect.NewRequest(&statusthingv1.DownloadAttachmentRequest{AttachmentId: att.GetId()}))
+	require.NoError(t, err)
+	require.True(t, stream.Receive())
+	require.Equal(t, att.GetSha256(), stream.Msg().GetAttachment().GetSha256())
+	var data []byte
+	for stream.Receive() {
+		data = append(data, stream.Msg().GetChunk()...)
+	}
+	require.NoError(t, stream.Err())
+	require.NoError(t, stream.Close())
+	require.Equal(t, "connection refused", string(data))
+
+	_, err = client.DeleteAttachment(ctx, connect.NewRequest(&statusthingv1.DeleteAttachmentRequest{AttachmentId: att.GetId()}))
+	require.NoError(t, err)
+	_, err = client.DeleteAttachment(ctx, connect.NewRequest(&statusthingv1.DeleteAttachmentRequest{AttachmentId: att.GetId()}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
+func TestSettings(t *testing.T) {
+	ctx := context.TODO()
+	api, httpClient, httpSrv, err := apiTestSetup(t)
+	defer httpSrv.Close()
+	require.NoError(t, err)
+	client := v1connect.NewSettingsServiceClient(httpClient, httpSrv.URL)
+
+	got, err := client.GetSettings(ctx, connect.NewRequest(&statusthingv1.GetSettingsRequest{}))
+	require.NoError(t, err)
+	require.Equal(t, services.DefaultPageTitle, got.Msg.GetSettings().GetPageTitle())
+	require.Equal(t, services.DefaultTimezone, got.Msg.GetSettings().GetTimezone())
+
+	_, err = client.UpdateSettings(ctx, connect.NewRequest(&statusthingv1.UpdateSettingsRequest{
+		Settings: &statusthingv1.Settings{SupportUrl: "https://phish.example.com"},
+	}))
+	require.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err), "anonymous callers should not be able to change settings")
+	got, err = client.GetSettings(ctx, connect.NewRequest(&statusthingv1.GetSettingsRequest{}))
+	require.NoError(t, err)
+	require.Empty(t, got.Msg.GetSettings().GetSupportUrl())
+
+	ctx = auth.NewContext(ctx, &auth.Identity{Name: "ci-bot", Source: "test"})
+	png := append([]byte("\x89PNG\r\n\x1a\n"), make([]byte, 16)...)
+	res, err := api.UpdateSettings(ctx, connect.NewRequest(&statusthingv1.UpdateSettingsRequest{
+		Settings: &statusthingv1.Settings{PageTitle: "Acme Status", AccentColor: "#123456", FooterText: "hosted by acme"},
+		Logo:     png,
+	}))
+	require.NoError(t, err)
+	settings := res.Msg.GetSettings()
+	require.Equal(t, "Acme Status", settings.GetPageTitle())
+	require.Equal(t, "#123456", settings.GetAccentColor())
+	require.Equal(t, services.DefaultTimezone, settings.GetTimezone())
+	require.Contains(t, settings.GetLogoUrl(), "/branding/logo?v=")
+	require.Empty(t, settings.GetFaviconUrl())
+
+	res, err = api.UpdateSettings(ctx, connect.NewRequest(&statusthingv1.UpdateSettingsRequest{
+		Settings:        &statusthingv1.Settings{Timezone: "America/New_York"},
+		ExpectedVersion: settings.GetVersion(),
+		UpdateMask:      &fieldmaskpb.FieldMask{Paths: []string{"timezone", "footer_text"}},
+	}))
+	require.NoError(t, err)
+	require.Equal(t, "America/New_York", res.Msg.GetSettings().GetTimezone())
+	require.Empty(t, res.Msg.GetSettings().GetFooterText(), "masked fields without a value should be cleared")
+	require.Equal(t, "Acme Status", res.Msg.GetSettings().GetPageTitle(), "unmasked fields should be left alone")
+
+	testCases := map[string]struct {
+		req  *statusthingv1.UpdateSettingsRequest
+		code connect.Code
+	}{
+		"nothing":       {req: &statusthingv1.UpdateSettingsRequest{}, code: connect.CodeInvalidArgument},
+		"bad-color":     {req: &statusthingv1.UpdateSettingsRequest{Settings: &statusthingv1.Settings{AccentColor: "red;}body{"}}, code: connect.CodeInvalidArgument},
+		"bad-timezone":  {req: &statusthingv1.UpdateSettingsRequest{Settings: &statusthingv1.Settings{Timezone: "Mars/Olympus_Mons"}}, code: connect.CodeInvalidArgument},
+		"bad-support":   {req: &statusthingv1.UpdateSettingsRequest{Settings: &statusthingv1.Settings{SupportUrl: "javascript:alert(1)"}}, code: connect.CodeInvalidArgument},
+		"unknown-field": {req: &statusthingv1.UpdateSettingsRequest{UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"version"}}}, code: connect.CodeInvalidArgument},
+		"not-an-image":  {req: &statusthingv1.UpdateSettingsRequest{Favicon: []byte("<svg onload=alert(1)>")}, code: connect.CodeInvalidArgument},
+		"stale-version": {req: &statusthingv1.UpdateSettingsRequest{Settings: &statusthingv1.Settings{PageTitle: "old"}, ExpectedVersion: settings.GetVersion()}, code: connect.CodeAborted},
+	}
+	for n, tc := range testCases {
+		t.Run(n, func(t *testing.T) {
+			_, err := api.UpdateSettings(ctx, connect.NewRequest(tc.req))
+			require.Error(t, err)
+			require.Equal(t, tc.code, connect.CodeOf(err))
+		})
+	}
+}
+
 func TestGetItem(t *testing.T) {
 	t.Parallel()
 	t.Run("happy-path", func(t *testing.T) {
@@ -854,6 +1489,183 @@ func TestGetItem(t *testing.T) {
 		require.Nil(t, res)
 	})
 }
+func TestItemGroups(t *testing.T) {
+	t.Parallel()
+	ctx := context.TODO()
+	api, _, httpSrv, err := apiTestSetup(t)
+	defer httpSrv.Close()
+	require.NoError(t, err)
+
+	pres, err := api.AddItem(ctx, connect.NewRequest(&statusthingv1.AddItemRequest{
+		Name:         t.Name() + "_parent",
+		RollupPolicy: statusthingv1.RollupPolicy_ROLLUP_POLICY_MAJORITY,
+	}))
+	require.NoError(t, err)
+	parent := pres.Msg.GetItem()
+	require.Equal(t, statusthingv1.RollupPolicy_ROLLUP_POLICY_MAJORITY, parent.GetRollupPolicy())
+
+	cres, err := api.AddItem(ctx, connect.NewRequest(&statusthingv1.AddItemRequest{
+		Name:     t.Name() + "_child",
+		ParentId: parent.GetId(),
+		InitialStatus: &statusthingv1.Status{
+			Name: t.Name(),
+			Kind: statusthingv1.StatusKind_STATUS_KIND_DOWN,
+		},
+	}))
+	require.NoError(t, err)
+	child := cres.Msg.GetItem()
+	require.Equal(t, parent.GetId(), child.GetParentId())
+
+	t.Run("flat", func(t *testing.T) {
+		res, err := api.ListItems(ctx, connect.NewRequest(&statusthingv1.ListItemsRequest{}))
+		require.NoError(t, err)
+		require.Len(t, res.Msg.GetItems(), 2)
+	})
+	t.Run("tree", func(t *testing.T) {
+		res, err := api.ListItems(ctx, connect.NewRequest(&statusthingv1.ListItemsRequest{Tree: true}))
+		require.NoError(t, err)
+		require.Len(t, res.Msg.GetItems(), 1)
+		root := res.Msg.GetItems()[0]
+		require.Equal(t, parent.GetId(), root.GetId())
+		require.Len(t, root.GetChildren(), 1)
+		require.Equal(t, child.GetId(), root.GetChildren()[0].GetId())
+		require.Equal(t, statusthingv1.StatusKind_STATUS_KIND_DOWN, root.GetRollupStatus().GetKind())
+	})
+	t.Run("cycle", func(t *testing.T) {
+		_, err := api.UpdateItem(ctx, connect.NewRequest(&statusthingv1.UpdateItemRequest{
+			ItemId:   parent.GetId(),
+			ParentId: child.GetId(),
+		}))
+		require.ErrorIs(t, err, serrors.ErrCycle)
+		require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	})
+}
+
+func TestItemDependencies(t *testing.T) {
+	t.Parallel()
+	ctx := context.TODO()
+	api, _, httpSrv, err := apiTestSetup(t)
+	defer httpSrv.Close()
+	require.NoError(t, err)
+
+	dres, err := api.AddItem(ctx, connect.NewRequest(&statusthingv1.AddItemRequest{
+		Name: t.Name() + "_db",
+		InitialStatus: &statusthingv1.Status{
+			Name: t.Name() + "_down",
+			Kind: statusthingv1.StatusKind_STATUS_KIND_DOWN,
+		},
+	}))
+	require.NoError(t, err)
+	database := dres.Msg.GetItem()
+	ares, err := api.AddItem(ctx, connect.NewRequest(&statusthingv1.AddItemRequest{
+		Name: t.Name() + "_app",
+		InitialStatus: &statusthingv1.Status{
+			Name: t.Name() + "_up",
+			Kind: statusthingv1.StatusKind_STATUS_KIND_UP,
+		},
+	}))
+	require.NoError(t, err)
+	app := ares.Msg.GetItem()
+
+	depres, err := api.AddItemDependency(ctx, connect.NewRequest(&statusthingv1.AddItemDependencyRequest{
+		ItemId:      app.GetId(),
+		DependsOnId: database.GetId(),
+	}))
+	require.NoError(t, err)
+	require.Equal(t, database.GetId(), depres.Msg.GetDependency().GetDependsOnId())
+
+	t.Run("cycle", func(t *testing.T) {
+		_, err := api.AddItemDependency(ctx, connect.NewRequest(&statusthingv1.AddItemDependencyRequest{
+			ItemId:      database.GetId(),
+			DependsOnId: app.GetId(),
+		}))
+		require.ErrorIs(t, err, serrors.ErrCycle)
+		require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	})
+	t.Run("effective-status", func(t *testing.T) {
+		res, err := api.GetItem(ctx, connect.NewRequest(&statusthingv1.GetItemRequest{ItemId: app.GetId()}))
+		require.NoError(t, err)
+		require.Equal(t, []string{database.GetId()}, res.Msg.GetItem().GetDependsOn())
+		require.Equal(t, statusthingv1.StatusKind_STATUS_KIND_UP, res.Msg.GetItem().GetStatus().GetKind())
+		require.Equal(t, statusthingv1.StatusKind_STATUS_KIND_WARNING, res.Msg.GetItem().GetEffectiveStatus().GetKind())
+	})
+	t.Run("impact", func(t *testing.T) {
+		res, err := api.GetItemImpact(ctx, connect.NewRequest(&statusthingv1.GetItemImpactRequest{ItemId: database.GetId()}))
+		require.NoError(t, err)
+		require.Equal(t, database.GetId(), res.Msg.GetItem().GetId())
+		require.Len(t, res.Msg.GetImpactedItems(), 1)
+		require.Equal(t, app.GetId(), res.Msg.GetImpactedItems()[0].GetId())
+	})
+	t.Run("remove", func(t *testing.T) {
+		_, err := api.RemoveItemDependency(ctx, connect.NewRequest(&statusthingv1.RemoveItemDependencyRequest{
+			ItemId:      app.GetId(),
+			DependsOnId: database.GetId(),
+		}))
+		require.NoError(t, err)
+		res, err := api.GetItemImpact(ctx, connect.NewRequest(&statusthingv1.GetItemImpactRequest{ItemId: database.GetId()}))
+		require.NoError(t, err)
+		require.Len(t, res.Msg.GetImpactedItems(), 0)
+	})
+}
+
+func TestBatchItems(t *testing.T) {
+	t.Parallel()
+	ctx := context.TODO()
+	api, _, httpSrv, err := apiTestSetup(t)
+	defer httpSrv.Close()
+	require.NoError(t, err)
+
+	sres, err := api.AddStatus(ctx, connect.NewRequest(&statusthingv1.AddStatusRequest{
+		Name: t.Name(),
+		Kind: statusthingv1.StatusKind_STATUS_KIND_DOWN,
+	}))
+	require.NoError(t, err)
+	status := sres.Msg.GetStatus()
+	ids := []string{}
+	for _, name := range []string{"first", "second"} {
+		res, err := api.AddItem(ctx, connect.NewRequest(&statusthingv1.AddItemRequest{Name: t.Name() + name}))
+		require.NoError(t, err)
+		ids = append(ids, res.Msg.GetItem().GetId())
+	}
+
+	t.Run("update-per-item-errors", func(t *testing.T) {
+		_, err := api.BatchUpdateItems(ctx, connect.NewRequest(&statusthingv1.BatchUpdateItemsRequest{
+			ItemIds:  append([]string{"missing"}, ids...),
+			StatusId: status.GetId(),
+		}))
+		require.Error(t, err)
+		var cerr *connect.Error
+		require.True(t, errors.As(err, &cerr))
+		require.Equal(t, connect.CodeInvalidArgument, cerr.Code())
+		require.Len(t, cerr.Details(), 1)
+		detail, derr := cerr.Details()[0].Value()
+		require.NoError(t, derr)
+		itemErr, ok := detail.(*statusthingv1.BatchItemError)
+		require.True(t, ok)
+		require.Equal(t, "missing", itemErr.GetItemId())
+	})
+	t.Run("update", func(t *testing.T) {
+		res, err := api.BatchUpdateItems(ctx, connect.NewRequest(&statusthingv1.BatchUpdateItemsRequest{
+			ItemIds:  ids,
+			StatusId: status.GetId(),
+			NoteText: "investigating",
+		}))
+		require.NoError(t, err)
+		require.Len(t, res.Msg.GetItems(), 2)
+		for _, item := range res.Msg.GetItems() {
+			require.Equal(t, status.GetId(), item.GetStatus().GetId())
+			require.Len(t, item.GetNotes(), 1)
+		}
+	})
+	t.Run("delete", func(t *testing.T) {
+		_, err := api.BatchDeleteItems(ctx, connect.NewRequest(&statusthingv1.BatchDeleteItemsRequest{ItemIds: ids}))
+		require.NoError(t, err)
+		res, err := api.ListItems(ctx, connect.NewRequest(&statusthingv1.ListItemsRequest{}))
+		require.NoError(t, err)
+		require.Len(t, res.Msg.GetItems(), 0)
+	})
+}
+
 func apiTestSetup(t *testing.T) (*APIHandler, *http.Client, *httptest.Server, error) {
 	// test setup
 	store, err := memdb.New()
@@ -880,11 +1692,15 @@ func apiTestSetup(t *testing.T) (*APIHandler, *http.Client, *httptest.Server, er
 	ispath, ishandler := v1connect.NewItemsServiceHandler(api)
 	spath, shandler := v1connect.NewStatusServiceHandler(api)
 	npath, nhandler := v1connect.NewNotesServiceHandler(api)
+	dpath, dhandler := v1connect.NewDataServiceHandler(api)
+	setpath, sethandler := v1connect.NewSettingsServiceHandler(api)
 
 	rtr := chi.NewRouter()
-	rtr.Handle(ispath, ishandler)
-	rtr.Handle(spath, shandler)
-	rtr.Handle(npath, nhandler)
+	rtr.Mount(ispath, ishandler)
+	rtr.Mount(spath, shandler)
+	rtr.Mount(npath, nhandler)
+	rtr.Mount(dpath, dhandler)
+	rtr.Mount(setpath, sethandler)
 
 	srv := httptest.NewServer(rtr)
 	client := srv.Client()
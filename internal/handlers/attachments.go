@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi"
+	"golang.org/x/exp/slog"
+
+	v1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
+	"github.com/lusis/statusthing/internal/serrors"
+	"github.com/lusis/statusthing/internal/services"
+)
+
+// serveAttachment writes the contents of the attachment named by the id url param
+// when publicOnly is true attachments of internal notes are not found
+// attachments never change so the sha256 of the contents is used as the etag
+func serveAttachment(sts *services.StatusThingService, publicOnly bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		att, err := sts.GetAttachment(ctx, chi.URLParam(r, "id"))
+		if err == nil && publicOnly {
+			var note *v1.Note
+			note, err = sts.GetNote(ctx, att.GetNoteId())
+			if err == nil && len(services.PublicNotes([]*v1.Note{note})) == 0 {
+				err = serrors.NewError("attachment", serrors.ErrNotFound)
+			}
+		}
+		if errors.Is(err, serrors.ErrNotFound) || errors.Is(err, serrors.ErrEmptyString) {
+			http.NotFound(w, r)
+			return
+		}
+		if err != nil {
+			slog.Error("unable to get attachment", "error", err)
+			http.Error(w, "internal error", http.StatusInternalServerError)
+			return
+		}
+		etag := `"` + att.GetSha256() + `"`
+		cacheControl := "private, max-age=86400"
+		if publicOnly {
+			cacheControl = "public, max-age=86400"
+		}
+		w.Header().Set("ETag", etag)
+		w.Header().Set("Cache-Control", cacheControl)
+		if r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		_, rc, err := sts.OpenAttachment(ctx, att.GetId())
+		if err != nil {
+			slog.Error("unable to open attachment", "error", err, "attachment_id", att.GetId())
+			http.Error(w, "internal error", http.StatusInternalServerError)
+			return
+		}
+		defer rc.Close()
+		disposition := "attachment"
+		if services.IsImage(att) {
+			disposition = "inline"
+		}
+		w.Header().Set("Content-Type", att.GetContentType())
+		w.Header().Set("Content-Length", strconv.FormatInt(att.GetSize(), 10))
+		w.Header().Set("Content-Disposition", mime.FormatMediaType(disposition, map[string]string{"filename": att.GetFilename()}))
+		// uploads are only as trustworthy as whoever uploaded them so the browser must not sniff or run them
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		w.Header().Set("Content-Security-Policy", "default-src 'none'; sandbox")
+		if _, err := io.Copy(w, rc); err != nil {
+			slog.Error("unable to write attachment", "error", err, "attachment_id", att.GetId())
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package handlers
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi"
+	"golang.org/x/exp/slog"
+
+	v1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
+	"github.com/lusis/statusthing/internal/serrors"
+	"github.com/lusis/statusthing/internal/services"
+)
+
+const (
+	// themePath is where the stylesheet built from the accent colors is served
+	themePath = "/branding/theme.css"
+)
+
+// brandingRoutes adds the logo, favicon and theme stylesheet to the provided router
+func brandingRoutes(mux chi.Router, sts *services.StatusThingService) {
+	mux.Get(themePath, serveTheme(sts))
+	mux.Get("/branding/{name}", serveBrandingImage(sts))
+}
+
+// siteSettings gets the settings, falling back to the defaults so a broken store doesn't break every page
+func siteSettings(ctx context.Context, sts *services.StatusThingService) *v1.Settings {
+	settings, err := sts.GetSettings(ctx)
+	if err != nil {
+		slog.Error("unable to get settings", "error", err)
+		return &v1.Settings{PageTitle: services.DefaultPageTitle, Timezone: services.DefaultTimezone}
+	}
+	return settings
+}
+
+// themeCSS returns the stylesheet that colors the site header with the accent colors
+// the colors are validated as hex colors when they are set so they are safe to write as-is
+func themeCSS(settings *v1.Settings) string {
+	b := &strings.Builder{}
+	if color := settings.GetAccentColor(); color != "" {
+		fmt.Fprintf(b, ".site-header, .site-header .navbar-menu {\n    background-color: %s;\n}\n", color)
+	}
+	if color := settings.GetAccentTextColor(); color != "" {
+		fmt.Fprintf(b, ".site-header, .site-header .navbar-item, .site-header .navbar-link, .site-header .title {\n    color: %s;\n}\n", color)
+	}
+	return b.String()
+}
+
+// themeURL returns the path of the theme stylesheet
+// the path changes along with the stylesheet so it can be cached
+func themeURL(settings *v1.Settings) string {
+	sum := sha256.Sum256([]byte(themeCSS(settings)))
+	return themePath + "?v=" + hex.EncodeToString(sum[:6])
+}
+
+// serveTheme writes the stylesheet built from the accent colors
+func serveTheme(sts *services.StatusThingService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		css := []byte(themeCSS(siteSettings(r.Context(), sts)))
+		sum := sha256.Sum256(css)
+		etag := `"` + hex.EncodeToString(sum[:]) + `"`
+		w.Header().Set("ETag", etag)
+		w.Header().Set("Cache-Control", "public, max-age=300")
+		if r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("Content-Type", "text/css; charset=utf-8")
+		w.Header().Set("Content-Length", strconv.Itoa(len(css)))
+		if _, err := w.Write(css); err != nil {
+			slog.Error("unable to write theme", "error", err)
+		}
+	}
+}
+
+// serveBrandingImage writes the logo or favicon named by the name url param
+func serveBrandingImage(sts *services.StatusThingService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		img, err := sts.GetSettingsImage(r.Context(), chi.URLParam(r, "name"))
+		if errors.Is(err, serrors.ErrNotFound) {
+			http.NotFound(w, r)
+			return
+		}
+		if err != nil {
+			slog.Error("unable to get settings image", "error", err)
+			http.Error(w, "internal error", http.StatusInternalServerError)
+			return
+		}
+		sum := sha256.Sum256(img.Data)
+		etag := `"` + hex.EncodeToString(sum[:]) + `"`
+		w.Header().Set("ETag", etag)
+		w.Header().Set("Cache-Control", "public, max-age=86400")
+		if r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("Content-Type", img.ContentType)
+		w.Header().Set("Content-Length", strconv.Itoa(len(img.Data)))
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		w.Header().Set("Content-Security-Policy", "default-src 'none'; sandbox")
+		if _, err := w.Write(img.Data); err != nil {
+			slog.Error("unable to write settings image", "error", err)
+		}
+	}
+}
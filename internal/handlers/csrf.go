@@ -0,0 +1,33 @@
+package handlers
+
+import (
+	"net/http"
+
+	"golang.org/x/exp/slog"
+
+	"github.com/lusis/statusthing/internal/session"
+)
+
+const (
+	// csrfHeader is the header htmx requests carry the csrf token in
+	// ours.js copies it from the csrf-token meta tag of the page
+	csrfHeader = "X-CSRF-Token"
+)
+
+// csrfProtect rejects requests that can change things unless they carry the csrf token of their session
+// it has to run after the session is loaded
+func csrfProtect(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace:
+			next.ServeHTTP(w, r)
+			return
+		}
+		if !session.ValidCSRFToken(r.Context(), r.Header.Get(csrfHeader)) {
+			slog.Warn("rejecting request without a valid csrf token", "method", r.Method, "path", r.URL.Path)
+			http.Error(w, "invalid csrf token", http.StatusForbidden)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
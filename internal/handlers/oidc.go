@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	"crypto/subtle"
+	"errors"
+	"net/http"
+
+	"golang.org/x/exp/slog"
+
+	v1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
+	"github.com/lusis/statusthing/internal/filters"
+	"github.com/lusis/statusthing/internal/oidc"
+	"github.com/lusis/statusthing/internal/serrors"
+	"github.com/lusis/statusthing/internal/session"
+)
+
+const (
+	// oidcLoginPath sends the user to the OpenID Connect provider to log in
+	oidcLoginPath = "/oidc/login"
+	// oidcCallbackPath is where the provider sends the user back to
+	oidcCallbackPath = "/oidc/callback"
+)
+
+// oidcLogin remembers a new login in the session and sends the user to the provider
+func (ah *AdminHandler) oidcLogin(w http.ResponseWriter, r *http.Request) {
+	req, err := oidc.NewAuthRequest()
+	if err != nil {
+		slog.Error("unable to start oidc login", "error", err)
+		http.Error(w, "unable to start login", http.StatusInternalServerError)
+		return
+	}
+	authURL, err := ah.oidc.AuthCodeURL(r.Context(), req)
+	if err != nil {
+		slog.Error("unable to start oidc login", "error", err)
+		http.Error(w, "the single sign-on provider is unavailable", http.StatusBadGateway)
+		return
+	}
+	session.Sessions.Put(r.Context(), session.OIDCStateKey, req.State)
+	session.Sessions.Put(r.Context(), session.OIDCNonceKey, req.Nonce)
+	session.Sessions.Put(r.Context(), session.OIDCVerifierKey, req.Verifier)
+	http.Redirect(w, r, authURL, http.StatusFound)
+}
+
+// oidcCallback finishes the login the provider sent the user back from
+// the user is provisioned with the role their groups map to and logged in
+func (ah *AdminHandler) oidcCallback(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	req := &oidc.AuthRequest{
+		State:    session.Sessions.PopString(ctx, session.OIDCStateKey),
+		Nonce:    session.Sessions.PopString(ctx, session.OIDCNonceKey),
+		Verifier: session.Sessions.PopString(ctx, session.OIDCVerifierKey),
+	}
+	q := r.URL.Query()
+	if e := q.Get("error"); e != "" {
+		slog.Info("oidc login failed", "error", e, "description", q.Get("error_description"))
+		http.Error(w, "login failed: "+e, http.StatusForbidden)
+		return
+	}
+	if req.State == "" || subtle.ConstantTimeCompare([]byte(req.State), []byte(q.Get("state"))) != 1 {
+		slog.Warn("oidc callback with an unknown state")
+		http.Error(w, "this login expired or was started somewhere else. please try again", http.StatusBadRequest)
+		return
+	}
+	claims, err := ah.oidc.Exchange(ctx, q.Get("code"), req)
+	if err != nil {
+		slog.Error("unable to finish oidc login", "error", err)
+		http.Error(w, "unable to verify the login", http.StatusForbidden)
+		return
+	}
+	cfg := ah.oidc.Config()
+	username := claims.String(cfg.UsernameClaim)
+	role := cfg.Role(claims.Strings(cfg.GroupsClaim))
+	if role == v1.Role_ROLE_UNKNOWN {
+		slog.Info("oidc login denied", "username", username, "reason", "no role")
+		http.Error(w, "you aren't in a group that can use this site", http.StatusForbidden)
+		return
+	}
+	opts := []filters.FilterOption{}
+	if name := claims.String("given_name"); name != "" {
+		opts = append(opts, filters.WithFirstName(name))
+	}
+	if name := claims.String("family_name"); name != "" {
+		opts = append(opts, filters.WithLastName(name))
+	}
+	user, err := ah.sts.ProvisionUser(ctx, username, claims.String("email"), role, opts...)
+	if err != nil {
+		slog.Error("unable to provision oidc user", "error", err, "username", username)
+		if errors.Is(err, serrors.ErrConflict) {
+			http.Error(w, "a local user already has this username", http.StatusForbidden)
+			return
+		}
+		http.Error(w, "unable to log in: the provider must send a "+cfg.UsernameClaim+" and email claim", http.StatusForbidden)
+		return
+	}
+	if err := session.Sessions.RenewToken(ctx); err != nil {
+		slog.Error("unable to renew token", "error", err)
+		http.Error(w, "unable to log in", http.StatusInternalServerError)
+		return
+	}
+	if _, err := session.RenewCSRFToken(ctx); err != nil {
+		slog.Error("unable to renew csrf token", "error", err)
+		http.Error(w, "unable to log in", http.StatusInternalServerError)
+		return
+	}
+	session.Sessions.Put(ctx, session.LoggedInKey, true)
+	session.Sessions.Put(ctx, session.UsernameKey, user.GetUsername())
+	slog.Info("oidc login", "username", user.GetUsername(), "role", user.GetRole().String())
+	http.Redirect(w, r, "/", http.StatusFound)
+}
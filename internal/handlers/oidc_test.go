@@ -0,0 +1,154 @@
+package handlers
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
+	"github.com/lusis/statusthing/internal/filters"
+	"github.com/lusis/statusthing/internal/oidc"
+	"github.com/lusis/statusthing/internal/oidc/oidctest"
+	"github.com/lusis/statusthing/internal/services"
+	"github.com/lusis/statusthing/internal/session"
+	"github.com/lusis/statusthing/internal/storers/memdb"
+)
+
+func TestAdminHandlerOIDC(t *testing.T) {
+	ctx := context.TODO()
+	store, err := memdb.New()
+	require.NoError(t, err)
+	sts, err := services.NewStatusThingService(store)
+	require.NoError(t, err)
+	_, err = sts.AddUser(ctx, "local", "password1", "local@example.com")
+	require.NoError(t, err)
+
+	fake := oidctest.NewProvider(t)
+	mux := chi.NewRouter()
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+	cfg := oidc.DefaultConfig()
+	cfg.DiscoveryURL = fake.DiscoveryURL()
+	cfg.ClientID = oidctest.ClientID
+	cfg.ClientSecret = oidctest.ClientSecret
+	cfg.RedirectURL = srv.URL + oidcCallbackPath
+	cfg.AdminGroups = []string{"admins"}
+	cfg.EditorGroups = []string{"ops"}
+	cfg.ViewerGroups = []string{"everyone"}
+	provider, err := oidc.NewProvider(cfg)
+	require.NoError(t, err)
+	_, err = NewAdminHandler(sts, mux, false, session.DefaultConfig(), nil, provider)
+	require.NoError(t, err)
+
+	csrfMeta := regexp.MustCompile(`<meta name="csrf-token" content="([^"]+)">`)
+	testCases := map[string]struct {
+		claims       map[string]any
+		wantStatus   int
+		wantRole     v1.Role
+		canAddStatus bool
+	}{
+		"editor": {
+			claims:       map[string]any{"sub": "1", "preferred_username": "sam", "email": "sam@example.com", "given_name": "Sam", "groups": []string{"everyone", "ops"}},
+			wantStatus:   http.StatusOK,
+			wantRole:     v1.Role_ROLE_EDITOR,
+			canAddStatus: true,
+		},
+		"viewer": {
+			claims:     map[string]any{"sub": "2", "preferred_username": "vic", "email": "vic@example.com", "groups": "everyone"},
+			wantStatus: http.StatusOK,
+			wantRole:   v1.Role_ROLE_VIEWER,
+		},
+		"no-role": {
+			claims:     map[string]any{"sub": "3", "preferred_username": "nobody", "email": "nobody@example.com", "groups": []string{"other"}},
+			wantStatus: http.StatusForbidden,
+		},
+		"local-user": {
+			claims:     map[string]any{"sub": "4", "preferred_username": "local", "email": "local@example.com", "groups": []string{"admins"}},
+			wantStatus: http.StatusForbidden,
+		},
+		"no-email": {
+			claims:     map[string]any{"sub": "5", "preferred_username": "noemail", "groups": []string{"admins"}},
+			wantStatus: http.StatusForbidden,
+		},
+	}
+	for n, tc := range testCases {
+		tc := tc
+		// sessions are global so logins can't run in parallel
+		t.Run(n, func(t *testing.T) {
+			jar, err := cookiejar.New(nil)
+			require.NoError(t, err)
+			client := srv.Client()
+			client.Jar = jar
+			fake.SetClaims(tc.claims)
+
+			res, err := client.Get(srv.URL + oidcLoginPath)
+			require.NoError(t, err)
+			body, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+			require.NoError(t, res.Body.Close())
+			require.Equal(t, tc.wantStatus, res.StatusCode, string(body))
+			if tc.wantStatus != http.StatusOK {
+				return
+			}
+			username := tc.claims["preferred_username"].(string)
+			require.Contains(t, string(body), "avatars/"+username, "the user should be logged in")
+			user, err := sts.GetUser(ctx, username)
+			require.NoError(t, err)
+			require.True(t, services.IsExternalUser(user))
+			require.Equal(t, tc.wantRole, user.GetRole())
+			require.Equal(t, tc.claims["email"], user.GetEmailAddress())
+
+			m := csrfMeta.FindStringSubmatch(string(body))
+			require.Len(t, m, 2)
+			post := func(path string, form url.Values) int {
+				req, err := http.NewRequest(http.MethodPost, srv.URL+path, strings.NewReader(form.Encode()))
+				require.NoError(t, err)
+				req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+				req.Header.Set("HX-Request", "true")
+				req.Header.Set(csrfHeader, m[1])
+				res, err := client.Do(req)
+				require.NoError(t, err)
+				require.NoError(t, res.Body.Close())
+				return res.StatusCode
+			}
+			status := post("/add-status", url.Values{"name": {n}, "kind": {v1.StatusKind_STATUS_KIND_UP.String()}})
+			if tc.canAddStatus {
+				require.NotEqual(t, http.StatusForbidden, status)
+				statuses, err := sts.FindStatus(ctx)
+				require.NoError(t, err)
+				names := []string{}
+				for _, s := range statuses {
+					names = append(names, s.GetName())
+				}
+				require.Contains(t, names, n)
+			} else {
+				require.Equal(t, http.StatusForbidden, status, "viewers should not be able to make changes")
+			}
+			require.Equal(t, http.StatusForbidden, post("/edit-settings", url.Values{}), "only admins should be able to change settings")
+
+			if tc.canAddStatus {
+				require.NoError(t, sts.EditUser(ctx, username, filters.WithRole(v1.Role_ROLE_VIEWER)))
+				status = post("/add-status", url.Values{"name": {n + "-demoted"}, "kind": {v1.StatusKind_STATUS_KIND_UP.String()}})
+				require.Equal(t, http.StatusForbidden, status, "a demoted user should lose their role without logging in again")
+			}
+			require.NoError(t, sts.RemoveUser(ctx, username))
+			require.Equal(t, http.StatusForbidden, post("/add-note", url.Values{}), "a removed user should lose their role without logging in again")
+		})
+	}
+
+	t.Run("unknown-state", func(t *testing.T) {
+		res, err := srv.Client().Get(srv.URL + oidcCallbackPath + "?state=forged&code=stolen")
+		require.NoError(t, err)
+		require.NoError(t, res.Body.Close())
+		require.Equal(t, http.StatusBadRequest, res.StatusCode)
+	})
+}
@@ -0,0 +1,210 @@
+package handlers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"image"
+	"io/fs"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi"
+	"github.com/lusis/htmxtools"
+	"golang.org/x/exp/slog"
+
+	"github.com/lusis/statusthing/internal/filters"
+	"github.com/lusis/statusthing/internal/serrors"
+	"github.com/lusis/statusthing/internal/services"
+	"github.com/lusis/statusthing/internal/session"
+	"github.com/lusis/statusthing/internal/validation"
+)
+
+const (
+	profilePage = "profile.html"
+	// defaultAvatar is served from the ui fs for users without an avatar
+	defaultAvatar = "avatar.jpg"
+)
+
+// passwordChangePaths are what users who have to change their password can still use
+var passwordChangePaths = map[string]bool{
+	"/" + profilePage:      true,
+	"/change-password":     true,
+	"/sign-out":            true,
+	"/sign-out-everywhere": true,
+}
+
+// requirePasswordChange sends users who have to change their password to their profile until they have
+// other pages and every change are refused. static files are still served so the profile page works
+func (ah *AdminHandler) requirePasswordChange(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if passwordChangePaths[r.URL.Path] {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if user, ok := ah.sessionUser(r); !ok || !user.GetPasswordChangeRequired() {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			http.Error(w, "change your password first", http.StatusForbidden)
+			return
+		}
+		if path := strings.TrimLeft(r.URL.Path, "/"); path != "" && ah.templateLoader.Lookup(path) == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if htmxtools.RequestFromContext(r.Context()) != nil {
+			w.Header().Add(htmxtools.RedirectResponse.String(), "/"+profilePage)
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		http.Redirect(w, r, "/"+profilePage, http.StatusSeeOther)
+	})
+}
+
+// sessionUsername returns the name of the logged in user or writes a forbidden response
+func sessionUsername(w http.ResponseWriter, r *http.Request) (string, bool) {
+	username := session.Sessions.GetString(r.Context(), session.UsernameKey)
+	if !session.Sessions.GetBool(r.Context(), session.LoggedInKey) || !validation.ValidString(username) {
+		w.WriteHeader(http.StatusForbidden)
+		return "", false
+	}
+	return username, true
+}
+
+func (ah *AdminHandler) editProfile(w http.ResponseWriter, r *http.Request) {
+	username, ok := sessionUsername(w, r)
+	if !ok {
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		slog.Error("unable to parse form", "error", err)
+		return
+	}
+	opts := []filters.FilterOption{}
+	if fname := r.Form.Get("first_name"); validation.ValidString(fname) {
+		opts = append(opts, filters.WithFirstName(fname))
+	}
+	if lname := r.Form.Get("last_name"); validation.ValidString(lname) {
+		opts = append(opts, filters.WithLastName(lname))
+	}
+	if email := r.Form.Get("email_address"); validation.ValidString(email) {
+		opts = append(opts, filters.WithEmailAddress(email))
+	}
+	if len(opts) != 0 {
+		if err := ah.sts.EditUser(r.Context(), username, opts...); err != nil {
+			slog.Error("unable to edit profile", "error", err)
+			http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+			return
+		}
+	}
+	slog.Info("edited profile", "username", username)
+	w.Header().Add(htmxtools.RedirectResponse.String(), profilePage)
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (ah *AdminHandler) changePassword(w http.ResponseWriter, r *http.Request) {
+	username, ok := sessionUsername(w, r)
+	if !ok {
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		slog.Error("unable to parse form", "error", err)
+		return
+	}
+	current, newPass := r.Form.Get("current_password"), r.Form.Get("new_password")
+	if newPass != r.Form.Get("confirm_password") {
+		http.Error(w, "the new passwords don't match", http.StatusUnprocessableEntity)
+		return
+	}
+	if err := ah.sts.ChangePassword(r.Context(), username, current, newPass); err != nil {
+		slog.Error("unable to change password", "error", err, "username", username)
+		if errors.Is(err, serrors.ErrInvalidPassword) {
+			http.Error(w, "the current password is wrong", http.StatusForbidden)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+	slog.Info("changed password", "username", username)
+	w.Header().Add(htmxtools.RedirectResponse.String(), profilePage)
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// uploadAvatar stores the avatar file from the form
+// crop_x, crop_y and crop_size pick the square of the image to keep, in the image's pixels. without them the middle is kept
+func (ah *AdminHandler) uploadAvatar(w http.ResponseWriter, r *http.Request) {
+	username, ok := sessionUsername(w, r)
+	if !ok {
+		return
+	}
+	r.Body = http.MaxBytesReader(w, r.Body, services.AvatarMaxSize+(1<<10))
+	file, _, err := r.FormFile("avatar")
+	if err != nil {
+		slog.Error("unable to read form", "error", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	defer file.Close()
+	crop, err := formCrop(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+	if _, err := ah.sts.SetAvatar(r.Context(), username, file, crop); err != nil {
+		slog.Error("unable to set avatar", "error", err, "username", username)
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+	slog.Info("changed avatar", "username", username)
+	w.Header().Add(htmxtools.RedirectResponse.String(), profilePage)
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// formCrop returns the crop square from the form or an empty rectangle when there isn't one
+func formCrop(r *http.Request) (image.Rectangle, error) {
+	if !validation.ValidString(r.FormValue("crop_size")) {
+		return image.Rectangle{}, nil
+	}
+	vals := [3]int{}
+	for i, name := range []string{"crop_x", "crop_y", "crop_size"} {
+		v, err := strconv.Atoi(r.FormValue(name))
+		if err != nil || v < 0 {
+			return image.Rectangle{}, serrors.NewError(name, serrors.ErrInvalidData)
+		}
+		vals[i] = v
+	}
+	return image.Rect(vals[0], vals[1], vals[0]+vals[2], vals[1]+vals[2]), nil
+}
+
+// serveAvatar writes the avatar of the user named by the username url param
+// users without an avatar get the default one
+func (ah *AdminHandler) serveAvatar(w http.ResponseWriter, r *http.Request) {
+	contentType := "image/png"
+	b, err := ah.sts.GetAvatar(r.Context(), chi.URLParam(r, "username"))
+	if errors.Is(err, serrors.ErrNotFound) || errors.Is(err, serrors.ErrEmptyString) {
+		contentType = "image/jpeg"
+		b, err = fs.ReadFile(ah.uiFS, defaultAvatar)
+	}
+	if err != nil {
+		slog.Error("unable to get avatar", "error", err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+	sum := sha256.Sum256(b)
+	etag := `"` + hex.EncodeToString(sum[:]) + `"`
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", "private, max-age=86400")
+	if r.Header.Get("If-None-Match") == etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Length", strconv.Itoa(len(b)))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	if _, err := w.Write(b); err != nil {
+		slog.Error("unable to write avatar", "error", err)
+	}
+}
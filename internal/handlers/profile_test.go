@@ -0,0 +1,39 @@
+package handlers
+
+import (
+	"image"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/lusis/statusthing/internal/serrors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormCrop(t *testing.T) {
+	testCases := map[string]struct {
+		form url.Values
+		crop image.Rectangle
+		err  error
+	}{
+		"no-crop":  {form: url.Values{}},
+		"crop":     {form: url.Values{"crop_x": {"10"}, "crop_y": {"20"}, "crop_size": {"30"}}, crop: image.Rect(10, 20, 40, 50)},
+		"negative": {form: url.Values{"crop_x": {"-1"}, "crop_y": {"0"}, "crop_size": {"30"}}, err: serrors.ErrInvalidData},
+		"missing":  {form: url.Values{"crop_size": {"30"}}, err: serrors.ErrInvalidData},
+		"not-int":  {form: url.Values{"crop_x": {"1.5"}, "crop_y": {"0"}, "crop_size": {"30"}}, err: serrors.ErrInvalidData},
+	}
+	for n, tc := range testCases {
+		t.Run(n, func(t *testing.T) {
+			r := httptest.NewRequest("POST", "/upload-avatar", strings.NewReader(tc.form.Encode()))
+			r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			crop, err := formCrop(r)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.crop, crop)
+		})
+	}
+}
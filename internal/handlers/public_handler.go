@@ -0,0 +1,197 @@
+package handlers
+
+import (
+	"context"
+	"html/template"
+	"io/fs"
+	"net/http"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/go-chi/chi"
+
+	"github.com/lusis/statusthing/assets"
+	v1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
+	"github.com/lusis/statusthing/internal/markdown"
+	"github.com/lusis/statusthing/internal/serrors"
+	"github.com/lusis/statusthing/internal/services"
+	"github.com/lusis/statusthing/internal/templating"
+
+	"golang.org/x/exp/slog"
+)
+
+const (
+	publicTemplate = "index.html"
+	// publicNoteCount is how many of the most recent notes are shown for each item
+	publicNoteCount = 3
+)
+
+var publicFuncs = template.FuncMap{
+	"markdown":    renderMarkdown,
+	"recentNotes": recentNotes,
+	"isImage":     services.IsImage,
+}
+
+// renderMarkdown renders note text to html that is safe to include as-is
+func renderMarkdown(src string) template.HTML {
+	return template.HTML(markdown.Render(src))
+}
+
+// recentNotes returns the public notes with pinned notes first and then the newest, at most publicNoteCount of them
+func recentNotes(notes []*v1.Note) []*v1.Note {
+	res := services.PublicNotes(notes)
+	sort.SliceStable(res, func(i, j int) bool {
+		if res[i].GetPinned() != res[j].GetPinned() {
+			return res[i].GetPinned()
+		}
+		return res[i].GetTimestamps().GetCreated().AsTime().After(res[j].GetTimestamps().GetCreated().AsTime())
+	})
+	if len(res) > publicNoteCount {
+		res = res[:publicNoteCount]
+	}
+	return res
+}
+
+// PublicHandler is the http handler for the read-only public status page
+type PublicHandler struct {
+	sts        *services.StatusThingService
+	uiFS       fs.FS
+	templateFS fs.FS
+	templates  *template.Template
+	reloadable bool
+	mux        chi.Router
+}
+
+// publicPage is what the public template is executed with
+// themes can rely on these fields along with publicFuncs and localTime
+type publicPage struct {
+	// Title is the page title from the settings
+	Title string
+	// Settings are the site settings
+	Settings *v1.Settings
+	// ThemeURL is the path of the stylesheet with the accent colors
+	ThemeURL string
+	// Overall is the worst status of the items or nil when no item has one
+	Overall *v1.Status
+	// Items are the top level items with their children, effective statuses and notes
+	Items []*v1.Item
+	// Updated is when the page was rendered, in the time zone from the settings
+	Updated string
+}
+
+// funcs returns the publicFuncs along with the ones that need the service
+func (ph *PublicHandler) funcs() template.FuncMap {
+	funcs := template.FuncMap{
+		// localTime converts t to the time zone from the settings
+		"localTime": func(t time.Time) time.Time {
+			return t.In(ph.sts.Location(context.TODO()))
+		},
+	}
+	for name, fn := range publicFuncs {
+		funcs[name] = fn
+	}
+	return funcs
+}
+
+// NewPublicHandler returns a new public handler
+// when reloadable is true templates and assets are read from disk on each request
+// the optional theme overrides the built-in files with the same names: templates/*.html for the admin site, templates/public/*.html for the public page
+// and ui/* for static files. a theme template that doesn't parse is logged and the built-in one is used instead
+func NewPublicHandler(sts *services.StatusThingService, reloadable bool, theme fs.FS) (*PublicHandler, error) {
+	if sts == nil {
+		return nil, serrors.NewError("sts", serrors.ErrNilVal)
+	}
+	ph := &PublicHandler{sts: sts, reloadable: reloadable}
+	baseUI, baseTemplates, err := assetFS(reloadable, "templates/public")
+	if err != nil {
+		return nil, err
+	}
+	ph.uiFS = templating.NewLayeredFS(themeSub(theme, "ui"), baseUI)
+	ph.templateFS = templating.NewOverrideFS(baseTemplates, themeSub(theme, "templates/public"), "*.html", templating.HTMLCheck(ph.funcs()))
+	if !reloadable {
+		templates, err := template.New("").Funcs(ph.funcs()).ParseFS(ph.templateFS, "*.html")
+		if err != nil {
+			return nil, err
+		}
+		ph.templates = templates
+	}
+	mux := chi.NewRouter()
+	mux.Use(securityHeaders)
+	mux.Get("/", ph.page)
+	mux.Get("/attachments/{id}", serveAttachment(sts, true))
+	brandingRoutes(mux, sts)
+	files := http.FileServer(http.FS(ph.uiFS))
+	mux.Get("/css/*", files.ServeHTTP)
+	mux.Get("/theme/*", files.ServeHTTP)
+	mux.Get("/favicon.ico", files.ServeHTTP)
+	ph.mux = mux
+	return ph, nil
+}
+
+// assetFS returns the static files and the templates in templateDir, from disk when reloadable is true and embedded otherwise
+func assetFS(reloadable bool, templateDir string) (fs.FS, fs.FS, error) {
+	var uiRoot, templateRoot fs.FS = assets.UIFs, assets.TemplateFS
+	if reloadable {
+		uiRoot = os.DirFS(defaultAssetDir)
+		templateRoot = uiRoot
+	}
+	ui, err := fs.Sub(uiRoot, "ui")
+	if err != nil {
+		return nil, nil, err
+	}
+	templates, err := fs.Sub(templateRoot, templateDir)
+	if err != nil {
+		return nil, nil, err
+	}
+	return ui, templates, nil
+}
+
+// themeSub returns the dir directory of the theme or nil when there is no theme
+func themeSub(theme fs.FS, dir string) fs.FS {
+	if theme == nil {
+		return nil
+	}
+	sub, err := fs.Sub(theme, dir)
+	if err != nil {
+		return nil
+	}
+	return sub
+}
+
+// ServeHTTP implements [http.Handler]
+func (ph *PublicHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ph.mux.ServeHTTP(w, r)
+}
+
+func (ph *PublicHandler) page(w http.ResponseWriter, r *http.Request) {
+	templates := ph.templates
+	if ph.reloadable {
+		t, err := template.New("").Funcs(ph.funcs()).ParseFS(ph.templateFS, "*.html")
+		if err != nil {
+			slog.Error("unable to load templates", "error", err)
+			http.Error(w, "internal error", http.StatusInternalServerError)
+			return
+		}
+		templates = t
+	}
+	items, err := ph.sts.ItemTree(r.Context())
+	if err != nil {
+		slog.Error("unable to get items", "error", err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+	settings := siteSettings(r.Context(), ph.sts)
+	page := publicPage{
+		Title:    settings.GetPageTitle(),
+		Settings: settings,
+		ThemeURL: themeURL(settings),
+		Overall:  services.OverallStatus(items),
+		Items:    items,
+		Updated:  time.Now().In(ph.sts.Location(r.Context())).Format(time.RFC1123),
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := templates.ExecuteTemplate(w, publicTemplate, page); err != nil {
+		slog.Error("unable to execute template", "error", err)
+	}
+}
@@ -0,0 +1,203 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	statusthingv1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
+	"github.com/lusis/statusthing/internal/filters"
+	"github.com/lusis/statusthing/internal/serrors"
+	"github.com/lusis/statusthing/internal/services"
+	"github.com/lusis/statusthing/internal/storers/memdb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublicHandler(t *testing.T) {
+	t.Parallel()
+	_, err := NewPublicHandler(nil, false, nil)
+	require.ErrorIs(t, err, serrors.ErrNilVal)
+
+	ctx := context.TODO()
+	store, err := memdb.New()
+	require.NoError(t, err)
+	sts, err := services.NewStatusThingService(store)
+	require.NoError(t, err)
+	ph, err := NewPublicHandler(sts, false, nil)
+	require.NoError(t, err)
+	srv := httptest.NewServer(ph)
+	defer srv.Close()
+
+	get := func(t *testing.T, path string) (int, string) {
+		res, err := srv.Client().Get(srv.URL + path)
+		require.NoError(t, err)
+		defer res.Body.Close()
+		body, err := io.ReadAll(res.Body)
+		require.NoError(t, err)
+		return res.StatusCode, string(body)
+	}
+
+	code, body := get(t, "/")
+	require.Equal(t, http.StatusOK, code)
+	require.Contains(t, body, "No status reported")
+
+	up, err := sts.AddStatus(ctx, "Operational", statusthingv1.StatusKind_STATUS_KIND_UP)
+	require.NoError(t, err)
+	down, err := sts.AddStatus(ctx, "Outage", statusthingv1.StatusKind_STATUS_KIND_DOWN)
+	require.NoError(t, err)
+	_, err = sts.AddItem(ctx, "website", filters.WithStatusID(up.GetId()))
+	require.NoError(t, err)
+	broken, err := sts.AddItem(ctx, "<script>alert(1)</script>", filters.WithStatusID(down.GetId()))
+	require.NoError(t, err)
+	public, err := sts.AddNote(ctx, broken.GetId(), "**Investigating** <img src=x onerror=alert(1)>")
+	require.NoError(t, err)
+	internal, err := sts.AddNote(ctx, broken.GetId(), "paged the database team", filters.WithNoteVisibility(statusthingv1.NoteVisibility_NOTE_VISIBILITY_INTERNAL))
+	require.NoError(t, err)
+	png := append([]byte("\x89PNG\r\n\x1a\n"), make([]byte, 16)...)
+	graph, err := sts.AddAttachment(ctx, public.GetId(), "graph.png", bytes.NewReader(png))
+	require.NoError(t, err)
+	logs, err := sts.AddAttachment(ctx, public.GetId(), "app.log", strings.NewReader("connection refused"))
+	require.NoError(t, err)
+	hidden, err := sts.AddAttachment(ctx, internal.GetId(), "secret.log", strings.NewReader("hunter2"))
+	require.NoError(t, err)
+
+	code, body = get(t, "/")
+	require.Equal(t, http.StatusOK, code)
+	require.Contains(t, body, "website")
+	require.Contains(t, body, "<strong>Outage</strong>", "the worst status should be the overall status")
+	require.NotContains(t, body, "<script>alert(1)</script>")
+	require.Contains(t, body, "&lt;script&gt;alert(1)&lt;/script&gt;")
+	require.Contains(t, body, "<strong>Investigating</strong>", "notes should be rendered as markdown")
+	require.NotContains(t, body, "<img src=x")
+	require.NotContains(t, body, "paged the database team", "internal notes should not be shown")
+	require.Contains(t, body, `<img src="/attachments/`+graph.GetId()+`"`, "images should be shown inline")
+	require.Contains(t, body, `<a href="/attachments/`+logs.GetId()+`"`)
+	require.NotContains(t, body, hidden.GetId())
+
+	res, err := srv.Client().Get(srv.URL + "/attachments/" + graph.GetId())
+	require.NoError(t, err)
+	require.NoError(t, res.Body.Close())
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	require.Equal(t, "image/png", res.Header.Get("Content-Type"))
+	require.Contains(t, res.Header.Get("Content-Disposition"), "inline")
+	req, err := http.NewRequest(http.MethodGet, srv.URL+"/attachments/"+graph.GetId(), nil)
+	require.NoError(t, err)
+	req.Header.Set("If-None-Match", res.Header.Get("ETag"))
+	res, err = srv.Client().Do(req)
+	require.NoError(t, err)
+	require.NoError(t, res.Body.Close())
+	require.Equal(t, http.StatusNotModified, res.StatusCode)
+
+	code, body = get(t, "/attachments/"+logs.GetId())
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, "connection refused", body)
+	code, _ = get(t, "/attachments/"+hidden.GetId())
+	require.Equal(t, http.StatusNotFound, code, "attachments of internal notes should not be served")
+	code, _ = get(t, "/attachments/missing")
+	require.Equal(t, http.StatusNotFound, code)
+
+	_, body = get(t, "/")
+	require.Contains(t, body, "<title>"+services.DefaultPageTitle+"</title>")
+	code, body = get(t, "/branding/theme.css")
+	require.Equal(t, http.StatusOK, code)
+	require.Empty(t, body, "there should be no theme without accent colors")
+	_, err = sts.UpdateSettings(ctx, services.SettingsUpdate{
+		Settings: &statusthingv1.Settings{
+			PageTitle:   "Acme <Status>",
+			AccentColor: "#123456",
+			FooterText:  "<b>hosted</b> by acme",
+			SupportUrl:  "https://acme.example/support",
+			Timezone:    "Asia/Tokyo",
+		},
+		Fields: []string{"page_title", "accent_color", "footer_text", "support_url", "timezone", "logo"},
+		Logo:   png,
+	})
+	require.NoError(t, err)
+	code, body = get(t, "/")
+	require.Equal(t, http.StatusOK, code)
+	require.Contains(t, body, "<title>Acme &lt;Status&gt;</title>")
+	require.Contains(t, body, "&lt;b&gt;hosted&lt;/b&gt; by acme")
+	require.Contains(t, body, `<a href="https://acme.example/support">`)
+	require.Contains(t, body, `<img class="site-logo" src="/branding/logo?v=`)
+	require.Contains(t, body, "JST", "times should be in the configured time zone")
+	code, body = get(t, "/branding/theme.css")
+	require.Equal(t, http.StatusOK, code)
+	require.Contains(t, body, "background-color: #123456;")
+	res, err = srv.Client().Get(srv.URL + "/branding/logo")
+	require.NoError(t, err)
+	require.NoError(t, res.Body.Close())
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	require.Equal(t, "image/png", res.Header.Get("Content-Type"))
+	require.Equal(t, "nosniff", res.Header.Get("X-Content-Type-Options"))
+	code, _ = get(t, "/branding/favicon")
+	require.Equal(t, http.StatusNotFound, code)
+	code, _ = get(t, "/branding/other")
+	require.Equal(t, http.StatusNotFound, code)
+
+	code, _ = get(t, "/css/ours.css")
+	require.Equal(t, http.StatusOK, code)
+	code, _ = get(t, "/items.html")
+	require.Equal(t, http.StatusNotFound, code, "admin pages should not be served")
+}
+
+func TestPublicHandlerTheme(t *testing.T) {
+	t.Parallel()
+	store, err := memdb.New()
+	require.NoError(t, err)
+	sts, err := services.NewStatusThingService(store)
+	require.NoError(t, err)
+
+	testCases := map[string]struct {
+		theme fstest.MapFS
+		want  string
+	}{
+		"override": {
+			theme: fstest.MapFS{"templates/public/index.html": {Data: []byte(`<h1 class="themed">{{ .Title }}</h1>`)}},
+			want:  `<h1 class="themed">` + services.DefaultPageTitle + `</h1>`,
+		},
+		"broken-falls-back": {
+			theme: fstest.MapFS{"templates/public/index.html": {Data: []byte(`{{ if .Title }}`)}},
+			want:  "No status reported",
+		},
+		"unknown-func-falls-back": {
+			theme: fstest.MapFS{"templates/public/index.html": {Data: []byte(`{{ nosuchfunc }}`)}},
+			want:  "No status reported",
+		},
+		"empty": {
+			theme: fstest.MapFS{},
+			want:  "No status reported",
+		},
+	}
+	for n, tc := range testCases {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+			ph, err := NewPublicHandler(sts, false, tc.theme)
+			require.NoError(t, err)
+			rec := httptest.NewRecorder()
+			ph.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+			require.Equal(t, http.StatusOK, rec.Code)
+			require.Contains(t, rec.Body.String(), tc.want)
+		})
+	}
+
+	ph, err := NewPublicHandler(sts, false, fstest.MapFS{
+		"ui/css/ours.css":   {Data: []byte("body { color: red; }")},
+		"ui/theme/logo.svg": {Data: []byte("<svg></svg>")},
+	})
+	require.NoError(t, err)
+	rec := httptest.NewRecorder()
+	ph.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/css/ours.css", nil))
+	require.Equal(t, "body { color: red; }", rec.Body.String(), "theme static files should replace the built-in ones")
+	rec = httptest.NewRecorder()
+	ph.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/css/bulma.min.css", nil))
+	require.Equal(t, http.StatusOK, rec.Code, "built-in static files should still be served")
+	rec = httptest.NewRecorder()
+	ph.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/theme/logo.svg", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+}
@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"net/http"
+
+	"golang.org/x/exp/slog"
+
+	v1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
+	"github.com/lusis/statusthing/internal/session"
+)
+
+// sessionUser returns the logged in user
+// the user is read on every request so changing a user's role or removing them takes effect right away
+func (ah *AdminHandler) sessionUser(r *http.Request) (*v1.User, bool) {
+	ctx := r.Context()
+	if !session.Sessions.GetBool(ctx, session.LoggedInKey) {
+		return nil, false
+	}
+	username := session.Sessions.GetString(ctx, session.UsernameKey)
+	user, err := ah.sts.GetUser(ctx, username)
+	if err != nil {
+		slog.Error("unable to get session user", "error", err, "username", username)
+		return nil, false
+	}
+	return user, true
+}
+
+// sessionRole returns the role of the logged in user or [v1.Role_ROLE_UNKNOWN] when nobody is logged in
+func (ah *AdminHandler) sessionRole(r *http.Request) v1.Role {
+	user, ok := ah.sessionUser(r)
+	if !ok {
+		return v1.Role_ROLE_UNKNOWN
+	}
+	return userRole(user)
+}
+
+// userRole returns the role of the user. users without one are viewers
+func userRole(user *v1.User) v1.Role {
+	if user.GetRole() == v1.Role_ROLE_UNKNOWN {
+		return v1.Role_ROLE_VIEWER
+	}
+	return user.GetRole()
+}
+
+// requireRole only calls the next handler for logged in users with at least the provided role
+func (ah *AdminHandler) requireRole(role v1.Role, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if ah.sessionRole(r) < role {
+			http.Error(w, "your role can't do that", http.StatusForbidden)
+			return
+		}
+		next(w, r)
+	}
+}
@@ -0,0 +1,40 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecurityHeaders(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]struct {
+		handler http.HandlerFunc
+		wantCSP string
+	}{
+		"default": {
+			handler: func(w http.ResponseWriter, r *http.Request) {},
+			wantCSP: contentSecurityPolicy,
+		},
+		"replaced": {
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Security-Policy", "default-src 'none'; sandbox")
+			},
+			wantCSP: "default-src 'none'; sandbox",
+		},
+	}
+	for n, tc := range testCases {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+			rec := httptest.NewRecorder()
+			securityHeaders(tc.handler).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+			require.Equal(t, tc.wantCSP, rec.Header().Get("Content-Security-Policy"))
+			require.Equal(t, "nosniff", rec.Header().Get("X-Content-Type-Options"))
+			require.Equal(t, "DENY", rec.Header().Get("X-Frame-Options"))
+			require.Equal(t, "same-origin", rec.Header().Get("Referrer-Policy"))
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/lusis/htmxtools"
+	"golang.org/x/exp/slog"
+
+	v1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
+	"github.com/lusis/statusthing/internal/serrors"
+	"github.com/lusis/statusthing/internal/services"
+	"github.com/lusis/statusthing/internal/storers"
+)
+
+const settingsPage = "settings.html"
+
+// settingsTextFields are the settings the form always sends
+var settingsTextFields = []string{"page_title", "accent_color", "accent_text_color", "support_url", "footer_text", "timezone"}
+
+// editSettings changes the settings from the form
+// the text fields are always changed. a logo or favicon is only changed when one is uploaded or its remove box is checked
+func (ah *AdminHandler) editSettings(w http.ResponseWriter, r *http.Request) {
+	if _, ok := sessionUsername(w, r); !ok {
+		return
+	}
+	r.Body = http.MaxBytesReader(w, r.Body, 2*services.SettingsImageMaxSize+(64<<10))
+	if err := r.ParseMultipartForm(2 * services.SettingsImageMaxSize); err != nil {
+		slog.Error("unable to parse form", "error", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	version, err := strconv.ParseUint(r.FormValue("version"), 10, 64)
+	if err != nil {
+		http.Error(w, "invalid settings version", http.StatusBadRequest)
+		return
+	}
+	update := services.SettingsUpdate{
+		Settings: &v1.Settings{
+			PageTitle:       r.FormValue("page_title"),
+			AccentColor:     r.FormValue("accent_color"),
+			AccentTextColor: r.FormValue("accent_text_color"),
+			SupportUrl:      r.FormValue("support_url"),
+			FooterText:      r.FormValue("footer_text"),
+			Timezone:        r.FormValue("timezone"),
+		},
+		Fields:          append([]string{}, settingsTextFields...),
+		ExpectedVersion: version,
+	}
+	for _, name := range []string{storers.SettingsLogo, storers.SettingsFavicon} {
+		data, changed, err := formImage(r, name)
+		if err != nil {
+			slog.Error("unable to read form", "error", err)
+			http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+			return
+		}
+		if !changed {
+			continue
+		}
+		update.Fields = append(update.Fields, name)
+		if name == storers.SettingsLogo {
+			update.Logo = data
+		} else {
+			update.Favicon = data
+		}
+	}
+	if _, err := ah.sts.UpdateSettings(r.Context(), update); err != nil {
+		slog.Error("unable to edit settings", "error", err)
+		if errors.Is(err, serrors.ErrConflict) {
+			http.Error(w, "the settings were changed by someone else. reload the page and try again", http.StatusConflict)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+	slog.Info("edited settings")
+	w.Header().Add(htmxtools.RedirectResponse.String(), settingsPage)
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// formImage returns the uploaded file with the provided name and whether it should be changed
+// checking the remove_<name> box changes it to nothing
+func formImage(r *http.Request, name string) ([]byte, bool, error) {
+	if r.FormValue("remove_"+name) != "" {
+		return nil, true, nil
+	}
+	file, _, err := r.FormFile(name)
+	if errors.Is(err, http.ErrMissingFile) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	defer file.Close()
+	data, err := io.ReadAll(io.LimitReader(file, services.SettingsImageMaxSize+1))
+	if err != nil {
+		return nil, false, err
+	}
+	return data, len(data) != 0, nil
+}
@@ -0,0 +1,39 @@
+package handlers
+
+import (
+	"net/http"
+
+	"golang.org/x/exp/slog"
+
+	"github.com/lusis/statusthing/internal/session"
+)
+
+// signOut ends the current session
+func (ah *AdminHandler) signOut(w http.ResponseWriter, r *http.Request) {
+	username := session.Sessions.GetString(r.Context(), session.UsernameKey)
+	if err := session.Sessions.Destroy(r.Context()); err != nil {
+		slog.Error("unable to sign out", "error", err, "username", username)
+		http.Error(w, "unable to sign out", http.StatusInternalServerError)
+		return
+	}
+	slog.Info("signed out", "username", username)
+	w.Header().Add(hxRedirectHeader, "/")
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// signOutEverywhere ends every session of the logged in user
+func (ah *AdminHandler) signOutEverywhere(w http.ResponseWriter, r *http.Request) {
+	username, ok := sessionUsername(w, r)
+	if !ok {
+		return
+	}
+	ended, err := session.SignOutEverywhere(r.Context(), username)
+	if err != nil {
+		slog.Error("unable to sign out everywhere", "error", err, "username", username)
+		http.Error(w, "unable to sign out everywhere", http.StatusInternalServerError)
+		return
+	}
+	slog.Info("signed out everywhere", "username", username, "sessions", ended)
+	w.Header().Add(hxRedirectHeader, "/")
+	w.WriteHeader(http.StatusAccepted)
+}
@@ -0,0 +1,280 @@
+// Package markdown renders a small, safe subset of markdown to html
+//
+// all text is escaped and only a fixed set of tags is ever written so the output needs no further sanitizing.
+// supported are paragraphs, line breaks, # headings, - * and 1. lists, > quotes, ``` fenced code blocks,
+// `code`, **strong**, *emphasis* or _emphasis_, ~~strikethrough~~ and [links](https://example.com).
+// links are only kept for http, https and mailto urls and always open with rel="nofollow noopener noreferrer"
+package markdown
+
+import (
+	"html"
+	"net/url"
+	"strings"
+)
+
+// Render renders the provided markdown to html that is safe to include in a page as-is
+func Render(src string) string {
+	r := &renderer{}
+	lines := strings.Split(strings.ReplaceAll(src, "\r\n", "\n"), "\n")
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case strings.HasPrefix(trimmed, "```"):
+			r.closeBlocks()
+			r.b.WriteString("<pre><code>")
+			first := true
+			for i++; i < len(lines) && !strings.HasPrefix(strings.TrimSpace(lines[i]), "```"); i++ {
+				if !first {
+					r.b.WriteString("\n")
+				}
+				first = false
+				r.b.WriteString(html.EscapeString(lines[i]))
+			}
+			r.b.WriteString("</code></pre>\n")
+		case trimmed == "":
+			r.closeBlocks()
+		case heading(trimmed) > 0:
+			r.closeBlocks()
+			level := heading(trimmed)
+			tag := "h" + string(rune('0'+level))
+			r.b.WriteString("<" + tag + ">" + inline(strings.TrimSpace(trimmed[level:])) + "</" + tag + ">\n")
+		case strings.HasPrefix(trimmed, ">"):
+			r.open("blockquote")
+			r.text(strings.TrimSpace(strings.TrimPrefix(trimmed, ">")))
+		case listItem(trimmed, false) != "":
+			r.item("ul", listItem(trimmed, false))
+		case listItem(trimmed, true) != "":
+			r.item("ol", listItem(trimmed, true))
+		default:
+			if r.block == "ul" || r.block == "ol" {
+				// a line following a list item continues it
+				r.b.WriteString(" " + inline(trimmed))
+				continue
+			}
+			r.open("p")
+			r.text(trimmed)
+		}
+	}
+	r.closeBlocks()
+	return strings.TrimSuffix(r.b.String(), "\n")
+}
+
+type renderer struct {
+	b strings.Builder
+	// block is the open block element, if any
+	block string
+	// lineOpen is true when the open block already has text
+	lineOpen bool
+	// itemOpen is true when a list item is open
+	itemOpen bool
+}
+
+func (r *renderer) open(block string) {
+	if r.block == block {
+		return
+	}
+	r.closeBlocks()
+	r.block = block
+	if block == "blockquote" {
+		r.b.WriteString("<blockquote><p>")
+		return
+	}
+	r.b.WriteString("<" + block + ">")
+}
+
+// text adds a line to the open paragraph or quote. consecutive lines are joined with a line break
+func (r *renderer) text(line string) {
+	if r.lineOpen {
+		r.b.WriteString("<br>\n")
+	}
+	r.b.WriteString(inline(line))
+	r.lineOpen = true
+}
+
+func (r *renderer) item(list, text string) {
+	r.open(list)
+	if r.itemOpen {
+		r.b.WriteString("</li>")
+	}
+	r.b.WriteString("<li>" + inline(text))
+	r.itemOpen = true
+}
+
+func (r *renderer) closeBlocks() {
+	switch r.block {
+	case "":
+		return
+	case "blockquote":
+		r.b.WriteString("</p></blockquote>\n")
+	case "ul", "ol":
+		if r.itemOpen {
+			r.b.WriteString("</li>")
+		}
+		r.b.WriteString("</" + r.block + ">\n")
+	default:
+		r.b.WriteString("</" + r.block + ">\n")
+	}
+	r.block = ""
+	r.lineOpen = false
+	r.itemOpen = false
+}
+
+// heading returns the level of a # heading line or 0
+func heading(line string) int {
+	level := 0
+	for level < len(line) && line[level] == '#' {
+		level++
+	}
+	if level == 0 || level > 6 || level == len(line) || line[level] != ' ' {
+		return 0
+	}
+	return level
+}
+
+// listItem returns the text of a list item line or an empty string
+func listItem(line string, ordered bool) string {
+	if !ordered {
+		if len(line) > 2 && (line[0] == '-' || line[0] == '*' || line[0] == '+') && line[1] == ' ' {
+			return strings.TrimSpace(line[2:])
+		}
+		return ""
+	}
+	digits := 0
+	for digits < len(line) && line[digits] >= '0' && line[digits] <= '9' {
+		digits++
+	}
+	if digits == 0 || digits+2 > len(line) || (line[digits] != '.' && line[digits] != ')') || line[digits+1] != ' ' {
+		return ""
+	}
+	return strings.TrimSpace(line[digits+2:])
+}
+
+// spans are the paired inline markers from longest to shortest so ** is matched before *
+var spans = []struct {
+	marker string
+	tag    string
+}{
+	{"**", "strong"},
+	{"__", "strong"},
+	{"~~", "del"},
+	{"*", "em"},
+	{"_", "em"},
+}
+
+// inline renders code, links and emphasis in a single line of text
+func inline(text string) string {
+	var b strings.Builder
+	sc := &scanner{text: text, found: map[string]match{}}
+	for i := 0; i < len(text); {
+		rest := text[i:]
+		switch {
+		case rest[0] == '\\' && len(rest) > 1 && strings.ContainsRune("\\`*_~[]()#>-+.!", rune(rest[1])):
+			b.WriteString(html.EscapeString(rest[1:2]))
+			i += 2
+			continue
+		case rest[0] == '`':
+			if end := sc.index(i+1, "`"); end > 0 {
+				b.WriteString("<code>" + html.EscapeString(rest[1:end+1]) + "</code>")
+				i += end + 2
+				continue
+			}
+		case rest[0] == '[':
+			if label, target, n := sc.link(i); n > 0 {
+				if u := safeURL(target); u != "" {
+					b.WriteString(`<a href="` + html.EscapeString(u) + `" rel="nofollow noopener noreferrer">` + inline(label) + "</a>")
+				} else {
+					b.WriteString(inline(label))
+				}
+				i += n
+				continue
+			}
+		}
+		matched := false
+		for _, span := range spans {
+			if !strings.HasPrefix(rest, span.marker) {
+				continue
+			}
+			inner := rest[len(span.marker):]
+			end := sc.index(i+len(span.marker), span.marker)
+			// markers must hug the text they wrap so 2 * 3 * 4 is left alone
+			if end <= 0 || inner[0] == ' ' || inner[end-1] == ' ' {
+				continue
+			}
+			b.WriteString("<" + span.tag + ">" + inline(inner[:end]) + "</" + span.tag + ">")
+			i += len(span.marker)*2 + end
+			matched = true
+			break
+		}
+		if matched {
+			continue
+		}
+		b.WriteString(html.EscapeString(rest[:1]))
+		i++
+	}
+	return b.String()
+}
+
+// scanner finds markers in a line of text
+// searches move forward through the line so the last match of each marker is remembered and reused,
+// which keeps lines like [[[[ or **** from being searched to the end once per marker
+type scanner struct {
+	text  string
+	found map[string]match
+}
+
+// match is where a search for a marker started and the position it found, -1 when there was none
+type match struct {
+	from int
+	pos  int
+}
+
+// index returns the position of the first marker at or after from relative to from, or -1
+func (s *scanner) index(from int, marker string) int {
+	m, ok := s.found[marker]
+	if !ok || m.from > from || (m.pos >= 0 && m.pos < from) {
+		m = match{from: from, pos: strings.Index(s.text[from:], marker)}
+		if m.pos >= 0 {
+			m.pos += from
+		}
+		s.found[marker] = m
+	}
+	if m.pos < 0 {
+		return -1
+	}
+	return m.pos - from
+}
+
+// link parses [label](target) at position i and returns how many bytes it used
+func (s *scanner) link(i int) (string, string, int) {
+	closeLabel := s.index(i, "](")
+	if closeLabel < 1 {
+		return "", "", 0
+	}
+	closeTarget := s.index(i+closeLabel+2, ")")
+	if closeTarget < 0 {
+		return "", "", 0
+	}
+	text := s.text[i:]
+	target := strings.TrimSpace(text[closeLabel+2 : closeLabel+2+closeTarget])
+	return text[1:closeLabel], target, closeLabel + 3 + closeTarget
+}
+
+// safeURL returns the url if it is an absolute http, https or mailto url
+func safeURL(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return ""
+	}
+	switch strings.ToLower(u.Scheme) {
+	case "http", "https":
+		if u.Host == "" {
+			return ""
+		}
+		return u.String()
+	case "mailto":
+		return u.String()
+	default:
+		return ""
+	}
+}
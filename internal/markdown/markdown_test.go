@@ -0,0 +1,66 @@
+package markdown
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRender(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]struct {
+		src      string
+		expected string
+	}{
+		"plain":         {src: "Investigating", expected: "<p>Investigating</p>"},
+		"paragraphs":    {src: "first\nstill first\n\nsecond", expected: "<p>first<br>\nstill first</p>\n<p>second</p>"},
+		"heading":       {src: "## Update", expected: "<h2>Update</h2>"},
+		"not-heading":   {src: "#1 priority", expected: "<p>#1 priority</p>"},
+		"emphasis":      {src: "**down** for *some* _users_ ~~all~~", expected: "<p><strong>down</strong> for <em>some</em> <em>users</em> <del>all</del></p>"},
+		"loose-markers": {src: "2 * 3 * 4", expected: "<p>2 * 3 * 4</p>"},
+		"code":          {src: "run `rm <x>`", expected: "<p>run <code>rm &lt;x&gt;</code></p>"},
+		"escaped":       {src: `\*not emphasis\*`, expected: "<p>*not emphasis*</p>"},
+		"unordered":     {src: "- one\n- two\n  more", expected: "<ul><li>one</li><li>two more</li></ul>"},
+		"ordered":       {src: "1. one\n2) two", expected: "<ol><li>one</li><li>two</li></ol>"},
+		"quote":         {src: "> quoted\n> text", expected: "<blockquote><p>quoted<br>\ntext</p></blockquote>"},
+		"fenced":        {src: "```\n<b>\n  x\n```\nafter", expected: "<pre><code>&lt;b&gt;\n  x</code></pre>\n<p>after</p>"},
+		"link":          {src: "see [the **docs**](https://example.com/a?b=1&c=2)", expected: `<p>see <a href="https://example.com/a?b=1&amp;c=2" rel="nofollow noopener noreferrer">the <strong>docs</strong></a></p>`},
+		"mailto":        {src: "[mail](mailto:ops@example.com)", expected: `<p><a href="mailto:ops@example.com" rel="nofollow noopener noreferrer">mail</a></p>`},
+		"html":          {src: `<script>alert("x")</script><img src=x onerror=alert(1)>`, expected: "<p>&lt;script&gt;alert(&#34;x&#34;)&lt;/script&gt;&lt;img src=x onerror=alert(1)&gt;</p>"},
+		"js-link":       {src: "[click](javascript:alert(1))", expected: "<p>click)</p>"},
+		"relative-link": {src: "[click](/admin)", expected: "<p>click</p>"},
+		"quoted-link":   {src: `[x](https://example.com/"onmouseover="alert(1))`, expected: `<p><a href="https://example.com/%22onmouseover=%22alert%281" rel="nofollow noopener noreferrer">x</a>)</p>`},
+		"long":          {src: strings.Repeat("text ", 500), expected: "<p>" + strings.Repeat("text ", 499) + "text</p>"},
+	}
+	for n, tc := range testCases {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tc.expected, Render(tc.src))
+		})
+	}
+}
+
+func TestRenderUnmatchedMarkers(t *testing.T) {
+	t.Parallel()
+	// markers without a match must not make rendering take time quadratic in the length of the line
+	testCases := map[string]string{
+		"brackets":     strings.Repeat("[", 1<<20),
+		"link-open":    strings.Repeat("[a](", 1<<18),
+		"label-closes": strings.Repeat("[", 1<<20) + "](",
+		"backticks":    "a" + strings.Repeat("`", 1<<20),
+		"strong":       strings.Repeat("**a", 1<<18),
+	}
+	for n, src := range testCases {
+		src := src
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+			start := time.Now()
+			res := Render(src)
+			require.NotEmpty(t, res)
+			require.Less(t, time.Since(start), 2*time.Second)
+		})
+	}
+}
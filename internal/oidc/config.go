@@ -0,0 +1,126 @@
+// Package oidc logs admin ui users in with an OpenID Connect provider using the authorization code flow
+package oidc
+
+import (
+	"net/url"
+	"strings"
+
+	v1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
+	"github.com/lusis/statusthing/internal/serrors"
+)
+
+// Config is the OpenID Connect configuration
+// logging in with a provider is enabled when DiscoveryURL is set
+type Config struct {
+	// DiscoveryURL is the url of the provider's openid-configuration document
+	DiscoveryURL string `yaml:"discovery_url"`
+	// ClientID is the client id registered with the provider
+	ClientID string `yaml:"client_id"`
+	// ClientSecret is the client secret registered with the provider
+	ClientSecret string `yaml:"client_secret"`
+	// RedirectURL is the external url of the admin ui's /oidc/callback
+	RedirectURL string `yaml:"redirect_url"`
+	// Scopes are the scopes requested. openid is always requested
+	Scopes []string `yaml:"scopes"`
+	// UsernameClaim is the id token claim users are known by
+	UsernameClaim string `yaml:"username_claim"`
+	// GroupsClaim is the id token claim listing the user's groups
+	GroupsClaim string `yaml:"groups_claim"`
+	// AdminGroups are the groups whose members are admins
+	AdminGroups []string `yaml:"admin_groups"`
+	// EditorGroups are the groups whose members are editors
+	EditorGroups []string `yaml:"editor_groups"`
+	// ViewerGroups are the groups whose members are viewers
+	ViewerGroups []string `yaml:"viewer_groups"`
+	// DefaultRole is the role of users in none of the groups: viewer, editor or admin
+	// when it isn't set they can't log in
+	DefaultRole string `yaml:"default_role"`
+}
+
+// DefaultConfig returns the default [Config]
+func DefaultConfig() Config {
+	return Config{
+		Scopes:        []string{"openid", "profile", "email"},
+		UsernameClaim: "preferred_username",
+		GroupsClaim:   "groups",
+	}
+}
+
+// Enabled is true when logging in with a provider is configured
+func (c Config) Enabled() bool {
+	return strings.TrimSpace(c.DiscoveryURL) != ""
+}
+
+// Validate checks the [Config] for errors
+func (c Config) Validate() error {
+	if !c.Enabled() {
+		return nil
+	}
+	for name, value := range map[string]string{"discovery_url": c.DiscoveryURL, "redirect_url": c.RedirectURL} {
+		u, err := url.Parse(value)
+		if err != nil {
+			return serrors.NewWrappedError(name, serrors.ErrInvalidData, err)
+		}
+		if (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+			return serrors.NewError(name+" must be an absolute http or https url", serrors.ErrInvalidData)
+		}
+	}
+	if strings.TrimSpace(c.ClientID) == "" {
+		return serrors.NewError("client_id", serrors.ErrEmptyString)
+	}
+	if strings.TrimSpace(c.UsernameClaim) == "" {
+		return serrors.NewError("username_claim", serrors.ErrEmptyString)
+	}
+	if c.DefaultRole != "" {
+		if _, err := ParseRole(c.DefaultRole); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Role returns the role of a user in the provided groups
+// members of more than one group get the role with the most access
+// users in none of them get DefaultRole, or [v1.Role_ROLE_UNKNOWN] when it isn't set
+func (c Config) Role(groups []string) v1.Role {
+	member := func(configured []string) bool {
+		for _, g := range configured {
+			for _, have := range groups {
+				if g == have {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	switch {
+	case member(c.AdminGroups):
+		return v1.Role_ROLE_ADMIN
+	case member(c.EditorGroups):
+		return v1.Role_ROLE_EDITOR
+	case member(c.ViewerGroups):
+		return v1.Role_ROLE_VIEWER
+	}
+	role, _ := ParseRole(c.DefaultRole)
+	return role
+}
+
+// scopes returns Scopes with openid first
+func (c Config) scopes() []string {
+	res := []string{"openid"}
+	for _, s := range c.Scopes {
+		if s != "openid" && strings.TrimSpace(s) != "" {
+			res = append(res, s)
+		}
+	}
+	return res
+}
+
+// ParseRole returns the [v1.Role] named viewer, editor or admin
+func ParseRole(name string) (v1.Role, error) {
+	role, ok := v1.Role_value["ROLE_"+strings.ToUpper(strings.TrimSpace(name))]
+	if !ok || v1.Role(role) == v1.Role_ROLE_UNKNOWN {
+		return v1.Role_ROLE_UNKNOWN, serrors.NewError("role "+name, serrors.ErrInvalidData)
+	}
+	return v1.Role(role), nil
+}
@@ -0,0 +1,149 @@
+package oidc
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
+	"github.com/lusis/statusthing/internal/oidc/oidctest"
+	"github.com/lusis/statusthing/internal/serrors"
+)
+
+func testConfig(fake *oidctest.Provider) Config {
+	cfg := DefaultConfig()
+	cfg.DiscoveryURL = fake.DiscoveryURL()
+	cfg.ClientID = oidctest.ClientID
+	cfg.ClientSecret = oidctest.ClientSecret
+	cfg.RedirectURL = "http://statusthing.example.com/oidc/callback"
+	return cfg
+}
+
+func TestLogin(t *testing.T) {
+	t.Parallel()
+	ctx := context.TODO()
+	fake := oidctest.NewProvider(t)
+	fake.SetClaims(map[string]any{"sub": "1234", "preferred_username": "sam", "groups": []string{"ops"}})
+	p, err := NewProvider(testConfig(fake))
+	require.NoError(t, err)
+
+	req, err := NewAuthRequest()
+	require.NoError(t, err)
+	authURL, err := p.AuthCodeURL(ctx, req)
+	require.NoError(t, err)
+	u, err := url.Parse(authURL)
+	require.NoError(t, err)
+	require.Equal(t, "openid profile email", u.Query().Get("scope"))
+
+	client := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }}
+	res, err := client.Get(authURL)
+	require.NoError(t, err)
+	require.NoError(t, res.Body.Close())
+	require.Equal(t, http.StatusFound, res.StatusCode)
+	callback, err := url.Parse(res.Header.Get("Location"))
+	require.NoError(t, err)
+	require.Equal(t, req.State, callback.Query().Get("state"))
+
+	_, err = p.Exchange(ctx, callback.Query().Get("code"), &AuthRequest{State: req.State, Nonce: req.Nonce, Verifier: "wrong"})
+	require.ErrorIs(t, err, serrors.ErrMissingCredentials, "the pkce verifier should be checked")
+
+	res, err = client.Get(authURL)
+	require.NoError(t, err)
+	require.NoError(t, res.Body.Close())
+	callback, err = url.Parse(res.Header.Get("Location"))
+	require.NoError(t, err)
+	claims, err := p.Exchange(ctx, callback.Query().Get("code"), req)
+	require.NoError(t, err)
+	require.Equal(t, "sam", claims.String("preferred_username"))
+	require.Equal(t, []string{"ops"}, claims.Strings("groups"))
+}
+
+func TestVerifyIDToken(t *testing.T) {
+	t.Parallel()
+	fake := oidctest.NewProvider(t)
+	p, err := NewProvider(testConfig(fake))
+	require.NoError(t, err)
+	tamper := func(token string) string {
+		parts := strings.Split(token, ".")
+		parts[1] = base64.RawURLEncoding.EncodeToString([]byte(`{"iss":"` + fake.Issuer() + `","aud":"statusthing","nonce":"n","exp":9999999999,"preferred_username":"admin"}`))
+		return strings.Join(parts, ".")
+	}
+	unsigned := func(token string) string {
+		parts := strings.Split(token, ".")
+		parts[0] = base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none"}`))
+		return parts[0] + "." + parts[1] + "."
+	}
+	testCases := map[string]struct {
+		token   string
+		wantErr error
+	}{
+		"valid":           {token: fake.IDToken(map[string]any{"nonce": "n"})},
+		"wrong-nonce":     {token: fake.IDToken(map[string]any{"nonce": "other"}), wantErr: serrors.ErrInvalidData},
+		"wrong-audience":  {token: fake.IDToken(map[string]any{"nonce": "n", "aud": "other"}), wantErr: serrors.ErrInvalidData},
+		"other-audiences": {token: fake.IDToken(map[string]any{"nonce": "n", "aud": []string{"other", oidctest.ClientID}, "azp": "other"}), wantErr: serrors.ErrInvalidData},
+		"wrong-issuer":    {token: fake.IDToken(map[string]any{"nonce": "n", "iss": "https://evil.example.com"}), wantErr: serrors.ErrInvalidData},
+		"expired":         {token: fake.IDToken(map[string]any{"nonce": "n", "exp": time.Now().Add(-time.Hour).Unix()}), wantErr: serrors.ErrInvalidData},
+		"no-expiry":       {token: fake.IDToken(map[string]any{"nonce": "n", "exp": nil}), wantErr: serrors.ErrMissingTimestamp},
+		"tampered":        {token: tamper(fake.IDToken(map[string]any{"nonce": "n"})), wantErr: serrors.ErrInvalidData},
+		"unsigned":        {token: unsigned(fake.IDToken(map[string]any{"nonce": "n"})), wantErr: serrors.ErrUnsupportedType},
+		"not-a-jwt":       {token: "nope", wantErr: serrors.ErrInvalidData},
+	}
+	for n, tc := range testCases {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+			claims, err := p.verifyIDToken(context.TODO(), tc.token, "n")
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, fake.Issuer(), claims.String("iss"))
+		})
+	}
+}
+
+func TestConfig(t *testing.T) {
+	t.Parallel()
+	cfg := DefaultConfig()
+	require.NoError(t, cfg.Validate(), "a disabled config should be valid")
+	cfg.DiscoveryURL = "https://sso.example.com/.well-known/openid-configuration"
+	require.ErrorIs(t, cfg.Validate(), serrors.ErrInvalidData, "redirect_url should be required")
+	cfg.RedirectURL = "https://status.example.com/oidc/callback"
+	require.ErrorIs(t, cfg.Validate(), serrors.ErrEmptyString, "client_id should be required")
+	cfg.ClientID = "statusthing"
+	require.NoError(t, cfg.Validate())
+	cfg.DefaultRole = "owner"
+	require.ErrorIs(t, cfg.Validate(), serrors.ErrInvalidData)
+
+	cfg.DefaultRole = ""
+	cfg.AdminGroups = []string{"admins"}
+	cfg.EditorGroups = []string{"ops"}
+	cfg.ViewerGroups = []string{"everyone"}
+	testCases := map[string]struct {
+		groups      []string
+		defaultRole string
+		want        v1.Role
+	}{
+		"admin":             {groups: []string{"everyone", "admins"}, want: v1.Role_ROLE_ADMIN},
+		"editor":            {groups: []string{"ops", "everyone"}, want: v1.Role_ROLE_EDITOR},
+		"viewer":            {groups: []string{"everyone"}, want: v1.Role_ROLE_VIEWER},
+		"no-groups":         {want: v1.Role_ROLE_UNKNOWN},
+		"no-groups-default": {defaultRole: "Viewer", want: v1.Role_ROLE_VIEWER},
+	}
+	for n, tc := range testCases {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+			c := cfg
+			c.DefaultRole = tc.defaultRole
+			require.Equal(t, tc.want, c.Role(tc.groups))
+		})
+	}
+}
@@ -0,0 +1,242 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lusis/statusthing/internal/serrors"
+)
+
+const (
+	// maxResponseSize is the largest response read from the provider
+	maxResponseSize = 1 << 20
+	// keyRefreshInterval is how long a key set is used before a token signed with an unknown key fetches it again
+	keyRefreshInterval = time.Minute
+	// clockSkew is how far the provider's clock can be ahead of or behind ours
+	clockSkew = time.Minute
+)
+
+// Provider logs users in with an OpenID Connect provider
+// the provider's configuration is discovered the first time it is needed so the server can start while the provider is down
+type Provider struct {
+	cfg    Config
+	client *http.Client
+	now    func() time.Time
+
+	l           sync.Mutex
+	metadata    *metadata
+	keys        map[string]any
+	keysFetched time.Time
+}
+
+// ProviderOption is a functional option for configuring a [Provider]
+type ProviderOption func(p *Provider) error
+
+// WithHTTPClient sets the client used to talk to the provider
+// defaults to a client with a 10 second timeout
+func WithHTTPClient(client *http.Client) ProviderOption {
+	return func(p *Provider) error {
+		if client == nil {
+			return serrors.NewError("client", serrors.ErrNilVal)
+		}
+		p.client = client
+		return nil
+	}
+}
+
+// NewProvider returns a new [Provider] for the provided [Config]
+func NewProvider(cfg Config, opts ...ProviderOption) (*Provider, error) {
+	if !cfg.Enabled() {
+		return nil, serrors.NewError("discovery_url", serrors.ErrEmptyString)
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	p := &Provider{
+		cfg:    cfg,
+		client: &http.Client{Timeout: 10 * time.Second},
+		now:    time.Now,
+	}
+	for _, opt := range opts {
+		if err := opt(p); err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+// Config returns the [Config] of the provider
+func (p *Provider) Config() Config {
+	return p.cfg
+}
+
+// metadata is the part of the openid-configuration document we use
+type metadata struct {
+	Issuer                string   `json:"issuer"`
+	AuthorizationEndpoint string   `json:"authorization_endpoint"`
+	TokenEndpoint         string   `json:"token_endpoint"`
+	JWKSURI               string   `json:"jwks_uri"`
+	TokenAuthMethods      []string `json:"token_endpoint_auth_methods_supported"`
+}
+
+// AuthRequest is what a login has to remember between sending the user to the provider and the provider sending them back
+type AuthRequest struct {
+	// State ties the callback to the session that started the login
+	State string
+	// Nonce ties the id token to the login
+	Nonce string
+	// Verifier is the pkce code verifier
+	Verifier string
+}
+
+// NewAuthRequest returns an [AuthRequest] with new random values
+func NewAuthRequest() (*AuthRequest, error) {
+	values := make([]string, 3)
+	for i := range values {
+		b := make([]byte, 32)
+		if _, err := rand.Read(b); err != nil {
+			return nil, serrors.NewWrappedError("random", serrors.ErrUnrecoverable, err)
+		}
+		values[i] = base64.RawURLEncoding.EncodeToString(b)
+	}
+	return &AuthRequest{State: values[0], Nonce: values[1], Verifier: values[2]}, nil
+}
+
+// AuthCodeURL returns the url to send the user to so they can log in with the provider
+func (p *Provider) AuthCodeURL(ctx context.Context, req *AuthRequest) (string, error) {
+	if req == nil {
+		return "", serrors.NewError("auth request", serrors.ErrNilVal)
+	}
+	md, err := p.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+	u, err := url.Parse(md.AuthorizationEndpoint)
+	if err != nil {
+		return "", serrors.NewWrappedError("authorization_endpoint", serrors.ErrInvalidData, err)
+	}
+	challenge := sha256.Sum256([]byte(req.Verifier))
+	q := u.Query()
+	q.Set("response_type", "code")
+	q.Set("client_id", p.cfg.ClientID)
+	q.Set("redirect_uri", p.cfg.RedirectURL)
+	q.Set("scope", strings.Join(p.cfg.scopes(), " "))
+	q.Set("state", req.State)
+	q.Set("nonce", req.Nonce)
+	q.Set("code_challenge", base64.RawURLEncoding.EncodeToString(challenge[:]))
+	q.Set("code_challenge_method", "S256")
+	u.RawQuery = q.Encode()
+	return u.String(), nil
+}
+
+// Exchange trades the code the provider sent the user back with for the claims of their verified id token
+func (p *Provider) Exchange(ctx context.Context, code string, req *AuthRequest) (Claims, error) {
+	if strings.TrimSpace(code) == "" {
+		return nil, serrors.NewError("code", serrors.ErrEmptyString)
+	}
+	if req == nil {
+		return nil, serrors.NewError("auth request", serrors.ErrNilVal)
+	}
+	md, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {p.cfg.RedirectURL},
+		"code_verifier": {req.Verifier},
+	}
+	secretInForm := !supports(md.TokenAuthMethods, "client_secret_basic") && supports(md.TokenAuthMethods, "client_secret_post")
+	if secretInForm {
+		form.Set("client_id", p.cfg.ClientID)
+		form.Set("client_secret", p.cfg.ClientSecret)
+	}
+	hreq, err := http.NewRequestWithContext(ctx, http.MethodPost, md.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, serrors.NewWrappedError("token request", serrors.ErrInvalidData, err)
+	}
+	hreq.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	hreq.Header.Set("Accept", "application/json")
+	if !secretInForm {
+		hreq.SetBasicAuth(url.QueryEscape(p.cfg.ClientID), url.QueryEscape(p.cfg.ClientSecret))
+	}
+	var res struct {
+		IDToken          string `json:"id_token"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	status, err := p.doJSON(hreq, &res)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK || res.Error != "" {
+		return nil, serrors.NewError(fmt.Sprintf("token endpoint returned %d %s %s", status, res.Error, res.ErrorDescription), serrors.ErrMissingCredentials)
+	}
+	if res.IDToken == "" {
+		return nil, serrors.NewError("id_token", serrors.ErrEmptyString)
+	}
+	return p.verifyIDToken(ctx, res.IDToken, req.Nonce)
+}
+
+// discover fetches the openid-configuration document the first time it's needed
+func (p *Provider) discover(ctx context.Context) (*metadata, error) {
+	p.l.Lock()
+	defer p.l.Unlock()
+	if p.metadata != nil {
+		return p.metadata, nil
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.cfg.DiscoveryURL, nil)
+	if err != nil {
+		return nil, serrors.NewWrappedError("discovery_url", serrors.ErrInvalidData, err)
+	}
+	md := &metadata{}
+	status, err := p.doJSON(req, md)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK {
+		return nil, serrors.NewError(fmt.Sprintf("discovery document returned %d", status), serrors.ErrDependencyMissing)
+	}
+	if md.Issuer == "" || md.AuthorizationEndpoint == "" || md.TokenEndpoint == "" || md.JWKSURI == "" {
+		return nil, serrors.NewError("discovery document is missing issuer, authorization_endpoint, token_endpoint or jwks_uri", serrors.ErrInvalidData)
+	}
+	p.metadata = md
+	return md, nil
+}
+
+// doJSON sends the request and decodes the json response into v, returning the status code
+func (p *Provider) doJSON(req *http.Request, v any) (int, error) {
+	res, err := p.client.Do(req)
+	if err != nil {
+		return 0, serrors.NewWrappedError("provider", serrors.ErrDependencyMissing, err)
+	}
+	defer res.Body.Close()
+	if err := json.NewDecoder(io.LimitReader(res.Body, maxResponseSize)).Decode(v); err != nil {
+		return res.StatusCode, serrors.NewWrappedError(fmt.Sprintf("provider response %d", res.StatusCode), serrors.ErrInvalidData, err)
+	}
+	return res.StatusCode, nil
+}
+
+func supports(methods []string, method string) bool {
+	if len(methods) == 0 {
+		// client_secret_basic is the default when the provider doesn't say
+		return method == "client_secret_basic"
+	}
+	for _, m := range methods {
+		if m == method {
+			return true
+		}
+	}
+	return false
+}
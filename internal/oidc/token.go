@@ -0,0 +1,302 @@
+package oidc
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/lusis/statusthing/internal/serrors"
+)
+
+// Claims are the claims of a verified id token
+type Claims map[string]any
+
+// String returns the named claim if it's a string
+func (c Claims) String(name string) string {
+	s, _ := c[name].(string)
+	return s
+}
+
+// Strings returns the named claim if it's a list of strings or a single string
+func (c Claims) Strings(name string) []string {
+	switch v := c[name].(type) {
+	case string:
+		return []string{v}
+	case []any:
+		res := []string{}
+		for _, e := range v {
+			if s, ok := e.(string); ok {
+				res = append(res, s)
+			}
+		}
+		return res
+	}
+	return nil
+}
+
+// signingAlgs are the id token signing algorithms we accept
+var signingAlgs = map[string]crypto.Hash{
+	"RS256": crypto.SHA256,
+	"RS384": crypto.SHA384,
+	"RS512": crypto.SHA512,
+	"ES256": crypto.SHA256,
+	"ES384": crypto.SHA384,
+	"ES512": crypto.SHA512,
+}
+
+// verifyIDToken checks the signature, issuer, audience, expiry and nonce of the id token and returns its claims
+func (p *Provider) verifyIDToken(ctx context.Context, token string, nonce string) (Claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, serrors.NewError("id_token", serrors.ErrInvalidData)
+	}
+	var header struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, serrors.NewWrappedError("id_token header", serrors.ErrInvalidData, err)
+	}
+	hash, ok := signingAlgs[header.Alg]
+	if !ok {
+		return nil, serrors.NewError("id_token alg "+header.Alg, serrors.ErrUnsupportedType)
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, serrors.NewWrappedError("id_token signature", serrors.ErrInvalidData, err)
+	}
+	key, err := p.key(ctx, header.Kid, header.Alg)
+	if err != nil {
+		return nil, err
+	}
+	h := hash.New()
+	h.Write([]byte(parts[0] + "." + parts[1]))
+	if !verifySignature(key, hash, h.Sum(nil), sig) {
+		return nil, serrors.NewError("id_token signature", serrors.ErrInvalidData)
+	}
+
+	claims := Claims{}
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return nil, serrors.NewWrappedError("id_token claims", serrors.ErrInvalidData, err)
+	}
+	md, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if claims.String("iss") != md.Issuer {
+		return nil, serrors.NewError("id_token issuer "+claims.String("iss"), serrors.ErrInvalidData)
+	}
+	audiences := claims.Strings("aud")
+	if !contains(audiences, p.cfg.ClientID) {
+		return nil, serrors.NewError("id_token audience", serrors.ErrInvalidData)
+	}
+	if azp := claims.String("azp"); len(audiences) > 1 && azp != p.cfg.ClientID {
+		return nil, serrors.NewError("id_token authorized party "+azp, serrors.ErrInvalidData)
+	}
+	exp, ok := claims["exp"].(float64)
+	if !ok {
+		return nil, serrors.NewError("id_token exp", serrors.ErrMissingTimestamp)
+	}
+	if p.now().After(time.Unix(int64(exp), 0).Add(clockSkew)) {
+		return nil, serrors.NewError("id_token expired", serrors.ErrInvalidData)
+	}
+	if iat, ok := claims["iat"].(float64); ok && time.Unix(int64(iat), 0).After(p.now().Add(clockSkew)) {
+		return nil, serrors.NewError("id_token issued in the future", serrors.ErrInvalidData)
+	}
+	if claims.String("nonce") != nonce {
+		return nil, serrors.NewError("id_token nonce", serrors.ErrInvalidData)
+	}
+	return claims, nil
+}
+
+// key returns the provider's key with the provided id
+// the key set is fetched again when the key isn't known, at most once every [keyRefreshInterval]
+func (p *Provider) key(ctx context.Context, kid string, alg string) (any, error) {
+	md, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+	p.l.Lock()
+	defer p.l.Unlock()
+	if k := findKey(p.keys, kid, alg); k != nil {
+		return k, nil
+	}
+	if p.keys != nil && p.now().Sub(p.keysFetched) < keyRefreshInterval {
+		return nil, serrors.NewError("id_token key "+kid, serrors.ErrNotFound)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, md.JWKSURI, nil)
+	if err != nil {
+		return nil, serrors.NewWrappedError("jwks_uri", serrors.ErrInvalidData, err)
+	}
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+	status, err := p.doJSON(req, &set)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK {
+		return nil, serrors.NewError(fmt.Sprintf("jwks_uri returned %d", status), serrors.ErrDependencyMissing)
+	}
+	keys := map[string]any{}
+	for i, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		pub, err := k.publicKey()
+		if err != nil {
+			// keys of types we don't use are skipped
+			continue
+		}
+		id := k.Kid
+		if id == "" {
+			id = fmt.Sprintf("#%d", i)
+		}
+		keys[id] = pub
+	}
+	p.keys = keys
+	p.keysFetched = p.now()
+	if k := findKey(p.keys, kid, alg); k != nil {
+		return k, nil
+	}
+	return nil, serrors.NewError("id_token key "+kid, serrors.ErrNotFound)
+}
+
+// findKey returns the key with the provided id
+// tokens without a key id can only use a key set with a single key of the right type
+func findKey(keys map[string]any, kid string, alg string) any {
+	if kid != "" {
+		if k, ok := keys[kid]; ok && keyFits(k, alg) {
+			return k
+		}
+		return nil
+	}
+	var found any
+	for _, k := range keys {
+		if keyFits(k, alg) {
+			if found != nil {
+				return nil
+			}
+			found = k
+		}
+	}
+	return found
+}
+
+// keyFits is true when the key can verify signatures made with alg
+func keyFits(key any, alg string) bool {
+	switch k := key.(type) {
+	case *rsa.PublicKey:
+		return strings.HasPrefix(alg, "RS")
+	case *ecdsa.PublicKey:
+		switch alg {
+		case "ES256":
+			return k.Curve == elliptic.P256()
+		case "ES384":
+			return k.Curve == elliptic.P384()
+		case "ES512":
+			return k.Curve == elliptic.P521()
+		}
+	}
+	return false
+}
+
+// verifySignature checks the signature of the digest
+func verifySignature(key any, hash crypto.Hash, digest []byte, sig []byte) bool {
+	switch k := key.(type) {
+	case *rsa.PublicKey:
+		return rsa.VerifyPKCS1v15(k, hash, digest, sig) == nil
+	case *ecdsa.PublicKey:
+		size := (k.Curve.Params().BitSize + 7) / 8
+		if len(sig) != 2*size {
+			return false
+		}
+		r, s := new(big.Int).SetBytes(sig[:size]), new(big.Int).SetBytes(sig[size:])
+		return ecdsa.Verify(k, digest, r, s)
+	}
+	return false
+}
+
+// jwk is a json web key
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// publicKey returns the rsa or ecdsa public key
+func (k jwk) publicKey() (any, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, err
+		}
+		exponent := new(big.Int).SetBytes(e)
+		if len(n) == 0 || !exponent.IsInt64() || exponent.Int64() < 3 {
+			return nil, serrors.NewError("rsa key", serrors.ErrInvalidData)
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exponent.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, serrors.NewError("curve "+k.Crv, serrors.ErrUnsupportedType)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := base64.RawURLEncoding.DecodeString(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		pub := &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+		if !curve.IsOnCurve(pub.X, pub.Y) {
+			return nil, serrors.NewError("ec key", serrors.ErrInvalidData)
+		}
+		return pub, nil
+	}
+	return nil, serrors.NewError("key type "+k.Kty, serrors.ErrUnsupportedType)
+}
+
+func decodeSegment(seg string, v any) error {
+	b, err := base64.RawURLEncoding.DecodeString(seg)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
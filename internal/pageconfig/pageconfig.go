@@ -0,0 +1,127 @@
+// Package pageconfig describes the statuses and items a page should have
+// a [Page] is applied with [github.com/lusis/statusthing/internal/services.StatusThingService.PlanPage]
+package pageconfig
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	statusthingv1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
+	"github.com/lusis/statusthing/internal/serrors"
+	"github.com/lusis/statusthing/internal/validation"
+)
+
+// Page is the desired set of statuses and items
+// statuses and items are identified by name
+type Page struct {
+	Statuses []*Status `yaml:"statuses"`
+	Items    []*Item   `yaml:"items"`
+}
+
+// Status is a desired [statusthingv1.Status]
+type Status struct {
+	// Name is the unique name of the status
+	Name string `yaml:"name"`
+	// Kind is the [statusthingv1.StatusKind] with or without the STATUS_KIND_ prefix. i.e. up or STATUS_KIND_UP
+	Kind string `yaml:"kind"`
+	// Description is the optional description
+	Description string `yaml:"description"`
+	// Color is the optional color
+	Color string `yaml:"color"`
+}
+
+// Item is a desired [statusthingv1.Item]
+type Item struct {
+	// Name is the unique name of the item
+	Name string `yaml:"name"`
+	// Description is the optional description
+	Description string `yaml:"description"`
+	// Group is the optional name of another item in the page to group this item under
+	Group string `yaml:"group"`
+	// Status is the optional name of the status new items start with
+	// it is only set on existing items that have no status so status changes made since aren't undone
+	Status string `yaml:"status"`
+}
+
+// StatusKind returns the [statusthingv1.StatusKind] of the status
+func (s *Status) StatusKind() statusthingv1.StatusKind {
+	kind := strings.ToUpper(strings.TrimSpace(s.Kind))
+	if !strings.HasPrefix(kind, "STATUS_KIND_") {
+		kind = "STATUS_KIND_" + kind
+	}
+	return statusthingv1.StatusKind(statusthingv1.StatusKind_value[kind])
+}
+
+// Load reads a [Page] from the yaml or json file at the provided path
+func Load(path string) (*Page, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, serrors.NewWrappedError("page config", serrors.ErrNotFound, err)
+	}
+	return Parse(b)
+}
+
+// Parse reads a [Page] from the provided yaml or json and validates it
+func Parse(data []byte) (*Page, error) {
+	page := &Page{}
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(page); err != nil && !errors.Is(err, io.EOF) {
+		return nil, serrors.NewWrappedError("page config", serrors.ErrInvalidData, err)
+	}
+	if err := page.Validate(); err != nil {
+		return nil, err
+	}
+	return page, nil
+}
+
+// Validate checks that every status and item has a unique name, every status has a known kind
+// and every group is another item in the page without creating a cycle
+func (p *Page) Validate() error {
+	statuses := map[string]bool{}
+	for _, status := range p.Statuses {
+		if !validation.ValidString(status.Name) {
+			return serrors.NewError("status name", serrors.ErrEmptyString)
+		}
+		if statuses[status.Name] {
+			return serrors.NewError("status "+status.Name, serrors.ErrAlreadySet)
+		}
+		statuses[status.Name] = true
+		if status.StatusKind() == statusthingv1.StatusKind_STATUS_KIND_UNKNOWN {
+			return serrors.NewError(fmt.Sprintf("status %s kind %q", status.Name, status.Kind), serrors.ErrEmptyEnum)
+		}
+	}
+	groups := map[string]string{}
+	for _, item := range p.Items {
+		if !validation.ValidString(item.Name) {
+			return serrors.NewError("item name", serrors.ErrEmptyString)
+		}
+		if _, ok := groups[item.Name]; ok {
+			return serrors.NewError("item "+item.Name, serrors.ErrAlreadySet)
+		}
+		groups[item.Name] = item.Group
+	}
+	for _, item := range p.Items {
+		if item.Group == "" {
+			continue
+		}
+		if _, ok := groups[item.Group]; !ok {
+			return serrors.NewError(fmt.Sprintf("item %s group %s", item.Name, item.Group), serrors.ErrNotFound)
+		}
+		// walk up the groups. more steps than items means we're going in circles
+		group := item.Group
+		for i := 0; group != ""; i++ {
+			if group == item.Name || i > len(groups) {
+				return serrors.NewError("item "+item.Name+" group", serrors.ErrCycle)
+			}
+			group = groups[group]
+		}
+	}
+	return nil
+}
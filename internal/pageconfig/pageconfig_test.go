@@ -0,0 +1,86 @@
+package pageconfig
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	statusthingv1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
+	"github.com/lusis/statusthing/internal/serrors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	testCases := map[string]struct {
+		data string
+		err  error
+	}{
+		"happy-path": {
+			data: "statuses:\n  - name: up\n    kind: up\nitems:\n  - name: api\n    group: platform\n    status: up\n  - name: platform\n",
+		},
+		"json": {
+			data: `{"statuses":[{"name":"up","kind":"STATUS_KIND_UP"}]}`,
+		},
+		"empty": {},
+		"unknown-field": {
+			data: "items:\n  - name: api\n    colour: red\n",
+			err:  serrors.ErrInvalidData,
+		},
+		"missing-status-name": {
+			data: "statuses:\n  - kind: up\n",
+			err:  serrors.ErrEmptyString,
+		},
+		"unknown-kind": {
+			data: "statuses:\n  - name: up\n    kind: sideways\n",
+			err:  serrors.ErrEmptyEnum,
+		},
+		"duplicate-status": {
+			data: "statuses:\n  - name: up\n    kind: up\n  - name: up\n    kind: down\n",
+			err:  serrors.ErrAlreadySet,
+		},
+		"missing-item-name": {
+			data: "items:\n  - description: nameless\n",
+			err:  serrors.ErrEmptyString,
+		},
+		"duplicate-item": {
+			data: "items:\n  - name: api\n  - name: api\n",
+			err:  serrors.ErrAlreadySet,
+		},
+		"unknown-group": {
+			data: "items:\n  - name: api\n    group: missing\n",
+			err:  serrors.ErrNotFound,
+		},
+		"self-group": {
+			data: "items:\n  - name: api\n    group: api\n",
+			err:  serrors.ErrCycle,
+		},
+		"group-cycle": {
+			data: "items:\n  - name: a\n    group: b\n  - name: b\n    group: c\n  - name: c\n    group: a\n",
+			err:  serrors.ErrCycle,
+		},
+	}
+	for n, tc := range testCases {
+		t.Run(n, func(t *testing.T) {
+			page, err := Parse([]byte(tc.data))
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				require.Nil(t, page)
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, page)
+		})
+	}
+}
+
+func TestLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "page.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("statuses:\n  - name: up\n    kind: Up\n"), 0o600))
+	page, err := Load(path)
+	require.NoError(t, err)
+	require.Len(t, page.Statuses, 1)
+	require.Equal(t, statusthingv1.StatusKind_STATUS_KIND_UP, page.Statuses[0].StatusKind())
+
+	_, err = Load(filepath.Join(t.TempDir(), "missing.yaml"))
+	require.ErrorIs(t, err, serrors.ErrNotFound)
+}
@@ -0,0 +1,47 @@
+package serrors
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// BatchError is returned by batch operations and holds the error for each failed entry
+// it wraps [ErrBatchFailed] along with every entry error
+type BatchError struct {
+	// Errors maps the id of each failed entry to its error
+	Errors map[string]error
+}
+
+// NewBatchError returns a [BatchError] for the provided entry errors
+func NewBatchError(errs map[string]error) *BatchError {
+	return &BatchError{Errors: errs}
+}
+
+// IDs returns the ids of the failed entries in sorted order
+func (e *BatchError) IDs() []string {
+	ids := make([]string, 0, len(e.Errors))
+	for id := range e.Errors {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// Error satisfies the error interface
+func (e *BatchError) Error() string {
+	msgs := []string{}
+	for _, id := range e.IDs() {
+		msgs = append(msgs, fmt.Sprintf("%s: %s", id, e.Errors[id]))
+	}
+	return fmt.Sprintf("%s: %s", ErrBatchFailed, strings.Join(msgs, "; "))
+}
+
+// Unwrap allows [errors.Is] and [errors.As] to match [ErrBatchFailed] and any entry error
+func (e *BatchError) Unwrap() []error {
+	errs := []error{ErrBatchFailed}
+	for _, id := range e.IDs() {
+		errs = append(errs, e.Errors[id])
+	}
+	return errs
+}
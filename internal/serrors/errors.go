@@ -59,3 +59,30 @@ var ErrDependencyMissing = fmt.Errorf("required dependency failed")
 
 // ErrInvalidPassword is the error when a password is invalid
 var ErrInvalidPassword = fmt.Errorf("invalid password")
+
+// ErrCycle is the error when a relationship would create a cycle
+// this error is generally returned when setting the parent of an item to itself or one of its descendants
+var ErrCycle = fmt.Errorf("cycle detected")
+
+// ErrBatchFailed is the error when one or more entries in a batch operation fail
+// no changes are made when this error is returned
+var ErrBatchFailed = fmt.Errorf("batch failed")
+
+// ErrConflict is the error when a record was changed by someone else
+// this error is generally returned when the expected version of a record does not match the stored version
+var ErrConflict = fmt.Errorf("conflict")
+
+// ErrUnknownField is the error when a field name is not known or cannot be changed
+// this error is generally returned when an update mask contains an unsupported path
+var ErrUnknownField = fmt.Errorf("unknown field")
+
+// ErrIdempotencyMismatch is the error when an idempotency key is reused with a different request
+var ErrIdempotencyMismatch = fmt.Errorf("idempotency key used with a different request")
+
+// ErrTooLarge is the error when a value is larger than allowed
+// this error is generally returned when an uploaded attachment is over the size limit
+var ErrTooLarge = fmt.Errorf("too large")
+
+// ErrUnsupportedType is the error when a content type is not allowed
+// this error is generally returned when an uploaded attachment is not one of the allowed types
+var ErrUnsupportedType = fmt.Errorf("unsupported type")
@@ -0,0 +1,351 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/segmentio/ksuid"
+
+	statusthingv1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
+	"github.com/lusis/statusthing/internal/filters"
+	"github.com/lusis/statusthing/internal/pageconfig"
+	"github.com/lusis/statusthing/internal/serrors"
+	"github.com/lusis/statusthing/internal/storers"
+)
+
+// PlanAction is what a [PlanChange] does
+type PlanAction string
+
+const (
+	// PlanCreate creates a record
+	PlanCreate PlanAction = "create"
+	// PlanUpdate updates an existing record
+	PlanUpdate PlanAction = "update"
+	// PlanDelete deletes an existing record
+	PlanDelete PlanAction = "delete"
+)
+
+const (
+	planKindStatus = "status"
+	planKindItem   = "item"
+)
+
+// PlanChange is a single change needed to converge the store on a [pageconfig.Page]
+type PlanChange struct {
+	// Action is what the change does
+	Action PlanAction
+	// Kind is either status or item
+	Kind string
+	// Name is the name of the record
+	Name string
+	// ID is the id of the existing record for updates and deletes
+	ID string
+	// Version is the version of the existing record the update was planned against
+	Version uint64
+	// Fields are the names of the fields an update changes
+	Fields []string
+
+	status *pageconfig.Status
+	item   *pageconfig.Item
+}
+
+// Plan is the list of changes needed to converge the store on a [pageconfig.Page]
+// changes are in the order they are applied
+type Plan struct {
+	Changes []*PlanChange
+}
+
+// Empty is true when the store already matches the page
+func (p *Plan) Empty() bool {
+	return p == nil || len(p.Changes) == 0
+}
+
+// String returns the plan with one change per line
+func (p *Plan) String() string {
+	if p.Empty() {
+		return "no changes\n"
+	}
+	var sb strings.Builder
+	symbols := map[PlanAction]string{PlanCreate: "+", PlanUpdate: "~", PlanDelete: "-"}
+	for _, c := range p.Changes {
+		fmt.Fprintf(&sb, "%s %s %s", symbols[c.Action], c.Kind, c.Name)
+		if len(c.Fields) != 0 {
+			fmt.Fprintf(&sb, " (%s)", strings.Join(c.Fields, ", "))
+		}
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
+// PlanPage compares the provided [pageconfig.Page] with the store and returns the [Plan] needed to converge on it
+// when prune is true, statuses and items not in the page are deleted. statuses still used by an item are kept
+func (sts *StatusThingService) PlanPage(ctx context.Context, page *pageconfig.Page, prune bool) (*Plan, error) {
+	if sts.store == nil {
+		return nil, serrors.NewError("store", serrors.ErrStoreUnavailable)
+	}
+	if page == nil {
+		return nil, serrors.NewError("page", serrors.ErrNilVal)
+	}
+	if err := page.Validate(); err != nil {
+		return nil, err
+	}
+	statuses, err := sts.store.FindStatus(ctx)
+	if err != nil {
+		return nil, err
+	}
+	items, err := sts.store.FindItems(ctx)
+	if err != nil {
+		return nil, err
+	}
+	statusesByName, err := statusesByName(statuses)
+	if err != nil {
+		return nil, err
+	}
+	itemsByName, err := itemsByName(items)
+	if err != nil {
+		return nil, err
+	}
+	itemNames := map[string]string{}
+	for _, item := range items {
+		itemNames[item.GetId()] = item.GetName()
+	}
+
+	plan := &Plan{}
+	for _, desired := range page.Statuses {
+		existing, ok := statusesByName[desired.Name]
+		if !ok {
+			plan.Changes = append(plan.Changes, &PlanChange{Action: PlanCreate, Kind: planKindStatus, Name: desired.Name, status: desired})
+			continue
+		}
+		fields := []string{}
+		if existing.GetKind() != desired.StatusKind() {
+			fields = append(fields, "kind")
+		}
+		if existing.GetDescription() != desired.Description {
+			fields = append(fields, "description")
+		}
+		if existing.GetColor() != desiredColor(desired) {
+			fields = append(fields, "color")
+		}
+		if len(fields) != 0 {
+			plan.Changes = append(plan.Changes, &PlanChange{
+				Action: PlanUpdate, Kind: planKindStatus, Name: desired.Name,
+				ID: existing.GetId(), Version: existing.GetVersion(), Fields: fields, status: desired,
+			})
+		}
+	}
+
+	declaredStatuses := map[string]bool{}
+	for _, status := range page.Statuses {
+		declaredStatuses[status.Name] = true
+	}
+	for _, desired := range groupsFirst(page.Items) {
+		if desired.Status != "" && !declaredStatuses[desired.Status] {
+			if _, ok := statusesByName[desired.Status]; !ok {
+				return nil, serrors.NewError(fmt.Sprintf("item %s status %s", desired.Name, desired.Status), serrors.ErrNotFound)
+			}
+		}
+		existing, ok := itemsByName[desired.Name]
+		if !ok {
+			plan.Changes = append(plan.Changes, &PlanChange{Action: PlanCreate, Kind: planKindItem, Name: desired.Name, item: desired})
+			continue
+		}
+		fields := []string{}
+		if existing.GetDescription() != desired.Description {
+			fields = append(fields, "description")
+		}
+		if itemNames[existing.GetParentId()] != desired.Group {
+			fields = append(fields, "group")
+		}
+		if existing.GetStatus() == nil && desired.Status != "" {
+			fields = append(fields, "status")
+		}
+		if len(fields) != 0 {
+			plan.Changes = append(plan.Changes, &PlanChange{
+				Action: PlanUpdate, Kind: planKindItem, Name: desired.Name,
+				ID: existing.GetId(), Version: existing.GetVersion(), Fields: fields, item: desired,
+			})
+		}
+	}
+
+	if !prune {
+		return plan, nil
+	}
+	declaredItems := map[string]bool{}
+	for _, item := range page.Items {
+		declaredItems[item.Name] = true
+	}
+	inUse := map[string]bool{}
+	for _, item := range page.Items {
+		inUse[item.Status] = true
+	}
+	for _, item := range items {
+		if !declaredItems[item.GetName()] {
+			plan.Changes = append(plan.Changes, &PlanChange{Action: PlanDelete, Kind: planKindItem, Name: item.GetName(), ID: item.GetId()})
+			continue
+		}
+		if item.GetStatus() != nil {
+			inUse[item.GetStatus().GetName()] = true
+		}
+	}
+	for _, status := range statuses {
+		if declaredStatuses[status.GetName()] || inUse[status.GetName()] {
+			continue
+		}
+		plan.Changes = append(plan.Changes, &PlanChange{Action: PlanDelete, Kind: planKindStatus, Name: status.GetName(), ID: status.GetId()})
+	}
+	return plan, nil
+}
+
+// ApplyPlan applies a [Plan] from [StatusThingService.PlanPage] in a single transaction
+// [serrors.ErrConflict] is returned if a record was updated after the plan was made
+func (sts *StatusThingService) ApplyPlan(ctx context.Context, plan *Plan) error {
+	if sts.store == nil {
+		return serrors.NewError("store", serrors.ErrStoreUnavailable)
+	}
+	if plan.Empty() {
+		return nil
+	}
+	return sts.withTx(ctx, func(store storers.StatusThingStorer) error {
+		// names are resolved to ids as we go so new items can be grouped under new items
+		statuses, err := store.FindStatus(ctx)
+		if err != nil {
+			return err
+		}
+		statusIDs := map[string]string{}
+		for _, status := range statuses {
+			statusIDs[status.GetName()] = status.GetId()
+		}
+		items, err := store.FindItems(ctx)
+		if err != nil {
+			return err
+		}
+		itemIDs := map[string]string{}
+		for _, item := range items {
+			itemIDs[item.GetName()] = item.GetId()
+		}
+
+		for _, c := range plan.Changes {
+			var err error
+			switch {
+			case c.Kind == planKindStatus && c.Action == PlanCreate:
+				status := &statusthingv1.Status{
+					Id:          ksuid.New().String(),
+					Name:        c.status.Name,
+					Kind:        c.status.StatusKind(),
+					Description: c.status.Description,
+					Color:       desiredColor(c.status),
+					Timestamps:  makeTsNow(),
+				}
+				_, err = store.StoreStatus(ctx, status)
+				statusIDs[status.GetName()] = status.GetId()
+			case c.Kind == planKindStatus && c.Action == PlanUpdate:
+				opts := []filters.FilterOption{
+					filters.WithExpectedVersion(c.Version),
+					filters.WithStatusKind(c.status.StatusKind()),
+					filters.WithColor(desiredColor(c.status)),
+				}
+				if c.status.Description != "" {
+					opts = append(opts, filters.WithDescription(c.status.Description))
+				} else {
+					opts = append(opts, filters.WithClearedFields("description"))
+				}
+				err = store.UpdateStatus(ctx, c.ID, opts...)
+			case c.Kind == planKindItem && c.Action == PlanCreate:
+				item := &statusthingv1.Item{
+					Id:          ksuid.New().String(),
+					Name:        c.item.Name,
+					Description: c.item.Description,
+					ParentId:    itemIDs[c.item.Group],
+					Timestamps:  makeTsNow(),
+				}
+				if c.item.Status != "" {
+					item.Status = &statusthingv1.Status{Id: statusIDs[c.item.Status]}
+				}
+				_, err = store.StoreItem(ctx, item)
+				itemIDs[item.GetName()] = item.GetId()
+			case c.Kind == planKindItem && c.Action == PlanUpdate:
+				opts := []filters.FilterOption{filters.WithExpectedVersion(c.Version)}
+				cleared := []string{}
+				if c.item.Description != "" {
+					opts = append(opts, filters.WithDescription(c.item.Description))
+				} else {
+					cleared = append(cleared, "description")
+				}
+				if c.item.Group != "" {
+					opts = append(opts, filters.WithParentID(itemIDs[c.item.Group]))
+				} else {
+					cleared = append(cleared, "parent_id")
+				}
+				for _, field := range c.Fields {
+					if field == "status" {
+						opts = append(opts, filters.WithStatusID(statusIDs[c.item.Status]))
+					}
+				}
+				if len(cleared) != 0 {
+					opts = append(opts, filters.WithClearedFields(cleared...))
+				}
+				err = store.UpdateItem(ctx, c.ID, opts...)
+			case c.Kind == planKindItem && c.Action == PlanDelete:
+				err = store.DeleteItem(ctx, c.ID)
+			case c.Kind == planKindStatus && c.Action == PlanDelete:
+				err = store.DeleteStatus(ctx, c.ID)
+			default:
+				err = serrors.NewError(fmt.Sprintf("%s %s", c.Action, c.Kind), serrors.ErrNotImplemented)
+			}
+			if err != nil {
+				return fmt.Errorf("%s %s %s: %w", c.Action, c.Kind, c.Name, err)
+			}
+		}
+		return nil
+	})
+}
+
+// desiredColor is the color a status will have. statuses without a color get the same default as [StatusThingService.AddStatus]
+func desiredColor(status *pageconfig.Status) string {
+	if status.Color == "" {
+		return defaultColor
+	}
+	return status.Color
+}
+
+// groupsFirst orders items so every item comes after its group
+// [pageconfig.Page.Validate] ensures groups don't form a cycle
+func groupsFirst(items []*pageconfig.Item) []*pageconfig.Item {
+	sorted := make([]*pageconfig.Item, 0, len(items))
+	placed := map[string]bool{}
+	for len(sorted) < len(items) {
+		for _, item := range items {
+			if !placed[item.Name] && (item.Group == "" || placed[item.Group]) {
+				sorted = append(sorted, item)
+				placed[item.Name] = true
+			}
+		}
+	}
+	return sorted
+}
+
+func statusesByName(statuses []*statusthingv1.Status) (map[string]*statusthingv1.Status, error) {
+	res := map[string]*statusthingv1.Status{}
+	for _, status := range statuses {
+		if _, ok := res[status.GetName()]; ok {
+			// names are how the page identifies statuses so they have to be unique
+			return nil, serrors.NewError("status "+status.GetName(), serrors.ErrAlreadySet)
+		}
+		res[status.GetName()] = status
+	}
+	return res, nil
+}
+
+func itemsByName(items []*statusthingv1.Item) (map[string]*statusthingv1.Item, error) {
+	res := map[string]*statusthingv1.Item{}
+	for _, item := range items {
+		if _, ok := res[item.GetName()]; ok {
+			// names are how the page identifies items so they have to be unique
+			return nil, serrors.NewError("item "+item.GetName(), serrors.ErrAlreadySet)
+		}
+		res[item.GetName()] = item
+	}
+	return res, nil
+}
@@ -0,0 +1,118 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	statusthingv1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
+	"github.com/lusis/statusthing/internal/filters"
+	"github.com/lusis/statusthing/internal/pageconfig"
+	"github.com/lusis/statusthing/internal/serrors"
+	"github.com/lusis/statusthing/internal/storers/memdb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyPage(t *testing.T) {
+	ctx := context.TODO()
+	page, err := pageconfig.Parse([]byte(`
+statuses:
+  - name: Operational
+    kind: up
+    color: green
+  - name: Outage
+    kind: STATUS_KIND_DOWN
+items:
+  - name: api
+    description: the api
+    group: platform
+    status: Operational
+  - name: platform
+    status: Operational
+`))
+	require.NoError(t, err)
+
+	store, err := memdb.New()
+	require.NoError(t, err)
+	sts, err := NewStatusThingService(store)
+	require.NoError(t, err)
+
+	plan, err := sts.PlanPage(ctx, page, false)
+	require.NoError(t, err)
+	require.Equal(t, "+ status Operational\n+ status Outage\n+ item platform\n+ item api\n", plan.String())
+	require.NoError(t, sts.ApplyPlan(ctx, plan))
+
+	items, err := sts.FindItems(ctx)
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+	ids := map[string]*statusthingv1.Item{}
+	for _, item := range items {
+		ids[item.GetName()] = item
+	}
+	require.Equal(t, ids["platform"].GetId(), ids["api"].GetParentId())
+	require.Equal(t, "Operational", ids["api"].GetStatus().GetName())
+	require.Equal(t, "the api", ids["api"].GetDescription())
+
+	t.Run("converged", func(t *testing.T) {
+		plan, err := sts.PlanPage(ctx, page, true)
+		require.NoError(t, err)
+		require.True(t, plan.Empty(), plan.String())
+	})
+	t.Run("status-changes-are-kept", func(t *testing.T) {
+		statuses, err := sts.FindStatus(ctx)
+		require.NoError(t, err)
+		for _, status := range statuses {
+			if status.GetName() == "Outage" {
+				require.NoError(t, sts.EditItem(ctx, ids["api"].GetId(), filters.WithStatusID(status.GetId())))
+			}
+		}
+		plan, err := sts.PlanPage(ctx, page, false)
+		require.NoError(t, err)
+		require.True(t, plan.Empty(), plan.String())
+	})
+	t.Run("update-and-prune", func(t *testing.T) {
+		_, err := sts.AddItem(ctx, "extra")
+		require.NoError(t, err)
+		_, err = sts.AddStatus(ctx, "unused", statusthingv1.StatusKind_STATUS_KIND_WARNING)
+		require.NoError(t, err)
+		changed := &pageconfig.Page{
+			Statuses: page.Statuses,
+			Items: []*pageconfig.Item{
+				{Name: "api", Description: "new description"},
+				{Name: "platform", Status: "Operational"},
+			},
+		}
+
+		plan, err := sts.PlanPage(ctx, changed, false)
+		require.NoError(t, err)
+		require.Equal(t, "~ item api (description, group)\n", plan.String())
+
+		plan, err = sts.PlanPage(ctx, changed, true)
+		require.NoError(t, err)
+		require.Equal(t, "~ item api (description, group)\n- item extra\n- status unused\n", plan.String())
+		require.NoError(t, sts.ApplyPlan(ctx, plan))
+
+		api, err := sts.GetItem(ctx, ids["api"].GetId())
+		require.NoError(t, err)
+		require.Equal(t, "new description", api.GetDescription())
+		require.Empty(t, api.GetParentId())
+		require.Equal(t, "Outage", api.GetStatus().GetName(), "status should not be reset")
+		items, err := sts.FindItems(ctx)
+		require.NoError(t, err)
+		require.Len(t, items, 2)
+
+		plan, err = sts.PlanPage(ctx, changed, true)
+		require.NoError(t, err)
+		require.True(t, plan.Empty(), plan.String())
+	})
+	t.Run("stale-plan", func(t *testing.T) {
+		changed := &pageconfig.Page{Statuses: page.Statuses, Items: []*pageconfig.Item{{Name: "api", Description: "stale"}, {Name: "platform"}}}
+		plan, err := sts.PlanPage(ctx, changed, false)
+		require.NoError(t, err)
+		require.NoError(t, sts.EditItem(ctx, ids["api"].GetId(), filters.WithDescription("changed since")))
+		require.ErrorIs(t, sts.ApplyPlan(ctx, plan), serrors.ErrConflict)
+	})
+	t.Run("unknown-status", func(t *testing.T) {
+		_, err := sts.PlanPage(ctx, &pageconfig.Page{Items: []*pageconfig.Item{{Name: "api", Status: "missing"}}}, false)
+		require.ErrorIs(t, err, serrors.ErrNotFound)
+	})
+}
@@ -0,0 +1,244 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"hash"
+	"io"
+	"mime"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/segmentio/ksuid"
+
+	statusthingv1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
+	"github.com/lusis/statusthing/internal/serrors"
+	"github.com/lusis/statusthing/internal/validation"
+)
+
+const (
+	// DefaultAttachmentMaxSize is the largest attachment accepted unless changed with [WithAttachmentLimits]
+	DefaultAttachmentMaxSize int64 = 5 << 20
+	// sniffLen is how much of a file is read to detect its content type
+	sniffLen = 512
+)
+
+// DefaultAttachmentTypes are the content types accepted unless changed with [WithAttachmentLimits]
+var DefaultAttachmentTypes = []string{"image/png", "image/jpeg", "image/gif", "image/webp", "application/pdf", "text/plain"}
+
+// AddAttachment attaches the file read from the provided reader to the [statusthingv1.Note] with the provided id
+// the content type is detected from the file itself and must be one of the allowed types
+// [serrors.ErrTooLarge] is returned if the file is over the size limit and [serrors.ErrUnsupportedType] if its type isn't allowed
+func (sts *StatusThingService) AddAttachment(ctx context.Context, noteID, filename string, r io.Reader) (*statusthingv1.Attachment, error) {
+	if sts.store == nil {
+		return nil, serrors.NewError("store", serrors.ErrStoreUnavailable)
+	}
+	if sts.blobs == nil {
+		return nil, serrors.NewError("blob store", serrors.ErrNotImplemented)
+	}
+	if !validation.ValidString(noteID) {
+		return nil, serrors.NewError("noteID", serrors.ErrEmptyString)
+	}
+	filename = cleanFilename(filename)
+	if filename == "" {
+		return nil, serrors.NewError("filename", serrors.ErrEmptyString)
+	}
+	if r == nil {
+		return nil, serrors.NewError("file", serrors.ErrNilVal)
+	}
+	if _, err := sts.store.GetNote(ctx, noteID); err != nil {
+		return nil, err
+	}
+
+	head := make([]byte, sniffLen)
+	n, err := io.ReadFull(r, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return nil, serrors.NewWrappedError("file", serrors.ErrInvalidData, err)
+	}
+	if n == 0 {
+		return nil, serrors.NewError("file", serrors.ErrAtLeastOne)
+	}
+	contentType := sniffContentType(head[:n])
+	if !sts.allowedAttachmentType(contentType) {
+		return nil, serrors.NewError(contentType, serrors.ErrUnsupportedType)
+	}
+
+	// uploads hold the read lock so the blob pruner never sees a blob before its attachment is stored
+	sts.attachmentsMu.RLock()
+	defer sts.attachmentsMu.RUnlock()
+	id := ksuid.New().String()
+	body := &limitedReader{r: io.MultiReader(bytes.NewReader(head[:n]), r), remaining: sts.attachmentMaxSize, hash: sha256.New()}
+	if err := sts.blobs.PutBlob(ctx, id, body); err != nil {
+		if body.exceeded {
+			return nil, serrors.NewError("file", serrors.ErrTooLarge)
+		}
+		return nil, err
+	}
+	att, err := sts.store.StoreAttachment(ctx, &statusthingv1.Attachment{
+		Id:          id,
+		NoteId:      noteID,
+		Filename:    filename,
+		ContentType: contentType,
+		Size:        body.read,
+		Sha256:      hex.EncodeToString(body.hash.Sum(nil)),
+		Timestamps:  makeTsNow(),
+	})
+	if err != nil {
+		return nil, errors.Join(err, sts.blobs.DeleteBlob(ctx, id))
+	}
+	return att, nil
+}
+
+// GetAttachment gets a [statusthingv1.Attachment] by id
+func (sts *StatusThingService) GetAttachment(ctx context.Context, attachmentID string) (*statusthingv1.Attachment, error) {
+	if sts.store == nil {
+		return nil, serrors.NewError("store", serrors.ErrStoreUnavailable)
+	}
+	if !validation.ValidString(attachmentID) {
+		return nil, serrors.NewError("attachmentID", serrors.ErrEmptyString)
+	}
+	return sts.store.GetAttachment(ctx, attachmentID)
+}
+
+// OpenAttachment gets a [statusthingv1.Attachment] by id along with a reader for its contents
+// callers must close the reader
+func (sts *StatusThingService) OpenAttachment(ctx context.Context, attachmentID string) (*statusthingv1.Attachment, io.ReadCloser, error) {
+	if sts.blobs == nil {
+		return nil, nil, serrors.NewError("blob store", serrors.ErrNotImplemented)
+	}
+	att, err := sts.GetAttachment(ctx, attachmentID)
+	if err != nil {
+		return nil, nil, err
+	}
+	rc, err := sts.blobs.GetBlob(ctx, att.GetId())
+	if err != nil {
+		return nil, nil, err
+	}
+	return att, rc, nil
+}
+
+// ListAttachments returns every [statusthingv1.Attachment] of the [statusthingv1.Note] with the provided id, oldest first
+func (sts *StatusThingService) ListAttachments(ctx context.Context, noteID string) ([]*statusthingv1.Attachment, error) {
+	if sts.store == nil {
+		return nil, serrors.NewError("store", serrors.ErrStoreUnavailable)
+	}
+	if !validation.ValidString(noteID) {
+		return nil, serrors.NewError("noteID", serrors.ErrEmptyString)
+	}
+	if _, err := sts.store.GetNote(ctx, noteID); err != nil {
+		return nil, err
+	}
+	return sts.store.FindAttachments(ctx, noteID)
+}
+
+// RemoveAttachment removes the [statusthingv1.Attachment] with the provided id along with its contents
+func (sts *StatusThingService) RemoveAttachment(ctx context.Context, attachmentID string) error {
+	if sts.store == nil {
+		return serrors.NewError("store", serrors.ErrStoreUnavailable)
+	}
+	if !validation.ValidString(attachmentID) {
+		return serrors.NewError("attachmentID", serrors.ErrEmptyString)
+	}
+	if err := sts.store.DeleteAttachment(ctx, attachmentID); err != nil {
+		return err
+	}
+	if sts.blobs == nil {
+		return nil
+	}
+	// a blob left behind is removed by the pruner so there is nothing to undo here
+	if err := sts.blobs.DeleteBlob(ctx, attachmentID); err != nil && !errors.Is(err, serrors.ErrNotFound) {
+		return err
+	}
+	return nil
+}
+
+// pruneBlobs removes every blob without an attachment, such as those of deleted notes, and returns how many there were
+// when dryRun is true nothing is removed
+func (sts *StatusThingService) pruneBlobs(ctx context.Context, dryRun bool) (int, error) {
+	if sts.blobs == nil {
+		return 0, nil
+	}
+	sts.attachmentsMu.Lock()
+	defer sts.attachmentsMu.Unlock()
+	keys, err := sts.blobs.ListBlobs(ctx)
+	if err != nil {
+		return 0, err
+	}
+	pruned := 0
+	for _, key := range keys {
+		exists, err := sts.store.AttachmentExists(ctx, key)
+		if err != nil {
+			return pruned, err
+		}
+		if exists {
+			continue
+		}
+		if !dryRun {
+			if err := sts.blobs.DeleteBlob(ctx, key); err != nil && !errors.Is(err, serrors.ErrNotFound) {
+				return pruned, err
+			}
+		}
+		pruned++
+	}
+	return pruned, nil
+}
+
+func (sts *StatusThingService) allowedAttachmentType(contentType string) bool {
+	for _, allowed := range sts.attachmentTypes {
+		if contentType == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+// IsImage is true when the [statusthingv1.Attachment] can be shown inline as an image
+func IsImage(att *statusthingv1.Attachment) bool {
+	return strings.HasPrefix(att.GetContentType(), "image/")
+}
+
+// sniffContentType returns the media type of the provided data without any parameters
+func sniffContentType(data []byte) string {
+	mediaType, _, err := mime.ParseMediaType(http.DetectContentType(data))
+	if err != nil {
+		return "application/octet-stream"
+	}
+	return mediaType
+}
+
+// cleanFilename returns the last element of the provided filename or an empty string if there isn't one
+func cleanFilename(filename string) string {
+	filename = path.Base(strings.ReplaceAll(strings.TrimSpace(filename), `\`, "/"))
+	if filename == "." || filename == "/" || filename == ".." {
+		return ""
+	}
+	return filename
+}
+
+// limitedReader reads at most remaining bytes, failing with [serrors.ErrTooLarge] past that, and hashes what it reads
+type limitedReader struct {
+	r         io.Reader
+	remaining int64
+	read      int64
+	exceeded  bool
+	hash      hash.Hash
+}
+
+func (l *limitedReader) Read(p []byte) (int, error) {
+	if int64(len(p)) > l.remaining+1 {
+		p = p[:l.remaining+1]
+	}
+	n, err := l.r.Read(p)
+	if int64(n) > l.remaining {
+		l.exceeded = true
+		return 0, serrors.NewError("file", serrors.ErrTooLarge)
+	}
+	l.remaining -= int64(n)
+	l.read += int64(n)
+	l.hash.Write(p[:n])
+	return n, err
+}
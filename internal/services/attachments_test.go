@@ -0,0 +1,109 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/lusis/statusthing/internal/serrors"
+	"github.com/lusis/statusthing/internal/storers/blobdir"
+	"github.com/lusis/statusthing/internal/storers/memdb"
+	"github.com/stretchr/testify/require"
+)
+
+// pngData is enough of a png for its content type to be detected
+var pngData = append([]byte("\x89PNG\r\n\x1a\n"), bytes.Repeat([]byte{0}, 64)...)
+
+func TestAttachments(t *testing.T) {
+	ctx := context.TODO()
+	blobStores := map[string]func(t *testing.T) []ServiceOption{
+		"db": func(t *testing.T) []ServiceOption { return nil },
+		"dir": func(t *testing.T) []ServiceOption {
+			blobs, err := blobdir.New(t.TempDir())
+			require.NoError(t, err)
+			return []ServiceOption{WithBlobStorer(blobs)}
+		},
+	}
+	for n, blobOpts := range blobStores {
+		t.Run(n, func(t *testing.T) {
+			store, err := memdb.New()
+			require.NoError(t, err)
+			sts, err := NewStatusThingService(store, append(blobOpts(t), WithAttachmentLimits(100, "image/png", "text/plain"))...)
+			require.NoError(t, err)
+			item, err := sts.AddItem(ctx, t.Name())
+			require.NoError(t, err)
+			note, err := sts.AddNote(ctx, item.GetId(), "see the graph")
+			require.NoError(t, err)
+
+			att, err := sts.AddAttachment(ctx, note.GetId(), `C:\screenshots\graph.png`, bytes.NewReader(pngData))
+			require.NoError(t, err)
+			require.Equal(t, "graph.png", att.GetFilename(), "only the base name should be kept")
+			require.Equal(t, "image/png", att.GetContentType())
+			require.Equal(t, int64(len(pngData)), att.GetSize())
+			sum := sha256.Sum256(pngData)
+			require.Equal(t, hex.EncodeToString(sum[:]), att.GetSha256())
+			require.True(t, IsImage(att))
+
+			_, rc, err := sts.OpenAttachment(ctx, att.GetId())
+			require.NoError(t, err)
+			b, err := io.ReadAll(rc)
+			require.NoError(t, err)
+			require.NoError(t, rc.Close())
+			require.Equal(t, pngData, b)
+
+			text, err := sts.AddAttachment(ctx, note.GetId(), "log.txt", strings.NewReader("connection refused"))
+			require.NoError(t, err)
+			require.Equal(t, "text/plain", text.GetContentType(), "parameters should be dropped from the detected type")
+			require.False(t, IsImage(text))
+
+			_, err = sts.AddAttachment(ctx, note.GetId(), "page.html", strings.NewReader("<html><script>alert(1)</script></html>"))
+			require.ErrorIs(t, err, serrors.ErrUnsupportedType, "the declared name should not decide the type")
+			_, err = sts.AddAttachment(ctx, note.GetId(), "big.txt", strings.NewReader(strings.Repeat("a", 101)))
+			require.ErrorIs(t, err, serrors.ErrTooLarge)
+			_, err = sts.AddAttachment(ctx, note.GetId(), "empty.txt", strings.NewReader(""))
+			require.ErrorIs(t, err, serrors.ErrAtLeastOne)
+			_, err = sts.AddAttachment(ctx, "missing", "log.txt", strings.NewReader("x"))
+			require.ErrorIs(t, err, serrors.ErrNotFound)
+			_, err = sts.AddAttachment(ctx, note.GetId(), "..", strings.NewReader("x"))
+			require.ErrorIs(t, err, serrors.ErrEmptyString)
+
+			atts, err := sts.ListAttachments(ctx, note.GetId())
+			require.NoError(t, err)
+			require.Len(t, atts, 2, "rejected uploads should not be stored")
+			summary, err := sts.Prune(ctx, false)
+			require.NoError(t, err)
+			require.Zero(t, summary.Blobs, "rejected uploads should not leave blobs behind")
+
+			require.NoError(t, sts.RemoveAttachment(ctx, text.GetId()))
+			_, _, err = sts.OpenAttachment(ctx, text.GetId())
+			require.ErrorIs(t, err, serrors.ErrNotFound)
+
+			// deleting the note leaves the blob for the pruner
+			require.NoError(t, sts.RemoveNote(ctx, note.GetId()))
+			summary, err = sts.Prune(ctx, true)
+			require.NoError(t, err)
+			require.Equal(t, 1, summary.Blobs)
+			summary, err = sts.Prune(ctx, false)
+			require.NoError(t, err)
+			require.Equal(t, 1, summary.Blobs)
+			summary, err = sts.Prune(ctx, false)
+			require.NoError(t, err)
+			require.Zero(t, summary.Blobs)
+		})
+	}
+
+	t.Run("options", func(t *testing.T) {
+		store, err := memdb.New()
+		require.NoError(t, err)
+		_, err = NewStatusThingService(store, WithAttachmentLimits(0))
+		require.ErrorIs(t, err, serrors.ErrAtLeastOne)
+		_, err = NewStatusThingService(store, WithAttachmentLimits(1, "not a type/"))
+		require.ErrorIs(t, err, serrors.ErrInvalidData)
+		_, err = NewStatusThingService(store, WithBlobStorer(nil))
+		require.ErrorIs(t, err, serrors.ErrNilVal)
+	})
+}
@@ -0,0 +1,161 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"net/url"
+
+	// decoders for the image formats avatars can be uploaded as
+	_ "image/gif"
+	_ "image/jpeg"
+
+	v1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
+	"github.com/lusis/statusthing/internal/filters"
+	"github.com/lusis/statusthing/internal/serrors"
+	"github.com/lusis/statusthing/internal/validation"
+)
+
+const (
+	// AvatarSize is the width and height in pixels of a stored avatar
+	AvatarSize = 256
+	// AvatarMaxSize is the largest image in bytes that can be uploaded as an avatar
+	AvatarMaxSize int64 = 5 << 20
+	// avatarMaxDimension is the widest or tallest image that will be decoded
+	// this keeps a small file that decompresses to a huge image from using all of our memory
+	avatarMaxDimension = 4096
+)
+
+// SetAvatar stores the image read from r as the avatar of the user with the provided username
+// the image is cropped to the square at crop, in the image's pixels, then scaled to [AvatarSize] and stored as a png
+// an empty crop uses the largest square in the middle of the image
+// the user's avatar url is changed to [AvatarURL] so browsers fetch the new image
+func (sts *StatusThingService) SetAvatar(ctx context.Context, username string, r io.Reader, crop image.Rectangle) (*v1.User, error) {
+	if sts.store == nil {
+		return nil, serrors.NewError("store", serrors.ErrStoreUnavailable)
+	}
+	if !validation.ValidString(username) {
+		return nil, serrors.NewError("username", serrors.ErrEmptyString)
+	}
+	if r == nil {
+		return nil, serrors.NewError("reader", serrors.ErrNilVal)
+	}
+	if _, err := sts.store.GetUser(ctx, username); err != nil {
+		return nil, err
+	}
+	b, err := io.ReadAll(io.LimitReader(r, AvatarMaxSize+1))
+	if err != nil {
+		return nil, serrors.NewWrappedError("avatar", serrors.ErrInvalidData, err)
+	}
+	if len(b) == 0 {
+		return nil, serrors.NewError("avatar", serrors.ErrAtLeastOne)
+	}
+	if int64(len(b)) > AvatarMaxSize {
+		return nil, serrors.NewError("avatar", serrors.ErrTooLarge)
+	}
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(b))
+	if err != nil {
+		return nil, serrors.NewWrappedError("avatar", serrors.ErrUnsupportedType, err)
+	}
+	if cfg.Width > avatarMaxDimension || cfg.Height > avatarMaxDimension {
+		return nil, serrors.NewError("avatar dimensions", serrors.ErrTooLarge)
+	}
+	img, _, err := image.Decode(bytes.NewReader(b))
+	if err != nil {
+		return nil, serrors.NewWrappedError("avatar", serrors.ErrInvalidData, err)
+	}
+	square, err := avatarSquare(img.Bounds(), crop)
+	if err != nil {
+		return nil, err
+	}
+	buf := &bytes.Buffer{}
+	if err := png.Encode(buf, scaleImage(img, square, AvatarSize)); err != nil {
+		return nil, serrors.NewWrappedError("avatar", serrors.ErrUnrecoverable, err)
+	}
+	avatar := buf.Bytes()
+	if err := sts.EditUser(ctx, username, filters.WithAvatar(avatar), filters.WithAvatarURL(AvatarURL(username, avatar))); err != nil {
+		return nil, err
+	}
+	return sts.store.GetUser(ctx, username)
+}
+
+// GetAvatar gets the avatar image of the user with the provided username
+// avatars are always png images
+func (sts *StatusThingService) GetAvatar(ctx context.Context, username string) ([]byte, error) {
+	if sts.store == nil {
+		return nil, serrors.NewError("store", serrors.ErrStoreUnavailable)
+	}
+	if !validation.ValidString(username) {
+		return nil, serrors.NewError("username", serrors.ErrEmptyString)
+	}
+	return sts.store.GetAvatar(ctx, username)
+}
+
+// AvatarURL returns the path the admin site serves the provided avatar of the user at
+// the path changes along with the image so it can be cached
+func AvatarURL(username string, avatar []byte) string {
+	sum := sha256.Sum256(avatar)
+	return "/avatars/" + url.PathEscape(username) + "?v=" + hex.EncodeToString(sum[:6])
+}
+
+// avatarSquare returns the square of bounds to use as an avatar
+func avatarSquare(bounds image.Rectangle, crop image.Rectangle) (image.Rectangle, error) {
+	if crop.Empty() {
+		side := bounds.Dx()
+		if bounds.Dy() < side {
+			side = bounds.Dy()
+		}
+		corner := bounds.Min.Add(image.Pt((bounds.Dx()-side)/2, (bounds.Dy()-side)/2))
+		return image.Rectangle{Min: corner, Max: corner.Add(image.Pt(side, side))}, nil
+	}
+	crop = crop.Add(bounds.Min).Intersect(bounds)
+	side := crop.Dx()
+	if crop.Dy() < side {
+		side = crop.Dy()
+	}
+	if side < 1 {
+		return image.Rectangle{}, serrors.NewError("crop outside the image", serrors.ErrInvalidData)
+	}
+	return image.Rectangle{Min: crop.Min, Max: crop.Min.Add(image.Pt(side, side))}, nil
+}
+
+// scaleImage scales the square src of img to a size by size image
+// each pixel is the average of the pixels it covers in src
+func scaleImage(img image.Image, src image.Rectangle, size int) *image.RGBA {
+	dst := image.NewRGBA(image.Rect(0, 0, size, size))
+	side := src.Dx()
+	for y := 0; y < size; y++ {
+		y0 := src.Min.Y + y*side/size
+		y1 := src.Min.Y + (y+1)*side/size
+		if y1 <= y0 {
+			y1 = y0 + 1
+		}
+		for x := 0; x < size; x++ {
+			x0 := src.Min.X + x*side/size
+			x1 := src.Min.X + (x+1)*side/size
+			if x1 <= x0 {
+				x1 = x0 + 1
+			}
+			var r, g, b, a, n uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					cr, cg, cb, ca := img.At(sx, sy).RGBA()
+					r, g, b, a = r+uint64(cr), g+uint64(cg), b+uint64(cb), a+uint64(ca)
+					n++
+				}
+			}
+			dst.SetRGBA(x, y, color.RGBA{
+				R: uint8(r / n >> 8),
+				G: uint8(g / n >> 8),
+				B: uint8(b / n >> 8),
+				A: uint8(a / n >> 8),
+			})
+		}
+	}
+	return dst
+}
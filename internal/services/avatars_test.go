@@ -0,0 +1,92 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/lusis/statusthing/internal/serrors"
+	"github.com/lusis/statusthing/internal/storers/memdb"
+	"github.com/stretchr/testify/require"
+)
+
+// testImage returns a jpeg that is red on the left half and blue on the right half
+func testImage(t *testing.T, w, h int) []byte {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			c := color.RGBA{R: 255, A: 255}
+			if x >= w/2 {
+				c = color.RGBA{B: 255, A: 255}
+			}
+			img.SetRGBA(x, y, c)
+		}
+	}
+	buf := &bytes.Buffer{}
+	require.NoError(t, jpeg.Encode(buf, img, &jpeg.Options{Quality: 100}))
+	return buf.Bytes()
+}
+
+func TestAvatars(t *testing.T) {
+	store, err := memdb.New()
+	require.NoError(t, err)
+	svc, err := NewStatusThingService(store)
+	require.NoError(t, err)
+	ctx := context.TODO()
+	_, err = svc.AddUser(ctx, t.Name(), "password1", "test@test.com")
+	require.NoError(t, err)
+
+	_, err = svc.GetAvatar(ctx, t.Name())
+	require.ErrorIs(t, err, serrors.ErrNotFound)
+
+	testCases := map[string]struct {
+		crop image.Rectangle
+		// the color expected in the middle of the avatar
+		middle color.RGBA
+		err    error
+	}{
+		"center":       {middle: color.RGBA{B: 255, A: 255}},
+		"crop-left":    {crop: image.Rect(0, 0, 100, 100), middle: color.RGBA{R: 255, A: 255}},
+		"crop-right":   {crop: image.Rect(300, 0, 400, 100), middle: color.RGBA{B: 255, A: 255}},
+		"crop-clipped": {crop: image.Rect(350, 150, 450, 250), middle: color.RGBA{B: 255, A: 255}},
+		"crop-outside": {crop: image.Rect(500, 500, 600, 600), err: serrors.ErrInvalidData},
+	}
+	for n, tc := range testCases {
+		t.Run(n, func(t *testing.T) {
+			user, err := svc.SetAvatar(ctx, "TestAvatars", bytes.NewReader(testImage(t, 400, 200)), tc.crop)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			b, err := svc.GetAvatar(ctx, user.GetUsername())
+			require.NoError(t, err)
+			require.Equal(t, AvatarURL(user.GetUsername(), b), user.GetAvatarUrl())
+			img, err := png.Decode(bytes.NewReader(b))
+			require.NoError(t, err)
+			require.Equal(t, image.Rect(0, 0, AvatarSize, AvatarSize), img.Bounds())
+			r, g, bl, _ := img.At(AvatarSize*3/4, AvatarSize/2).RGBA()
+			require.InDelta(t, tc.middle.R, r>>8, 8)
+			require.InDelta(t, tc.middle.G, g>>8, 8)
+			require.InDelta(t, tc.middle.B, bl>>8, 8)
+		})
+	}
+
+	_, err = svc.SetAvatar(ctx, t.Name(), strings.NewReader("<svg></svg>"), image.Rectangle{})
+	require.ErrorIs(t, err, serrors.ErrUnsupportedType)
+	_, err = svc.SetAvatar(ctx, t.Name(), strings.NewReader(""), image.Rectangle{})
+	require.ErrorIs(t, err, serrors.ErrAtLeastOne)
+	_, err = svc.SetAvatar(ctx, t.Name(), bytes.NewReader(testImage(t, avatarMaxDimension+1, 1)), image.Rectangle{})
+	require.ErrorIs(t, err, serrors.ErrTooLarge)
+	_, err = svc.SetAvatar(ctx, "missing", bytes.NewReader(testImage(t, 10, 10)), image.Rectangle{})
+	require.ErrorIs(t, err, serrors.ErrNotFound)
+
+	small, err := svc.SetAvatar(ctx, t.Name(), bytes.NewReader(testImage(t, 10, 10)), image.Rectangle{})
+	require.NoError(t, err, "small images should be scaled up")
+	require.NotEmpty(t, small.GetAvatarUrl())
+}
@@ -0,0 +1,96 @@
+package services
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"golang.org/x/exp/slog"
+
+	"github.com/lusis/statusthing/internal/serrors"
+	"github.com/lusis/statusthing/internal/storers"
+)
+
+// DefaultBackupRetention is how many backups are kept by default
+const DefaultBackupRetention = 7
+
+const (
+	backupPrefix = "statusthing-"
+	backupSuffix = ".db"
+	// backupTimeFormat sorts in time order so the oldest backups can be found by name
+	backupTimeFormat = "20060102T150405.000000000Z"
+)
+
+// Backup writes a consistent copy of the store to a new file in the backup dir and returns its path
+// backups beyond the retention are removed oldest first
+// [serrors.ErrNotImplemented] is returned if there is no backup dir or the store isn't a [storers.Backuper]
+func (sts *StatusThingService) Backup(ctx context.Context) (string, error) {
+	if sts.store == nil {
+		return "", serrors.NewError("store", serrors.ErrStoreUnavailable)
+	}
+	backuper, ok := sts.store.(storers.Backuper)
+	if !ok {
+		return "", serrors.NewError("backup", serrors.ErrNotImplemented)
+	}
+	if sts.backupDir == "" {
+		return "", serrors.NewError("backup dir", serrors.ErrNotImplemented)
+	}
+	if err := os.MkdirAll(sts.backupDir, 0o700); err != nil {
+		return "", serrors.NewWrappedError("backup dir", serrors.ErrUnrecoverable, err)
+	}
+	path := filepath.Join(sts.backupDir, backupPrefix+time.Now().UTC().Format(backupTimeFormat)+backupSuffix)
+	if err := backuper.Backup(ctx, path); err != nil {
+		return "", err
+	}
+	if err := sts.pruneBackups(); err != nil {
+		// the backup itself worked so we only warn
+		slog.Warn("unable to remove old backups", "error", err, "backup.dir", sts.backupDir)
+	}
+	return path, nil
+}
+
+// RunBackups calls [StatusThingService.Backup] every interval until the provided context is done
+func (sts *StatusThingService) RunBackups(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			path, err := sts.Backup(ctx)
+			if err != nil {
+				slog.Error("backup failed", "error", err, "backup.dir", sts.backupDir)
+				continue
+			}
+			slog.Info("backup complete", "backup.path", path)
+		}
+	}
+}
+
+// pruneBackups removes the oldest backups beyond the retention
+// only files named like the ones [StatusThingService.Backup] writes are considered
+func (sts *StatusThingService) pruneBackups() error {
+	entries, err := os.ReadDir(sts.backupDir)
+	if err != nil {
+		return err
+	}
+	backups := []string{}
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.Type().IsRegular() && strings.HasPrefix(name, backupPrefix) && strings.HasSuffix(name, backupSuffix) {
+			backups = append(backups, name)
+		}
+	}
+	sort.Strings(backups)
+	for len(backups) > sts.backupRetention {
+		if err := os.Remove(filepath.Join(sts.backupDir, backups[0])); err != nil {
+			return err
+		}
+		backups = backups[1:]
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package services
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/lusis/statusthing/internal/serrors"
+	"github.com/lusis/statusthing/internal/storers/memdb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackup(t *testing.T) {
+	ctx := context.TODO()
+	t.Run("happy-path", func(t *testing.T) {
+		dir := filepath.Join(t.TempDir(), "backups")
+		store, err := memdb.New()
+		require.NoError(t, err)
+		sts, err := NewStatusThingService(store, WithBackupDir(dir), WithBackupRetention(2))
+		require.NoError(t, err)
+		// files that aren't backups are never removed
+		require.NoError(t, os.MkdirAll(dir, 0o700))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "notes.txt"), nil, 0o600))
+
+		paths := []string{}
+		for i := 0; i < 3; i++ {
+			path, err := sts.Backup(ctx)
+			require.NoError(t, err)
+			require.FileExists(t, path)
+			paths = append(paths, path)
+		}
+		require.NoFileExists(t, paths[0], "oldest backup should be removed")
+		entries, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		require.Len(t, entries, 3)
+	})
+	t.Run("no-backup-dir", func(t *testing.T) {
+		store, err := memdb.New()
+		require.NoError(t, err)
+		sts, err := NewStatusThingService(store)
+		require.NoError(t, err)
+		_, err = sts.Backup(ctx)
+		require.ErrorIs(t, err, serrors.ErrNotImplemented)
+	})
+	t.Run("not-a-backuper", func(t *testing.T) {
+		sts, err := NewStatusThingService(&testStatusThingStore{}, WithBackupDir(t.TempDir()))
+		require.NoError(t, err)
+		_, err = sts.Backup(ctx)
+		require.ErrorIs(t, err, serrors.ErrNotImplemented)
+	})
+	t.Run("invalid-options", func(t *testing.T) {
+		_, err := NewStatusThingService(&testStatusThingStore{}, WithBackupDir(" "))
+		require.ErrorIs(t, err, serrors.ErrEmptyString)
+		_, err = NewStatusThingService(&testStatusThingStore{}, WithBackupRetention(0))
+		require.ErrorIs(t, err, serrors.ErrAtLeastOne)
+	})
+}
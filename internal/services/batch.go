@@ -0,0 +1,103 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/segmentio/ksuid"
+
+	statusthingv1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
+	"github.com/lusis/statusthing/internal/filters"
+	"github.com/lusis/statusthing/internal/serrors"
+	"github.com/lusis/statusthing/internal/validation"
+)
+
+// BatchEditItems changes every [statusthingv1.Item] with the provided ids in a single transaction
+// no items are changed if any item fails and a [serrors.BatchError] with the error for each failed item is returned
+// duplicate ids are ignored
+// supported filters (at least one is required):
+// - [filters.WithStatusID]: sets the status of every item to the [statusthingv1.Status] with the provided id
+// - [filters.WithNoteText]: adds a [statusthingv1.Note] with the provided text to every item
+func (sts *StatusThingService) BatchEditItems(ctx context.Context, itemIDs []string, opts ...filters.FilterOption) ([]*statusthingv1.Item, error) {
+	if sts.store == nil {
+		return nil, fmt.Errorf("store was nil: %w", serrors.ErrStoreUnavailable)
+	}
+	ids, err := batchIDs(itemIDs)
+	if err != nil {
+		return nil, err
+	}
+	f, err := filters.New(opts...)
+	if err != nil {
+		return nil, err
+	}
+	if !validation.ValidString(f.StatusID()) && !validation.ValidString(f.NoteText()) {
+		return nil, serrors.NewError("statusID or noteText", serrors.ErrAtLeastOne)
+	}
+	if err := checkNoteText(f.NoteText()); err != nil {
+		return nil, err
+	}
+	storeOpts := []filters.FilterOption{}
+	if validation.ValidString(f.StatusID()) {
+		if _, err := sts.store.GetStatus(ctx, f.StatusID()); err != nil {
+			return nil, serrors.NewWrappedError("statusID", serrors.ErrNotFound, err)
+		}
+		storeOpts = append(storeOpts, filters.WithStatusID(f.StatusID()))
+	}
+	notes := map[string]*statusthingv1.Note{}
+	if validation.ValidString(f.NoteText()) {
+		for _, id := range ids {
+			notes[id] = &statusthingv1.Note{
+				Id:         ksuid.New().String(),
+				Text:       f.NoteText(),
+				Author:     noteAuthor(ctx),
+				Timestamps: makeTsNow(),
+			}
+		}
+	}
+	if err := sts.store.BatchUpdateItems(ctx, ids, notes, storeOpts...); err != nil {
+		return nil, err
+	}
+	res := []*statusthingv1.Item{}
+	for _, id := range ids {
+		item, err := sts.GetItem(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, item)
+	}
+	return res, nil
+}
+
+// BatchRemoveItems removes every [statusthingv1.Item] with the provided ids in a single transaction
+// no items are removed if any item fails and a [serrors.BatchError] with the error for each failed item is returned
+// duplicate ids are ignored
+func (sts *StatusThingService) BatchRemoveItems(ctx context.Context, itemIDs []string) error {
+	if sts.store == nil {
+		return serrors.NewError("store", serrors.ErrStoreUnavailable)
+	}
+	ids, err := batchIDs(itemIDs)
+	if err != nil {
+		return err
+	}
+	return sts.store.BatchDeleteItems(ctx, ids)
+}
+
+// batchIDs validates the provided ids and removes any duplicates while preserving order
+func batchIDs(itemIDs []string) ([]string, error) {
+	if len(itemIDs) == 0 {
+		return nil, serrors.NewError("itemIDs", serrors.ErrAtLeastOne)
+	}
+	seen := map[string]bool{}
+	ids := []string{}
+	for _, id := range itemIDs {
+		if !validation.ValidString(id) {
+			return nil, serrors.NewError("itemIDs", serrors.ErrEmptyString)
+		}
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
@@ -0,0 +1,82 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	statusthingv1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
+	"github.com/lusis/statusthing/internal/filters"
+	"github.com/lusis/statusthing/internal/serrors"
+	"github.com/lusis/statusthing/internal/storers/memdb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatchEditItems(t *testing.T) {
+	ctx := context.TODO()
+	store, err := memdb.New()
+	require.NoError(t, err)
+	sts, err := NewStatusThingService(store)
+	require.NoError(t, err)
+	down, err := sts.AddStatus(ctx, "down", statusthingv1.StatusKind_STATUS_KIND_DOWN)
+	require.NoError(t, err)
+	first, err := sts.AddItem(ctx, "first")
+	require.NoError(t, err)
+	second, err := sts.AddItem(ctx, "second")
+	require.NoError(t, err)
+
+	testCases := map[string]struct {
+		ids  []string
+		opts []filters.FilterOption
+		err  error
+	}{
+		"no-ids":         {opts: []filters.FilterOption{filters.WithStatusID(down.GetId())}, err: serrors.ErrAtLeastOne},
+		"empty-id":       {ids: []string{""}, opts: []filters.FilterOption{filters.WithStatusID(down.GetId())}, err: serrors.ErrEmptyString},
+		"no-changes":     {ids: []string{first.GetId()}, err: serrors.ErrAtLeastOne},
+		"missing-status": {ids: []string{first.GetId()}, opts: []filters.FilterOption{filters.WithStatusID("missing")}, err: serrors.ErrNotFound},
+		"missing-item": {
+			ids:  []string{first.GetId(), "missing"},
+			opts: []filters.FilterOption{filters.WithStatusID(down.GetId()), filters.WithNoteText("incident")},
+			err:  serrors.ErrBatchFailed,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			res, err := sts.BatchEditItems(ctx, tc.ids, tc.opts...)
+			require.ErrorIs(t, err, tc.err)
+			require.Nil(t, res)
+		})
+	}
+	t.Run("happy-path", func(t *testing.T) {
+		ids := []string{first.GetId(), second.GetId(), first.GetId()}
+		res, err := sts.BatchEditItems(ctx, ids, filters.WithStatusID(down.GetId()), filters.WithNoteText("incident"))
+		require.NoError(t, err)
+		require.Len(t, res, 2, "duplicate ids should be ignored")
+		for _, item := range res {
+			require.Equal(t, down.GetId(), item.GetStatus().GetId())
+			require.Len(t, item.GetNotes(), 1)
+			require.Equal(t, "incident", item.GetNotes()[0].GetText())
+		}
+	})
+}
+
+func TestBatchRemoveItems(t *testing.T) {
+	ctx := context.TODO()
+	store, err := memdb.New()
+	require.NoError(t, err)
+	sts, err := NewStatusThingService(store)
+	require.NoError(t, err)
+	first, err := sts.AddItem(ctx, "first", filters.WithNoteText("a note"))
+	require.NoError(t, err)
+	second, err := sts.AddItem(ctx, "second")
+	require.NoError(t, err)
+
+	require.ErrorIs(t, sts.BatchRemoveItems(ctx, nil), serrors.ErrAtLeastOne)
+	require.ErrorIs(t, sts.BatchRemoveItems(ctx, []string{first.GetId(), "missing"}), serrors.ErrBatchFailed)
+	_, err = sts.GetItem(ctx, first.GetId())
+	require.NoError(t, err, "no items should be removed when one fails")
+
+	require.NoError(t, sts.BatchRemoveItems(ctx, []string{first.GetId(), second.GetId()}))
+	res, err := sts.FindItems(ctx)
+	require.NoError(t, err)
+	require.Len(t, res, 0)
+}
@@ -0,0 +1,421 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	statusthingv1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
+	"github.com/lusis/statusthing/internal/filters"
+	"github.com/lusis/statusthing/internal/serrors"
+	"github.com/lusis/statusthing/internal/storers"
+	"github.com/lusis/statusthing/internal/validation"
+)
+
+// errDryRun is used to roll back the transaction of a dry run import
+var errDryRun = errors.New("dry run")
+
+// ImportSummary describes what an import changed or, for a dry run, would have changed
+type ImportSummary struct {
+	// Statuses is the number of statuses added or updated
+	Statuses int
+	// Items is the number of items added or updated
+	Items int
+	// Notes is the number of notes added or changed. existing notes that are the same are skipped
+	Notes int
+	// Dependencies is the number of dependencies added
+	Dependencies int
+	// Users is the number of users added or updated
+	Users int
+	// SkippedUsers are the usernames of new users that were not added because they had no password hash
+	SkippedUsers []string
+	// RemovedItems is the number of existing items removed by a replace
+	RemovedItems int
+	// RemovedStatuses is the number of existing statuses removed by a replace
+	RemovedStatuses int
+	// DryRun is true when nothing was changed
+	DryRun bool
+}
+
+// ExportData returns everything in the store as a [statusthingv1.Dataset]
+// ids, versions and timestamps are preserved so the dataset can be imported elsewhere with [StatusThingService.ImportData]
+// user passwords are only included if includePasswordHashes is true
+func (sts *StatusThingService) ExportData(ctx context.Context, includePasswordHashes bool) (*statusthingv1.Dataset, error) {
+	if sts.store == nil {
+		return nil, serrors.NewError("store", serrors.ErrStoreUnavailable)
+	}
+	statuses, err := sts.store.FindStatus(ctx)
+	if err != nil {
+		return nil, err
+	}
+	items, err := sts.store.FindItems(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range items {
+		// statuses and dependencies are exported on their own
+		if item.GetStatus() != nil {
+			item.Status = &statusthingv1.Status{Id: item.GetStatus().GetId()}
+		}
+		item.DependsOn = nil
+	}
+	deps, err := sts.store.FindDependencies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	users, err := sts.store.FindUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !includePasswordHashes {
+		for _, user := range users {
+			user.Password = ""
+		}
+	}
+	return &statusthingv1.Dataset{
+		Statuses:     statuses,
+		Items:        items,
+		Dependencies: deps,
+		Users:        users,
+	}, nil
+}
+
+// ImportData imports the provided [statusthingv1.Dataset] in a single transaction
+// - [statusthingv1.ImportMode_IMPORT_MODE_MERGE] (the default): records are added, or update the existing record with the same id
+// - [statusthingv1.ImportMode_IMPORT_MODE_REPLACE]: existing statuses, items, notes and dependencies are removed first
+// users are always merged by username so an import can't lock everyone out. new users without a password hash are skipped
+// when dryRun is true the import is rolled back and the summary reports what would have changed
+func (sts *StatusThingService) ImportData(ctx context.Context, dataset *statusthingv1.Dataset, mode statusthingv1.ImportMode, dryRun bool) (*ImportSummary, error) {
+	if sts.store == nil {
+		return nil, serrors.NewError("store", serrors.ErrStoreUnavailable)
+	}
+	if dataset == nil {
+		return nil, serrors.NewError("dataset", serrors.ErrNilVal)
+	}
+	if _, ok := sts.store.(storers.Transactor); dryRun && !ok {
+		// without a transaction there is nothing to roll back
+		return nil, serrors.NewError("dry run", serrors.ErrNotImplemented)
+	}
+	summary := &ImportSummary{DryRun: dryRun}
+	err := sts.withTx(ctx, func(store storers.StatusThingStorer) error {
+		if mode == statusthingv1.ImportMode_IMPORT_MODE_REPLACE {
+			if err := removeAll(ctx, store, summary); err != nil {
+				return err
+			}
+		}
+		if err := importStatuses(ctx, store, dataset.GetStatuses(), summary); err != nil {
+			return err
+		}
+		if err := importItems(ctx, store, dataset.GetItems(), summary); err != nil {
+			return err
+		}
+		if err := importDependencies(ctx, store, dataset.GetDependencies(), summary); err != nil {
+			return err
+		}
+		if err := importUsers(ctx, store, dataset.GetUsers(), summary); err != nil {
+			return err
+		}
+		if dryRun {
+			return errDryRun
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errDryRun) {
+		return nil, err
+	}
+	return summary, nil
+}
+
+// removeAll removes every item and status. notes and dependencies are removed along with their items
+func removeAll(ctx context.Context, store storers.StatusThingStorer, summary *ImportSummary) error {
+	items, err := store.FindItems(ctx)
+	if err != nil {
+		return err
+	}
+	for _, item := range items {
+		if err := store.DeleteItem(ctx, item.GetId()); err != nil {
+			return fmt.Errorf("removing item %s: %w", item.GetId(), err)
+		}
+		summary.RemovedItems++
+	}
+	statuses, err := store.FindStatus(ctx)
+	if err != nil {
+		return err
+	}
+	for _, status := range statuses {
+		if err := store.DeleteStatus(ctx, status.GetId()); err != nil {
+			return fmt.Errorf("removing status %s: %w", status.GetId(), err)
+		}
+		summary.RemovedStatuses++
+	}
+	return nil
+}
+
+func importStatuses(ctx context.Context, store storers.StatusThingStorer, statuses []*statusthingv1.Status, summary *ImportSummary) error {
+	for _, status := range statuses {
+		if !validation.ValidString(status.GetId()) {
+			return serrors.NewError("status id", serrors.ErrEmptyString)
+		}
+		_, err := store.GetStatus(ctx, status.GetId())
+		switch {
+		case errors.Is(err, serrors.ErrNotFound):
+			cp := proto.Clone(status).(*statusthingv1.Status)
+			if cp.GetTimestamps() == nil {
+				cp.Timestamps = makeTsNow()
+			}
+			if _, err := store.StoreStatus(ctx, cp); err != nil {
+				return fmt.Errorf("status %s: %w", status.GetId(), err)
+			}
+		case err != nil:
+			return err
+		default:
+			opts := []filters.FilterOption{
+				filters.WithName(status.GetName()),
+				filters.WithStatusKind(status.GetKind()),
+			}
+			cleared := []string{}
+			if validation.ValidString(status.GetDescription()) {
+				opts = append(opts, filters.WithDescription(status.GetDescription()))
+			} else {
+				cleared = append(cleared, "description")
+			}
+			if validation.ValidString(status.GetColor()) {
+				opts = append(opts, filters.WithColor(status.GetColor()))
+			} else {
+				cleared = append(cleared, "color")
+			}
+			if len(cleared) != 0 {
+				opts = append(opts, filters.WithClearedFields(cleared...))
+			}
+			if err := store.UpdateStatus(ctx, status.GetId(), opts...); err != nil {
+				return fmt.Errorf("status %s: %w", status.GetId(), err)
+			}
+		}
+		summary.Statuses++
+	}
+	return nil
+}
+
+// importItems imports items and their notes
+// items are imported parents first so they can be in any order in the dataset
+func importItems(ctx context.Context, store storers.StatusThingStorer, items []*statusthingv1.Item, summary *ImportSummary) error {
+	for _, item := range parentsFirst(items) {
+		if !validation.ValidString(item.GetId()) {
+			return serrors.NewError("item id", serrors.ErrEmptyString)
+		}
+		_, err := store.GetItem(ctx, item.GetId())
+		switch {
+		case errors.Is(err, serrors.ErrNotFound):
+			cp := proto.Clone(item).(*statusthingv1.Item)
+			cp.Notes = nil
+			cp.DependsOn = nil
+			if cp.GetTimestamps() == nil {
+				cp.Timestamps = makeTsNow()
+			}
+			if _, err := store.StoreItem(ctx, cp); err != nil {
+				return fmt.Errorf("item %s: %w", item.GetId(), err)
+			}
+		case err != nil:
+			return err
+		default:
+			opts := []filters.FilterOption{filters.WithName(item.GetName())}
+			cleared := []string{}
+			if validation.ValidString(item.GetDescription()) {
+				opts = append(opts, filters.WithDescription(item.GetDescription()))
+			} else {
+				cleared = append(cleared, "description")
+			}
+			if validation.ValidString(item.GetStatus().GetId()) {
+				opts = append(opts, filters.WithStatusID(item.GetStatus().GetId()))
+			} else {
+				cleared = append(cleared, "status_id")
+			}
+			if validation.ValidString(item.GetParentId()) {
+				opts = append(opts, filters.WithParentID(item.GetParentId()))
+			} else {
+				cleared = append(cleared, "parent_id")
+			}
+			if item.GetRollupPolicy() != statusthingv1.RollupPolicy_ROLLUP_POLICY_UNKNOWN {
+				opts = append(opts, filters.WithRollupPolicy(item.GetRollupPolicy()))
+			} else {
+				cleared = append(cleared, "rollup_policy")
+			}
+			if len(cleared) != 0 {
+				opts = append(opts, filters.WithClearedFields(cleared...))
+			}
+			if err := store.UpdateItem(ctx, item.GetId(), opts...); err != nil {
+				return fmt.Errorf("item %s: %w", item.GetId(), err)
+			}
+		}
+		summary.Items++
+		if err := importNotes(ctx, store, item.GetId(), item.GetNotes(), summary); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parentsFirst orders items so every item comes after its parent
+// items whose parent isn't in the list are assumed to have an existing parent
+func parentsFirst(items []*statusthingv1.Item) []*statusthingv1.Item {
+	ids := map[string]bool{}
+	for _, item := range items {
+		ids[item.GetId()] = true
+	}
+	sorted := make([]*statusthingv1.Item, 0, len(items))
+	added := make([]bool, len(items))
+	placed := map[string]bool{}
+	for len(sorted) < len(items) {
+		progress := false
+		for i, item := range items {
+			if added[i] {
+				continue
+			}
+			parent := item.GetParentId()
+			if parent == "" || !ids[parent] || placed[parent] {
+				sorted = append(sorted, item)
+				added[i] = true
+				placed[item.GetId()] = true
+				progress = true
+			}
+		}
+		if !progress {
+			// parents form a cycle so the rest are left in order for the store to reject
+			for i, item := range items {
+				if !added[i] {
+					sorted = append(sorted, item)
+					added[i] = true
+				}
+			}
+		}
+	}
+	return sorted
+}
+
+func importNotes(ctx context.Context, store storers.StatusThingStorer, itemID string, notes []*statusthingv1.Note, summary *ImportSummary) error {
+	for _, note := range notes {
+		if !validation.ValidString(note.GetId()) {
+			return serrors.NewError("note id", serrors.ErrEmptyString)
+		}
+		if err := checkNoteText(note.GetText()); err != nil {
+			return fmt.Errorf("note %s: %w", note.GetId(), err)
+		}
+		// datasets from before notes had a visibility only have public notes
+		visibility := note.GetVisibility()
+		if visibility == statusthingv1.NoteVisibility_NOTE_VISIBILITY_UNKNOWN {
+			visibility = statusthingv1.NoteVisibility_NOTE_VISIBILITY_PUBLIC
+		}
+		existing, err := store.GetNote(ctx, note.GetId())
+		switch {
+		case errors.Is(err, serrors.ErrNotFound):
+			cp := proto.Clone(note).(*statusthingv1.Note)
+			if cp.GetTimestamps() == nil {
+				cp.Timestamps = makeTsNow()
+			}
+			if _, err := store.StoreNote(ctx, cp, itemID); err != nil {
+				return fmt.Errorf("note %s: %w", note.GetId(), err)
+			}
+		case err != nil:
+			return err
+		case existing.GetText() == note.GetText() && existing.GetVisibility() == visibility && existing.GetPinned() == note.GetPinned():
+			// unchanged notes are skipped so importing the same dataset again doesn't make them look edited
+			continue
+		default:
+			if err := store.UpdateNote(ctx, note.GetId(), filters.WithNoteText(note.GetText()), filters.WithNoteVisibility(visibility), filters.WithPinned(note.GetPinned())); err != nil {
+				return fmt.Errorf("note %s: %w", note.GetId(), err)
+			}
+		}
+		summary.Notes++
+	}
+	return nil
+}
+
+// importDependencies adds any dependencies that don't already exist
+// [serrors.ErrCycle] is returned if the imported dependencies would create a cycle
+func importDependencies(ctx context.Context, store storers.StatusThingStorer, deps []*statusthingv1.ItemDependency, summary *ImportSummary) error {
+	existing, err := store.FindDependencies(ctx)
+	if err != nil {
+		return err
+	}
+	edges := dependencyEdges(existing)
+	for _, dep := range deps {
+		itemID, dependsOnID := dep.GetItemId(), dep.GetDependsOnId()
+		if !validation.ValidString(itemID) || !validation.ValidString(dependsOnID) {
+			return serrors.NewError("dependency", serrors.ErrEmptyString)
+		}
+		exists := false
+		for _, id := range edges[itemID] {
+			if id == dependsOnID {
+				exists = true
+			}
+		}
+		if exists {
+			continue
+		}
+		if _, ok := reachable(edges, dependsOnID)[itemID]; ok || itemID == dependsOnID {
+			return serrors.NewError(fmt.Sprintf("dependency %s -> %s", itemID, dependsOnID), serrors.ErrCycle)
+		}
+		cp := proto.Clone(dep).(*statusthingv1.ItemDependency)
+		if cp.GetTimestamps() == nil {
+			cp.Timestamps = makeTsNow()
+		}
+		if _, err := store.StoreDependency(ctx, cp); err != nil {
+			return fmt.Errorf("dependency %s -> %s: %w", itemID, dependsOnID, err)
+		}
+		edges[itemID] = append(edges[itemID], dependsOnID)
+		summary.Dependencies++
+	}
+	return nil
+}
+
+func importUsers(ctx context.Context, store storers.StatusThingStorer, users []*statusthingv1.User, summary *ImportSummary) error {
+	for _, user := range users {
+		if !validation.ValidString(user.GetUsername()) {
+			return serrors.NewError("username", serrors.ErrEmptyString)
+		}
+		_, err := store.GetUser(ctx, user.GetUsername())
+		switch {
+		case errors.Is(err, serrors.ErrNotFound):
+			if !validation.ValidString(user.GetPassword()) {
+				summary.SkippedUsers = append(summary.SkippedUsers, user.GetUsername())
+				continue
+			}
+			cp := proto.Clone(user).(*statusthingv1.User)
+			if cp.GetTimestamps() == nil {
+				cp.Timestamps = makeTsNow()
+			}
+			if _, err := store.StoreUser(ctx, cp); err != nil {
+				return fmt.Errorf("user %s: %w", user.GetUsername(), err)
+			}
+		case err != nil:
+			return err
+		default:
+			opts := []filters.FilterOption{}
+			if validation.ValidString(user.GetFirstName()) {
+				opts = append(opts, filters.WithFirstName(user.GetFirstName()))
+			}
+			if validation.ValidString(user.GetLastName()) {
+				opts = append(opts, filters.WithLastName(user.GetLastName()))
+			}
+			if validation.ValidString(user.GetEmailAddress()) {
+				opts = append(opts, filters.WithEmailAddress(user.GetEmailAddress()))
+			}
+			if validation.ValidString(user.GetAvatarUrl()) {
+				opts = append(opts, filters.WithAvatarURL(user.GetAvatarUrl()))
+			}
+			// existing passwords are kept unless the export included them
+			if validation.ValidString(user.GetPassword()) {
+				opts = append(opts, filters.WithPassword(user.GetPassword()))
+			}
+			if len(opts) != 0 {
+				if err := store.UpdateUser(ctx, user.GetUsername(), opts...); err != nil {
+					return fmt.Errorf("user %s: %w", user.GetUsername(), err)
+				}
+			}
+		}
+		summary.Users++
+	}
+	return nil
+}
@@ -0,0 +1,229 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	statusthingv1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
+	"github.com/lusis/statusthing/internal/filters"
+	"github.com/lusis/statusthing/internal/serrors"
+	"github.com/lusis/statusthing/internal/storers/memdb"
+	"github.com/lusis/statusthing/internal/testutils"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+// makeDataset populates a new service with a parent and child item, a status, a note, a dependency and a user
+func makeDataset(t *testing.T) *StatusThingService {
+	t.Helper()
+	ctx := context.TODO()
+	store, err := memdb.New()
+	require.NoError(t, err)
+	sts, err := NewStatusThingService(store)
+	require.NoError(t, err)
+
+	status, err := sts.AddStatus(ctx, "up", statusthingv1.StatusKind_STATUS_KIND_UP, filters.WithColor("green"))
+	require.NoError(t, err)
+	parent, err := sts.AddItem(ctx, "parent", filters.WithItemID("parent"), filters.WithStatusID(status.GetId()))
+	require.NoError(t, err)
+	_, err = sts.AddItem(ctx, "child", filters.WithItemID("child"), filters.WithParentID(parent.GetId()), filters.WithNoteText("child note"))
+	require.NoError(t, err)
+	_, err = sts.AddItem(ctx, "db", filters.WithItemID("db"))
+	require.NoError(t, err)
+	_, err = sts.AddDependency(ctx, "child", "db")
+	require.NoError(t, err)
+	user := testutils.MakeUser("admin")
+	user.EmailAddress = "admin@example.com"
+	_, err = sts.store.StoreUser(ctx, user)
+	require.NoError(t, err)
+	return sts
+}
+
+func TestExportData(t *testing.T) {
+	ctx := context.TODO()
+	sts := makeDataset(t)
+
+	ds, err := sts.ExportData(ctx, false)
+	require.NoError(t, err)
+	require.Len(t, ds.GetStatuses(), 1)
+	require.Len(t, ds.GetItems(), 3)
+	require.Len(t, ds.GetDependencies(), 1)
+	require.Len(t, ds.GetUsers(), 1)
+	require.Empty(t, ds.GetUsers()[0].GetPassword(), "password hashes should not be exported by default")
+	for _, item := range ds.GetItems() {
+		require.Empty(t, item.GetDependsOn(), "dependencies are exported separately")
+		if item.GetStatus() != nil {
+			require.Empty(t, item.GetStatus().GetName(), "statuses are exported separately")
+		}
+	}
+
+	ds, err = sts.ExportData(ctx, true)
+	require.NoError(t, err)
+	require.Equal(t, "admin_password", ds.GetUsers()[0].GetPassword())
+
+	t.Run("nil-store", func(t *testing.T) {
+		_, err := (&StatusThingService{}).ExportData(ctx, false)
+		require.ErrorIs(t, err, serrors.ErrStoreUnavailable)
+	})
+}
+
+func TestImportData(t *testing.T) {
+	ctx := context.TODO()
+	src := makeDataset(t)
+	ds, err := src.ExportData(ctx, true)
+	require.NoError(t, err)
+	noHashes, err := src.ExportData(ctx, false)
+	require.NoError(t, err)
+
+	newService := func(t *testing.T) *StatusThingService {
+		store, err := memdb.New()
+		require.NoError(t, err)
+		sts, err := NewStatusThingService(store)
+		require.NoError(t, err)
+		return sts
+	}
+
+	t.Run("round-trip", func(t *testing.T) {
+		sts := newService(t)
+		summary, err := sts.ImportData(ctx, ds, statusthingv1.ImportMode_IMPORT_MODE_UNKNOWN, false)
+		require.NoError(t, err)
+		require.Equal(t, &ImportSummary{Statuses: 1, Items: 3, Notes: 1, Dependencies: 1, Users: 1}, summary)
+
+		for _, expected := range ds.GetItems() {
+			actual, err := sts.GetItem(ctx, expected.GetId())
+			require.NoError(t, err)
+			require.Equal(t, expected.GetParentId(), actual.GetParentId())
+			require.Equal(t, expected.GetStatus().GetId(), actual.GetStatus().GetId())
+			require.True(t, testutils.TimestampsEqual(expected.GetTimestamps(), actual.GetTimestamps()), "timestamps should be preserved")
+			require.Len(t, actual.GetNotes(), len(expected.GetNotes()))
+		}
+		child, err := sts.GetItem(ctx, "child")
+		require.NoError(t, err)
+		require.Equal(t, []string{"db"}, child.GetDependsOn())
+		require.Equal(t, "child note", child.GetNotes()[0].GetText())
+		user, err := sts.store.GetUser(ctx, "admin_username")
+		require.NoError(t, err)
+		require.Equal(t, "admin_password", user.GetPassword())
+	})
+	t.Run("merge", func(t *testing.T) {
+		sts := newService(t)
+		_, err := sts.ImportData(ctx, ds, statusthingv1.ImportMode_IMPORT_MODE_MERGE, false)
+		require.NoError(t, err)
+		extra, err := sts.AddItem(ctx, "extra")
+		require.NoError(t, err)
+		require.NoError(t, sts.EditItem(ctx, "parent", filters.WithName("renamed"), filters.WithDescription("desc")))
+
+		summary, err := sts.ImportData(ctx, noHashes, statusthingv1.ImportMode_IMPORT_MODE_MERGE, false)
+		require.NoError(t, err)
+		require.Equal(t, 3, summary.Items)
+		require.Equal(t, 0, summary.Dependencies, "existing dependencies should be skipped")
+		require.Equal(t, 0, summary.Notes, "unchanged notes should be skipped")
+		child, err := sts.GetItem(ctx, "child")
+		require.NoError(t, err)
+		revisions, err := sts.ListNoteRevisions(ctx, child.GetNotes()[0].GetId())
+		require.NoError(t, err)
+		require.Empty(t, revisions, "importing unchanged notes should not add revisions")
+
+		parent, err := sts.GetItem(ctx, "parent")
+		require.NoError(t, err)
+		require.Equal(t, "parent", parent.GetName())
+		require.Empty(t, parent.GetDescription())
+		_, err = sts.GetItem(ctx, extra.GetId())
+		require.NoError(t, err, "merge should leave other items alone")
+		user, err := sts.store.GetUser(ctx, "admin_username")
+		require.NoError(t, err)
+		require.Equal(t, "admin_password", user.GetPassword(), "existing password should be kept")
+	})
+	t.Run("note-changes", func(t *testing.T) {
+		sts := newService(t)
+		_, err := sts.ImportData(ctx, ds, statusthingv1.ImportMode_IMPORT_MODE_MERGE, false)
+		require.NoError(t, err)
+
+		changed := proto.Clone(ds).(*statusthingv1.Dataset)
+		var note *statusthingv1.Note
+		for _, item := range changed.GetItems() {
+			if item.GetId() == "child" {
+				note = item.GetNotes()[0]
+			}
+		}
+		require.NotNil(t, note)
+		note.Visibility = statusthingv1.NoteVisibility_NOTE_VISIBILITY_INTERNAL
+		note.Pinned = true
+		summary, err := sts.ImportData(ctx, changed, statusthingv1.ImportMode_IMPORT_MODE_MERGE, false)
+		require.NoError(t, err)
+		require.Equal(t, 1, summary.Notes)
+		res, err := sts.GetNote(ctx, note.GetId())
+		require.NoError(t, err)
+		require.Equal(t, statusthingv1.NoteVisibility_NOTE_VISIBILITY_INTERNAL, res.GetVisibility())
+		require.True(t, res.GetPinned())
+
+		exported, err := sts.ExportData(ctx, false)
+		require.NoError(t, err)
+		other := newService(t)
+		_, err = other.ImportData(ctx, exported, statusthingv1.ImportMode_IMPORT_MODE_MERGE, false)
+		require.NoError(t, err)
+		res, err = other.GetNote(ctx, note.GetId())
+		require.NoError(t, err)
+		require.Equal(t, statusthingv1.NoteVisibility_NOTE_VISIBILITY_INTERNAL, res.GetVisibility(), "visibility should survive a round trip")
+		require.True(t, res.GetPinned(), "pinned should survive a round trip")
+
+		summary, err = sts.ImportData(ctx, ds, statusthingv1.ImportMode_IMPORT_MODE_MERGE, false)
+		require.NoError(t, err)
+		require.Equal(t, 1, summary.Notes)
+		res, err = sts.GetNote(ctx, note.GetId())
+		require.NoError(t, err)
+		require.Equal(t, statusthingv1.NoteVisibility_NOTE_VISIBILITY_PUBLIC, res.GetVisibility(), "importing the original should undo the change")
+		require.False(t, res.GetPinned())
+	})
+	t.Run("replace", func(t *testing.T) {
+		sts := newService(t)
+		extra, err := sts.AddItem(ctx, "extra", filters.WithStatus(testutils.MakeStatus(t.Name())))
+		require.NoError(t, err)
+
+		summary, err := sts.ImportData(ctx, ds, statusthingv1.ImportMode_IMPORT_MODE_REPLACE, false)
+		require.NoError(t, err)
+		require.Equal(t, 1, summary.RemovedItems)
+		require.Equal(t, 1, summary.RemovedStatuses)
+		_, err = sts.GetItem(ctx, extra.GetId())
+		require.ErrorIs(t, err, serrors.ErrNotFound)
+		items, err := sts.FindItems(ctx)
+		require.NoError(t, err)
+		require.Len(t, items, 3)
+	})
+	t.Run("dry-run", func(t *testing.T) {
+		sts := newService(t)
+		summary, err := sts.ImportData(ctx, ds, statusthingv1.ImportMode_IMPORT_MODE_MERGE, true)
+		require.NoError(t, err)
+		require.True(t, summary.DryRun)
+		require.Equal(t, 3, summary.Items)
+		items, err := sts.FindItems(ctx)
+		require.NoError(t, err)
+		require.Empty(t, items, "dry run should not change anything")
+	})
+	t.Run("skipped-users", func(t *testing.T) {
+		sts := newService(t)
+		summary, err := sts.ImportData(ctx, noHashes, statusthingv1.ImportMode_IMPORT_MODE_MERGE, false)
+		require.NoError(t, err)
+		require.Equal(t, []string{"admin_username"}, summary.SkippedUsers)
+		require.Equal(t, 0, summary.Users)
+	})
+	t.Run("cycle", func(t *testing.T) {
+		sts := newService(t)
+		bad := &statusthingv1.Dataset{
+			Items: []*statusthingv1.Item{testutils.MakeItem("a"), testutils.MakeItem("b")},
+			Dependencies: []*statusthingv1.ItemDependency{
+				{ItemId: "a_item_id", DependsOnId: "b_item_id"},
+				{ItemId: "b_item_id", DependsOnId: "a_item_id"},
+			},
+		}
+		_, err := sts.ImportData(ctx, bad, statusthingv1.ImportMode_IMPORT_MODE_MERGE, false)
+		require.ErrorIs(t, err, serrors.ErrCycle)
+		items, err := sts.FindItems(ctx)
+		require.NoError(t, err)
+		require.Empty(t, items, "failed imports should be rolled back")
+	})
+	t.Run("nil-dataset", func(t *testing.T) {
+		_, err := newService(t).ImportData(ctx, nil, statusthingv1.ImportMode_IMPORT_MODE_MERGE, false)
+		require.ErrorIs(t, err, serrors.ErrNilVal)
+	})
+}
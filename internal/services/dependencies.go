@@ -0,0 +1,242 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	statusthingv1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
+	"github.com/lusis/statusthing/internal/filters"
+	"github.com/lusis/statusthing/internal/serrors"
+	"github.com/lusis/statusthing/internal/storers"
+	"github.com/lusis/statusthing/internal/validation"
+)
+
+// degradedStatusName is the name of the derived [statusthingv1.Status] used when a dependency is down
+const degradedStatusName = "DEGRADED"
+
+// ItemImpact describes how an [statusthingv1.Item] affects and is affected by its dependencies
+type ItemImpact struct {
+	// Item is the item with its effective status populated
+	Item *statusthingv1.Item
+	// Impacted are the items that directly or transitively depend on Item
+	Impacted []*statusthingv1.Item
+	// DownDependencies are the items Item directly or transitively depends on that are down
+	DownDependencies []*statusthingv1.Item
+}
+
+// AddDependency declares that the [statusthingv1.Item] with the provided item id depends on the [statusthingv1.Item] with the provided depends on id
+// adding a dependency that already exists returns the existing dependency
+// [serrors.ErrCycle] is returned if the dependency would create a cycle
+func (sts *StatusThingService) AddDependency(ctx context.Context, itemID, dependsOnID string) (*statusthingv1.ItemDependency, error) {
+	if sts.store == nil {
+		return nil, serrors.NewError("store", serrors.ErrStoreUnavailable)
+	}
+	if !validation.ValidString(itemID) {
+		return nil, serrors.NewError("itemID", serrors.ErrEmptyString)
+	}
+	if !validation.ValidString(dependsOnID) {
+		return nil, serrors.NewError("dependsOnID", serrors.ErrEmptyString)
+	}
+	if itemID == dependsOnID {
+		return nil, serrors.NewError("dependency", serrors.ErrCycle)
+	}
+	var res *statusthingv1.ItemDependency
+	// checking for cycles and storing the edge happen together so concurrent changes can't sneak a cycle in
+	txerr := sts.withTx(ctx, func(store storers.StatusThingStorer) error {
+		for _, id := range []string{itemID, dependsOnID} {
+			if _, err := store.GetItem(ctx, id); err != nil {
+				return err
+			}
+		}
+		deps, err := store.FindDependencies(ctx)
+		if err != nil {
+			return err
+		}
+		for _, dep := range deps {
+			if dep.GetItemId() == itemID && dep.GetDependsOnId() == dependsOnID {
+				res = dep
+				return nil
+			}
+		}
+		// the new edge creates a cycle if the item is already reachable from what it will depend on
+		if _, ok := reachable(dependencyEdges(deps), dependsOnID)[itemID]; ok {
+			return serrors.NewError("dependency", serrors.ErrCycle)
+		}
+		res, err = store.StoreDependency(ctx, &statusthingv1.ItemDependency{
+			ItemId:      itemID,
+			DependsOnId: dependsOnID,
+			Timestamps:  makeTsNow(),
+		})
+		return err
+	})
+	if txerr != nil {
+		return nil, txerr
+	}
+	return res, nil
+}
+
+// RemoveDependency removes the dependency of the [statusthingv1.Item] with the provided item id on the [statusthingv1.Item] with the provided depends on id
+func (sts *StatusThingService) RemoveDependency(ctx context.Context, itemID, dependsOnID string) error {
+	if sts.store == nil {
+		return serrors.NewError("store", serrors.ErrStoreUnavailable)
+	}
+	if !validation.ValidString(itemID) {
+		return serrors.NewError("itemID", serrors.ErrEmptyString)
+	}
+	if !validation.ValidString(dependsOnID) {
+		return serrors.NewError("dependsOnID", serrors.ErrEmptyString)
+	}
+	return sts.store.DeleteDependency(ctx, itemID, dependsOnID)
+}
+
+// FindDependencies returns all known [statusthingv1.ItemDependency]
+// supported filters:
+// - [filters.WithItemID]: only return the dependencies of the provided item id
+func (sts *StatusThingService) FindDependencies(ctx context.Context, opts ...filters.FilterOption) ([]*statusthingv1.ItemDependency, error) {
+	if sts.store == nil {
+		return nil, fmt.Errorf("store was nil: %w", serrors.ErrStoreUnavailable)
+	}
+	return sts.store.FindDependencies(ctx, opts...)
+}
+
+// GetItemImpact returns the [ItemImpact] of the [statusthingv1.Item] with the provided id
+func (sts *StatusThingService) GetItemImpact(ctx context.Context, itemID string) (*ItemImpact, error) {
+	if sts.store == nil {
+		return nil, fmt.Errorf("store was nil: %w", serrors.ErrStoreUnavailable)
+	}
+	if !validation.ValidString(itemID) {
+		return nil, serrors.NewError("itemID", serrors.ErrEmptyString)
+	}
+	all, err := sts.FindItems(ctx)
+	if err != nil {
+		return nil, err
+	}
+	deps, err := sts.store.FindDependencies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := map[string]*statusthingv1.Item{}
+	for _, item := range all {
+		byID[item.GetId()] = item
+	}
+	item, ok := byID[itemID]
+	if !ok {
+		return nil, serrors.NewError("item", serrors.ErrNotFound)
+	}
+	res := &ItemImpact{
+		Item:             item,
+		Impacted:         []*statusthingv1.Item{},
+		DownDependencies: downDependencies(itemID, dependencyEdges(deps), byID),
+	}
+	// walking the edges in reverse gives us everything depending on this item
+	dependents := map[string][]string{}
+	for _, dep := range deps {
+		dependents[dep.GetDependsOnId()] = append(dependents[dep.GetDependsOnId()], dep.GetItemId())
+	}
+	impacted := reachable(dependents, itemID)
+	for _, candidate := range all {
+		if _, ok := impacted[candidate.GetId()]; ok {
+			res.Impacted = append(res.Impacted, candidate)
+		}
+	}
+	return res, nil
+}
+
+// applyDerivedStatus populates the rollup status and effective status of the provided items
+// all items and dependencies are loaded from the store so that results are accurate even when the provided items are filtered
+func (sts *StatusThingService) applyDerivedStatus(ctx context.Context, items ...*statusthingv1.Item) error {
+	all, err := sts.store.FindItems(ctx)
+	if err != nil {
+		return err
+	}
+	deps, err := sts.store.FindDependencies(ctx)
+	if err != nil && !errors.Is(err, serrors.ErrNotImplemented) {
+		return err
+	}
+	rollups := computeRollups(all)
+	byID := map[string]*statusthingv1.Item{}
+	for _, item := range all {
+		item.RollupStatus = rollups[item.GetId()]
+		byID[item.GetId()] = item
+	}
+	edges := dependencyEdges(deps)
+	for _, item := range items {
+		item.RollupStatus = rollups[item.GetId()]
+		item.EffectiveStatus = impactedStatus(effectiveStatus(item), downDependencies(item.GetId(), edges, byID))
+	}
+	return nil
+}
+
+// impactedStatus returns the status to use for an item with the provided status and down dependencies
+// the provided status is returned if there are no down dependencies or it is already at least a warning
+func impactedStatus(status *statusthingv1.Status, down []*statusthingv1.Item) *statusthingv1.Status {
+	if len(down) == 0 || kindSeverity[status.GetKind()] >= kindSeverity[statusthingv1.StatusKind_STATUS_KIND_WARNING] {
+		return status
+	}
+	names := []string{}
+	for _, item := range down {
+		names = append(names, item.GetName())
+	}
+	return &statusthingv1.Status{
+		Name:        degradedStatusName,
+		Description: fmt.Sprintf("depends on down items: %s", strings.Join(names, ", ")),
+		Kind:        statusthingv1.StatusKind_STATUS_KIND_WARNING,
+		Color:       yellow,
+	}
+}
+
+// downDependencies returns the items the provided item id directly or transitively depends on that are down
+// items are considered down when their rollup or own status is at least as severe as [statusthingv1.StatusKind_STATUS_KIND_DOWN]
+func downDependencies(itemID string, edges map[string][]string, byID map[string]*statusthingv1.Item) []*statusthingv1.Item {
+	res := []*statusthingv1.Item{}
+	for _, id := range reachableOrdered(edges, itemID) {
+		dep, ok := byID[id]
+		if !ok {
+			continue
+		}
+		if kindSeverity[effectiveStatus(dep).GetKind()] >= kindSeverity[statusthingv1.StatusKind_STATUS_KIND_DOWN] {
+			res = append(res, dep)
+		}
+	}
+	return res
+}
+
+// dependencyEdges maps item ids to the ids of the items they depend on
+func dependencyEdges(deps []*statusthingv1.ItemDependency) map[string][]string {
+	res := map[string][]string{}
+	for _, dep := range deps {
+		res[dep.GetItemId()] = append(res[dep.GetItemId()], dep.GetDependsOnId())
+	}
+	return res
+}
+
+// reachable returns the set of ids reachable from the provided id following the provided edges
+// the starting id is only included if it can reach itself
+func reachable(edges map[string][]string, from string) map[string]struct{} {
+	res := map[string]struct{}{}
+	for _, id := range reachableOrdered(edges, from) {
+		res[id] = struct{}{}
+	}
+	return res
+}
+
+// reachableOrdered returns the ids reachable from the provided id in depth-first order
+func reachableOrdered(edges map[string][]string, from string) []string {
+	res := []string{}
+	seen := map[string]bool{}
+	var walk func(id string)
+	walk = func(id string) {
+		for _, next := range edges[id] {
+			if seen[next] {
+				continue
+			}
+			seen[next] = true
+			res = append(res, next)
+			walk(next)
+		}
+	}
+	walk(from)
+	return res
+}
@@ -0,0 +1,129 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	statusthingv1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
+	"github.com/lusis/statusthing/internal/filters"
+	"github.com/lusis/statusthing/internal/serrors"
+	"github.com/lusis/statusthing/internal/storers/memdb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImpactedStatus(t *testing.T) {
+	t.Parallel()
+	up := &statusthingv1.Status{Id: "up", Kind: statusthingv1.StatusKind_STATUS_KIND_UP}
+	down := &statusthingv1.Status{Id: "down", Kind: statusthingv1.StatusKind_STATUS_KIND_DOWN}
+	downItem := &statusthingv1.Item{Id: "db", Name: "db", Status: down}
+	testCases := map[string]struct {
+		status  *statusthingv1.Status
+		down    []*statusthingv1.Item
+		derived bool
+	}{
+		"no-down-dependencies": {status: up},
+		"nil-status":           {down: []*statusthingv1.Item{downItem}, derived: true},
+		"up-with-down":         {status: up, down: []*statusthingv1.Item{downItem}, derived: true},
+		"already-down":         {status: down, down: []*statusthingv1.Item{downItem}},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			res := impactedStatus(tc.status, tc.down)
+			if tc.derived {
+				require.Equal(t, statusthingv1.StatusKind_STATUS_KIND_WARNING, res.GetKind())
+				require.Equal(t, degradedStatusName, res.GetName())
+				require.Contains(t, res.GetDescription(), "db")
+			} else {
+				require.Equal(t, tc.status, res)
+			}
+		})
+	}
+}
+
+func TestItemDependencies(t *testing.T) {
+	ctx := context.TODO()
+	store, err := memdb.New()
+	require.NoError(t, err)
+	sts, err := NewStatusThingService(store)
+	require.NoError(t, err)
+	up, err := sts.AddStatus(ctx, "up", statusthingv1.StatusKind_STATUS_KIND_UP)
+	require.NoError(t, err)
+	down, err := sts.AddStatus(ctx, "down", statusthingv1.StatusKind_STATUS_KIND_DOWN)
+	require.NoError(t, err)
+
+	database, err := sts.AddItem(ctx, "database", filters.WithStatusID(up.GetId()))
+	require.NoError(t, err)
+	api, err := sts.AddItem(ctx, "api", filters.WithStatusID(up.GetId()))
+	require.NoError(t, err)
+	web, err := sts.AddItem(ctx, "web", filters.WithStatusID(up.GetId()))
+	require.NoError(t, err)
+
+	dep, err := sts.AddDependency(ctx, api.GetId(), database.GetId())
+	require.NoError(t, err)
+	require.Equal(t, api.GetId(), dep.GetItemId())
+	_, err = sts.AddDependency(ctx, web.GetId(), api.GetId())
+	require.NoError(t, err)
+
+	t.Run("idempotent", func(t *testing.T) {
+		res, err := sts.AddDependency(ctx, api.GetId(), database.GetId())
+		require.NoError(t, err)
+		require.Equal(t, dep.GetItemId(), res.GetItemId())
+		all, err := sts.FindDependencies(ctx)
+		require.NoError(t, err)
+		require.Len(t, all, 2)
+	})
+	t.Run("self-cycle", func(t *testing.T) {
+		_, err := sts.AddDependency(ctx, api.GetId(), api.GetId())
+		require.ErrorIs(t, err, serrors.ErrCycle)
+	})
+	t.Run("transitive-cycle", func(t *testing.T) {
+		_, err := sts.AddDependency(ctx, database.GetId(), web.GetId())
+		require.ErrorIs(t, err, serrors.ErrCycle)
+	})
+	t.Run("missing-item", func(t *testing.T) {
+		_, err := sts.AddDependency(ctx, api.GetId(), "missing")
+		require.ErrorIs(t, err, serrors.ErrNotFound)
+	})
+	t.Run("healthy", func(t *testing.T) {
+		res, err := sts.GetItem(ctx, web.GetId())
+		require.NoError(t, err)
+		require.Equal(t, up.GetId(), res.GetEffectiveStatus().GetId())
+	})
+	t.Run("propagates-down", func(t *testing.T) {
+		require.NoError(t, sts.EditItem(ctx, database.GetId(), filters.WithStatusID(down.GetId())))
+		items, err := sts.FindItems(ctx)
+		require.NoError(t, err)
+		for _, item := range items {
+			switch item.GetId() {
+			case database.GetId():
+				require.Equal(t, down.GetId(), item.GetEffectiveStatus().GetId())
+			default:
+				require.Equal(t, statusthingv1.StatusKind_STATUS_KIND_WARNING, item.GetEffectiveStatus().GetKind())
+				require.Equal(t, up.GetId(), item.GetStatus().GetId(), "own status should be unchanged")
+			}
+		}
+	})
+	t.Run("impact", func(t *testing.T) {
+		res, err := sts.GetItemImpact(ctx, database.GetId())
+		require.NoError(t, err)
+		require.Equal(t, database.GetId(), res.Item.GetId())
+		require.Len(t, res.Impacted, 2)
+		require.Len(t, res.DownDependencies, 0)
+
+		webres, err := sts.GetItemImpact(ctx, web.GetId())
+		require.NoError(t, err)
+		require.Len(t, webres.Impacted, 0)
+		require.Len(t, webres.DownDependencies, 1)
+		require.Equal(t, database.GetId(), webres.DownDependencies[0].GetId())
+
+		_, err = sts.GetItemImpact(ctx, "missing")
+		require.ErrorIs(t, err, serrors.ErrNotFound)
+	})
+	t.Run("remove", func(t *testing.T) {
+		require.NoError(t, sts.RemoveDependency(ctx, api.GetId(), database.GetId()))
+		res, err := sts.GetItem(ctx, web.GetId())
+		require.NoError(t, err)
+		require.Equal(t, up.GetId(), res.GetEffectiveStatus().GetId())
+		require.ErrorIs(t, sts.RemoveDependency(ctx, api.GetId(), database.GetId()), serrors.ErrNotFound)
+	})
+}
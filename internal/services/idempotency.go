@@ -0,0 +1,113 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"golang.org/x/exp/slog"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/lusis/statusthing/internal/serrors"
+	"github.com/lusis/statusthing/internal/storers"
+	"github.com/lusis/statusthing/internal/validation"
+)
+
+// DefaultIdempotencyTTL is how long idempotency keys are kept unless overridden with [WithIdempotencyTTL]
+const DefaultIdempotencyTTL = 24 * time.Hour
+
+// idempotencyLease is how long a key stays claimed by a request that hasn't stored its response
+// after that the request is assumed to have died with the server and a retry can claim the key again
+const idempotencyLease = time.Minute
+
+// Idempotent calls fn at most once for the provided idempotency key
+// fn is expected to populate res, which is stored so that retries with the same key and request get the same res back without calling fn again
+// - [serrors.ErrIdempotencyMismatch] is returned if the key was used with a different request
+// - [serrors.ErrConflict] is returned if a request with the key is still being handled, for at most [idempotencyLease]
+// if fn fails nothing is stored and the key can be retried
+// if fn succeeds but its response can't be stored the success is still returned and the key can be retried once the lease is over
+// fn is always called if the key is empty
+func (sts *StatusThingService) Idempotent(ctx context.Context, key string, req proto.Message, res proto.Message, fn func() error) error {
+	if !validation.ValidString(key) {
+		return fn()
+	}
+	if sts.store == nil {
+		return serrors.NewError("store", serrors.ErrStoreUnavailable)
+	}
+	hash, err := requestHash(req)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	existing, err := sts.store.GetIdempotencyRecord(ctx, key)
+	switch {
+	case err == nil && existing.Expires.After(now):
+		if existing.RequestHash != hash {
+			return serrors.NewError("idempotency key", serrors.ErrIdempotencyMismatch)
+		}
+		if len(existing.Response) == 0 && now.Sub(existing.Created) < idempotencyLease {
+			return serrors.NewError("idempotency key in progress", serrors.ErrConflict)
+		}
+		if len(existing.Response) != 0 {
+			if err := proto.Unmarshal(existing.Response, res); err != nil {
+				return serrors.NewWrappedError("idempotency response", serrors.ErrInvalidData, err)
+			}
+			return nil
+		}
+		// the claim outlived its lease so it is released like an expired key
+		if err := sts.store.DeleteIdempotencyRecord(ctx, key); err != nil && !errors.Is(err, serrors.ErrNotFound) {
+			return err
+		}
+	case err == nil:
+		// an expired key is treated as if it was never used
+		if err := sts.store.DeleteIdempotencyRecord(ctx, key); err != nil && !errors.Is(err, serrors.ErrNotFound) {
+			return err
+		}
+	case !errors.Is(err, serrors.ErrNotFound):
+		return err
+	}
+	if _, err := sts.store.DeleteExpiredIdempotencyRecords(ctx, now); err != nil {
+		slog.Warn("unable to delete expired idempotency keys", "error", err)
+	}
+	// the key is claimed before calling fn so concurrent retries get a conflict instead of a duplicate
+	if err := sts.store.StoreIdempotencyRecord(ctx, &storers.IdempotencyRecord{
+		Key:         key,
+		RequestHash: hash,
+		Created:     now,
+		Expires:     now.Add(sts.idempotencyTTL),
+	}); err != nil {
+		return err
+	}
+	if err := fn(); err != nil {
+		if derr := sts.store.DeleteIdempotencyRecord(ctx, key); derr != nil {
+			slog.Warn("unable to release idempotency key", "error", derr, "idempotency.key", key)
+		}
+		return err
+	}
+	// fn already made its changes so failing to store the response can't fail the request
+	response, err := proto.MarshalOptions{Deterministic: true}.Marshal(res)
+	if err == nil {
+		err = sts.store.UpdateIdempotencyRecord(ctx, key, response)
+	}
+	if err != nil {
+		slog.Warn("unable to store idempotency response", "error", err, "idempotency.key", key)
+	}
+	return nil
+}
+
+// requestHash identifies a request by its type and contents
+func requestHash(req proto.Message) (string, error) {
+	if req == nil {
+		return "", serrors.NewError("request", serrors.ErrNilVal)
+	}
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return "", serrors.NewWrappedError("request", serrors.ErrUnrecoverable, err)
+	}
+	h := sha256.New()
+	h.Write([]byte(req.ProtoReflect().Descriptor().FullName()))
+	h.Write(b)
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,157 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	statusthingv1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
+	"github.com/lusis/statusthing/internal/serrors"
+	"github.com/lusis/statusthing/internal/storers"
+	"github.com/lusis/statusthing/internal/storers/memdb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIdempotent(t *testing.T) {
+	ctx := context.TODO()
+	newService := func(t *testing.T) (*StatusThingService, *memdb.Store) {
+		mem, err := memdb.New()
+		require.NoError(t, err)
+		sts, err := NewStatusThingService(mem)
+		require.NoError(t, err)
+		return sts, mem
+	}
+	// addItem returns a function that adds an item and counts how often it is called
+	addItem := func(sts *StatusThingService, calls *int, name string, res *statusthingv1.AddItemResponse) func() error {
+		return func() error {
+			*calls++
+			item, err := sts.AddItem(ctx, name)
+			if err != nil {
+				return err
+			}
+			res.Item = item
+			return nil
+		}
+	}
+
+	t.Run("replay", func(t *testing.T) {
+		sts, mem := newService(t)
+		calls := 0
+		req := &statusthingv1.AddItemRequest{Name: t.Name()}
+		first := &statusthingv1.AddItemResponse{}
+		require.NoError(t, sts.Idempotent(ctx, "some-key", req, first, addItem(sts, &calls, t.Name(), first)))
+		second := &statusthingv1.AddItemResponse{}
+		require.NoError(t, sts.Idempotent(ctx, "some-key", req, second, addItem(sts, &calls, t.Name(), second)))
+
+		require.Equal(t, 1, calls, "retries should not call the function again")
+		require.Equal(t, first.GetItem().GetId(), second.GetItem().GetId())
+		items, err := mem.FindItems(ctx)
+		require.NoError(t, err)
+		require.Len(t, items, 1)
+	})
+	t.Run("mismatch", func(t *testing.T) {
+		sts, _ := newService(t)
+		calls := 0
+		res := &statusthingv1.AddItemResponse{}
+		require.NoError(t, sts.Idempotent(ctx, "some-key", &statusthingv1.AddItemRequest{Name: t.Name()}, res, addItem(sts, &calls, t.Name(), res)))
+		err := sts.Idempotent(ctx, "some-key", &statusthingv1.AddItemRequest{Name: "other"}, res, addItem(sts, &calls, "other", res))
+		require.ErrorIs(t, err, serrors.ErrIdempotencyMismatch)
+		// the same fields on a different request type don't match either
+		err = sts.Idempotent(ctx, "some-key", &statusthingv1.AddStatusRequest{Name: t.Name()}, &statusthingv1.AddStatusResponse{}, func() error { return nil })
+		require.ErrorIs(t, err, serrors.ErrIdempotencyMismatch)
+		require.Equal(t, 1, calls)
+	})
+	t.Run("failure-releases-key", func(t *testing.T) {
+		sts, _ := newService(t)
+		req := &statusthingv1.AddItemRequest{Name: t.Name()}
+		err := sts.Idempotent(ctx, "some-key", req, &statusthingv1.AddItemResponse{}, func() error {
+			return fmt.Errorf("transient: %w", serrors.ErrStoreUnavailable)
+		})
+		require.ErrorIs(t, err, serrors.ErrStoreUnavailable)
+		calls := 0
+		res := &statusthingv1.AddItemResponse{}
+		require.NoError(t, sts.Idempotent(ctx, "some-key", req, res, addItem(sts, &calls, t.Name(), res)))
+		require.Equal(t, 1, calls)
+	})
+	t.Run("in-progress", func(t *testing.T) {
+		sts, _ := newService(t)
+		req := &statusthingv1.AddItemRequest{Name: t.Name()}
+		err := sts.Idempotent(ctx, "some-key", req, &statusthingv1.AddItemResponse{}, func() error {
+			// a retry arriving while the first request is still running
+			return sts.Idempotent(ctx, "some-key", req, &statusthingv1.AddItemResponse{}, func() error { return nil })
+		})
+		require.ErrorIs(t, err, serrors.ErrConflict)
+	})
+	t.Run("abandoned", func(t *testing.T) {
+		sts, mem := newService(t)
+		now := time.Now()
+		req := &statusthingv1.AddItemRequest{Name: t.Name()}
+		hash, err := requestHash(req)
+		require.NoError(t, err)
+		// a claim left behind by a request that never stored its response
+		require.NoError(t, mem.StoreIdempotencyRecord(ctx, &storers.IdempotencyRecord{
+			Key:         "some-key",
+			RequestHash: hash,
+			Created:     now.Add(-2 * idempotencyLease),
+			Expires:     now.Add(time.Hour),
+		}))
+		calls := 0
+		res := &statusthingv1.AddItemResponse{}
+		require.NoError(t, sts.Idempotent(ctx, "some-key", req, res, addItem(sts, &calls, t.Name(), res)))
+		require.Equal(t, 1, calls, "a claim past its lease can be taken over")
+		replay := &statusthingv1.AddItemResponse{}
+		require.NoError(t, sts.Idempotent(ctx, "some-key", req, replay, addItem(sts, &calls, t.Name(), replay)))
+		require.Equal(t, 1, calls)
+		require.Equal(t, res.GetItem().GetId(), replay.GetItem().GetId())
+	})
+	t.Run("response-not-stored", func(t *testing.T) {
+		mem, err := memdb.New()
+		require.NoError(t, err)
+		sts, err := NewStatusThingService(&failingResponseStore{Store: mem})
+		require.NoError(t, err)
+		calls := 0
+		res := &statusthingv1.AddItemResponse{}
+		require.NoError(t, sts.Idempotent(ctx, "some-key", &statusthingv1.AddItemRequest{Name: t.Name()}, res, addItem(sts, &calls, t.Name(), res)), "the request succeeded even though its response wasn't stored")
+		require.Equal(t, 1, calls)
+		require.NotNil(t, res.GetItem())
+	})
+	t.Run("expired", func(t *testing.T) {
+		sts, mem := newService(t)
+		now := time.Now()
+		req := &statusthingv1.AddItemRequest{Name: t.Name()}
+		hash, err := requestHash(&statusthingv1.AddItemRequest{Name: "other"})
+		require.NoError(t, err)
+		require.NoError(t, mem.StoreIdempotencyRecord(ctx, &storers.IdempotencyRecord{
+			Key:         "some-key",
+			RequestHash: hash,
+			Created:     now.Add(-2 * time.Hour),
+			Expires:     now.Add(-time.Hour),
+		}))
+		calls := 0
+		res := &statusthingv1.AddItemResponse{}
+		require.NoError(t, sts.Idempotent(ctx, "some-key", req, res, addItem(sts, &calls, t.Name(), res)))
+		require.Equal(t, 1, calls, "an expired key can be reused for a different request")
+	})
+	t.Run("no-key", func(t *testing.T) {
+		sts, _ := newService(t)
+		calls := 0
+		for i := 0; i < 2; i++ {
+			require.NoError(t, sts.Idempotent(ctx, "", nil, nil, func() error { calls++; return nil }))
+		}
+		require.Equal(t, 2, calls)
+	})
+	t.Run("invalid-ttl", func(t *testing.T) {
+		_, err := NewStatusThingService(&testStatusThingStore{}, WithIdempotencyTTL(0))
+		require.ErrorIs(t, err, serrors.ErrAtLeastOne)
+	})
+}
+
+// failingResponseStore can't store idempotency responses
+type failingResponseStore struct {
+	*memdb.Store
+}
+
+func (s *failingResponseStore) UpdateIdempotencyRecord(_ context.Context, _ string, _ []byte) error {
+	return serrors.NewError("idempotency response", serrors.ErrStoreUnavailable)
+}
@@ -7,6 +7,7 @@ import (
 	statusthingv1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
 	"github.com/lusis/statusthing/internal/filters"
 	"github.com/lusis/statusthing/internal/serrors"
+	"github.com/lusis/statusthing/internal/storers"
 	"github.com/lusis/statusthing/internal/validation"
 
 	"github.com/segmentio/ksuid"
@@ -19,6 +20,8 @@ import (
 // - [filters.WithItemID] sets a custom unique id. default is generated via [ksuid.New().String()]
 // - [filters.WithNoteText] sets the note text for an initial note to create along with the item
 // - [filters.WithStatus] creates a new [statusthingv1.Status] before creating the item and sets the items status to that new status
+// - [filters.WithParentID] groups the new item under the [statusthingv1.Item] with the provided id
+// - [filters.WithRollupPolicy] sets how the status of child items is rolled up into the new item
 func (sts *StatusThingService) AddItem(ctx context.Context, name string, opts ...filters.FilterOption) (*statusthingv1.Item, error) {
 	if sts.store == nil {
 		return nil, serrors.NewError("store", serrors.ErrStoreUnavailable)
@@ -41,6 +44,9 @@ func (sts *StatusThingService) AddItem(ctx context.Context, name string, opts ..
 	status := f.Status()
 	desc := f.Description()
 	noteText := f.NoteText()
+	if err := checkNoteText(noteText); err != nil {
+		return nil, err
+	}
 
 	// this next bit gets unfortunately a bit convoluted due to flexibility
 	// first we check if they have a status id provided
@@ -67,16 +73,40 @@ func (sts *StatusThingService) AddItem(ctx context.Context, name string, opts ..
 	if desc != "" {
 		thing.Description = desc
 	}
-	res, err := sts.store.StoreItem(ctx, thing)
-	if err != nil {
-		return nil, err
+	if validation.ValidString(f.ParentID()) {
+		if err := sts.checkParent(ctx, id, f.ParentID()); err != nil {
+			return nil, err
+		}
+		thing.ParentId = f.ParentID()
 	}
+	thing.RollupPolicy = f.RollupPolicy()
 
-	if validation.ValidString(noteText) {
-		_, nerr := sts.AddNote(ctx, res.GetId(), f.NoteText())
-		if nerr != nil {
-			return nil, nerr
+	// the item, any new status and the initial note are created together or not at all
+	var res *statusthingv1.Item
+	txerr := sts.withTx(ctx, func(store storers.StatusThingStorer) error {
+		created, err := store.StoreItem(ctx, thing)
+		if err != nil {
+			return err
 		}
+		res = created
+		if validation.ValidString(noteText) {
+			note := &statusthingv1.Note{
+				Id:         ksuid.New().String(),
+				Text:       noteText,
+				Author:     noteAuthor(ctx),
+				Timestamps: makeTsNow(),
+			}
+			if _, err := store.StoreNote(ctx, note, created.GetId()); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if txerr != nil {
+		return nil, txerr
+	}
+
+	if validation.ValidString(noteText) {
 		// do a fresh get to include the notes
 		return sts.GetItem(ctx, res.GetId())
 	}
@@ -84,10 +114,20 @@ func (sts *StatusThingService) AddItem(ctx context.Context, name string, opts ..
 }
 
 // EditItem updates the [statusthingv1.Item] with the provided id
+// changing the parent via [filters.WithParentID] is rejected with [serrors.ErrCycle] if it would create a cycle
 func (sts *StatusThingService) EditItem(ctx context.Context, itemID string, opts ...filters.FilterOption) error {
 	if sts.store == nil {
 		return serrors.NewError("store", serrors.ErrStoreUnavailable)
 	}
+	f, err := filters.New(opts...)
+	if err != nil {
+		return err
+	}
+	if validation.ValidString(f.ParentID()) {
+		if err := sts.checkParent(ctx, itemID, f.ParentID()); err != nil {
+			return err
+		}
+	}
 	return sts.store.UpdateItem(ctx, itemID, opts...)
 }
 
@@ -106,18 +146,46 @@ func (sts *StatusThingService) RemoveItem(ctx context.Context, itemID string) er
 // supported filters:
 // - [filters.WithStatusIDs]: only return results having the provided status ids
 // - [filters.WithStatusKinds]: only return restuls having the provided status kinds
+// - [filters.WithParentID]: only return results that are children of the provided item id
 // StatusIDs and StatusKinds are mutually exclusive
+// the rollup status of any item with children and the effective status of every item is populated
 func (sts *StatusThingService) FindItems(ctx context.Context, opts ...filters.FilterOption) ([]*statusthingv1.Item, error) {
 	if sts.store == nil {
 		return nil, fmt.Errorf("store was nil: %w", serrors.ErrStoreUnavailable)
 	}
-	return sts.store.FindItems(ctx, opts...)
+	res, err := sts.store.FindItems(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if err := sts.applyDerivedStatus(ctx, res...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ItemTree returns the known [statusthingv1.Item] as a tree
+// only top-level items are returned and child items are nested under their parent
+// supports the same filters as [StatusThingService.FindItems]
+func (sts *StatusThingService) ItemTree(ctx context.Context, opts ...filters.FilterOption) ([]*statusthingv1.Item, error) {
+	res, err := sts.FindItems(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return buildItemTree(res), nil
 }
 
 // GetItem gets a [statusthingv1.Item] by id
+// the rollup status is populated if the item has children along with the effective status
 func (sts *StatusThingService) GetItem(ctx context.Context, itemID string) (*statusthingv1.Item, error) {
 	if sts.store == nil {
 		return nil, fmt.Errorf("store was nil: %w", serrors.ErrStoreUnavailable)
 	}
-	return sts.store.GetItem(ctx, itemID)
+	res, err := sts.store.GetItem(ctx, itemID)
+	if err != nil {
+		return nil, err
+	}
+	if err := sts.applyDerivedStatus(ctx, res); err != nil {
+		return nil, err
+	}
+	return res, nil
 }
@@ -7,6 +7,7 @@ import (
 	statusthingv1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
 	"github.com/lusis/statusthing/internal/filters"
 	"github.com/lusis/statusthing/internal/serrors"
+	"github.com/lusis/statusthing/internal/storers"
 	"github.com/lusis/statusthing/internal/storers/memdb"
 	"github.com/lusis/statusthing/internal/testutils"
 	"github.com/stretchr/testify/require"
@@ -265,3 +266,40 @@ func TestAllItems(t *testing.T) {
 
 	})
 }
+
+// failingNoteStore is a transactional store that fails to store notes
+type failingNoteStore struct {
+	*memdb.Store
+}
+
+func (fs *failingNoteStore) StoreNote(_ context.Context, _ *statusthingv1.Note, _ string) (*statusthingv1.Note, error) {
+	return nil, serrors.ErrUnrecoverable
+}
+
+func (fs *failingNoteStore) WithTx(ctx context.Context, fn func(storers.StatusThingStorer) error) error {
+	return fs.Store.WithTx(ctx, func(tx storers.StatusThingStorer) error {
+		return fn(&failingNoteStore{Store: tx.(*memdb.Store)})
+	})
+}
+
+func TestAddItemTransaction(t *testing.T) {
+	ctx := context.TODO()
+	mem, err := memdb.New()
+	require.NoError(t, err)
+	sts, err := NewStatusThingService(&failingNoteStore{Store: mem})
+	require.NoError(t, err)
+
+	res, err := sts.AddItem(ctx, t.Name(),
+		filters.WithStatus(&statusthingv1.Status{Name: t.Name(), Kind: statusthingv1.StatusKind_STATUS_KIND_UP}),
+		filters.WithNoteText("this note will fail"),
+	)
+	require.ErrorIs(t, err, serrors.ErrUnrecoverable)
+	require.Nil(t, res)
+
+	items, err := mem.FindItems(ctx)
+	require.NoError(t, err)
+	require.Len(t, items, 0, "item should not be left behind when the note fails")
+	statuses, err := mem.FindStatus(ctx)
+	require.NoError(t, err)
+	require.Len(t, statuses, 0, "status should not be left behind when the note fails")
+}
@@ -4,17 +4,34 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/segmentio/ksuid"
 
 	statusthingv1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
+	"github.com/lusis/statusthing/internal/auth"
 	"github.com/lusis/statusthing/internal/filters"
 	"github.com/lusis/statusthing/internal/serrors"
 	"github.com/lusis/statusthing/internal/validation"
 )
 
+// noteTextMaxLength is the most characters a note can have
+const noteTextMaxLength = 10000
+
+// checkNoteText returns [serrors.ErrTooLarge] if the note text is longer than [noteTextMaxLength]
+func checkNoteText(noteText string) error {
+	if utf8.RuneCountInString(noteText) > noteTextMaxLength {
+		return serrors.NewError("noteText", serrors.ErrTooLarge)
+	}
+	return nil
+}
+
 // AddNote adds the provided text as a [statusthingv1.Note] to the [statusthingv1.Item] with the provided id
-func (sts *StatusThingService) AddNote(ctx context.Context, itemID, noteText string) (*statusthingv1.Note, error) {
+// the author is the [auth.Identity] carried by the provided context, if any
+// supported opts:
+// [filters.WithNoteVisibility] to set who can see the note. defaults to public
+// [filters.WithPinned] to pin the note
+func (sts *StatusThingService) AddNote(ctx context.Context, itemID, noteText string, opts ...filters.FilterOption) (*statusthingv1.Note, error) {
 	if sts.store == nil {
 		return nil, fmt.Errorf("store was nil: %w", serrors.ErrStoreUnavailable)
 	}
@@ -24,6 +41,13 @@ func (sts *StatusThingService) AddNote(ctx context.Context, itemID, noteText str
 	if !validation.ValidString(noteText) {
 		return nil, serrors.NewError("noteText", serrors.ErrEmptyString)
 	}
+	if err := checkNoteText(noteText); err != nil {
+		return nil, err
+	}
+	f, err := filters.New(opts...)
+	if err != nil {
+		return nil, err
+	}
 	if _, err := sts.GetItem(ctx, itemID); err != nil {
 		return nil, err
 	}
@@ -32,13 +56,20 @@ func (sts *StatusThingService) AddNote(ctx context.Context, itemID, noteText str
 		Timestamps: makeTsNow(),
 		Id:         id,
 		Text:       noteText,
+		Author:     noteAuthor(ctx),
+		Visibility: f.NoteVisibility(),
 	}
+	note.Pinned, _ = f.Pinned()
 	return sts.store.StoreNote(ctx, note, itemID)
 }
 
 // EditNote edits the [statusthingv1.Note] with provided id to set the text to provided text
+// the text can be empty when only the visibility or pinned flag are changed
 // supported opts:
+// [filters.WithNoteVisibility] to change who can see the note
+// [filters.WithPinned] to pin or unpin the note
 // [filters.WithTimestamps] to override the timestamps (generally for testing)
+// [filters.WithExpectedVersion] to only edit the note if it hasn't changed since
 func (sts *StatusThingService) EditNote(ctx context.Context, noteID, noteText string, opts ...filters.FilterOption) error {
 	if sts.store == nil {
 		return fmt.Errorf("store was nil: %w", serrors.ErrStoreUnavailable)
@@ -46,20 +77,35 @@ func (sts *StatusThingService) EditNote(ctx context.Context, noteID, noteText st
 	if strings.TrimSpace(noteID) == "" {
 		return fmt.Errorf("noteID: %w", serrors.ErrEmptyString)
 	}
-	if strings.TrimSpace(noteText) == "" {
-		return fmt.Errorf("noteText: %w", serrors.ErrEmptyString)
-	}
 	f, err := filters.New(opts...)
 	if err != nil {
 		return err
 	}
+	pinned, pinnedSet := f.Pinned()
+	visibility := f.NoteVisibility()
+	if strings.TrimSpace(noteText) == "" && !pinnedSet && visibility == statusthingv1.NoteVisibility_NOTE_VISIBILITY_UNKNOWN {
+		return fmt.Errorf("noteText: %w", serrors.ErrEmptyString)
+	}
+	if err := checkNoteText(noteText); err != nil {
+		return err
+	}
 
-	allowedOpts := []filters.FilterOption{
-		filters.WithNoteText(noteText),
+	allowedOpts := []filters.FilterOption{}
+	if strings.TrimSpace(noteText) != "" {
+		allowedOpts = append(allowedOpts, filters.WithNoteText(noteText))
+	}
+	if visibility != statusthingv1.NoteVisibility_NOTE_VISIBILITY_UNKNOWN {
+		allowedOpts = append(allowedOpts, filters.WithNoteVisibility(visibility))
+	}
+	if pinnedSet {
+		allowedOpts = append(allowedOpts, filters.WithPinned(pinned))
 	}
 	if f.Timestamps() != nil {
 		allowedOpts = append(allowedOpts, filters.WithTimestamps(f.Timestamps()))
 	}
+	if f.ExpectedVersion() != 0 {
+		allowedOpts = append(allowedOpts, filters.WithExpectedVersion(f.ExpectedVersion()))
+	}
 
 	return sts.store.UpdateNote(ctx, noteID, allowedOpts...)
 }
@@ -100,3 +146,34 @@ func (sts *StatusThingService) GetNote(ctx context.Context, noteID string) (*sta
 	}
 	return sts.store.GetNote(ctx, noteID)
 }
+
+// ListNoteRevisions returns every earlier version of the [statusthingv1.Note] with the provided id, oldest first
+func (sts *StatusThingService) ListNoteRevisions(ctx context.Context, noteID string) ([]*statusthingv1.NoteRevision, error) {
+	if sts.store == nil {
+		return nil, serrors.NewError("store", serrors.ErrStoreUnavailable)
+	}
+	if !validation.ValidString(noteID) {
+		return nil, serrors.NewError("noteID", serrors.ErrEmptyString)
+	}
+	return sts.store.FindNoteRevisions(ctx, noteID)
+}
+
+// PublicNotes returns the provided notes without any that are [statusthingv1.NoteVisibility_NOTE_VISIBILITY_INTERNAL]
+func PublicNotes(notes []*statusthingv1.Note) []*statusthingv1.Note {
+	res := []*statusthingv1.Note{}
+	for _, note := range notes {
+		if note.GetVisibility() != statusthingv1.NoteVisibility_NOTE_VISIBILITY_INTERNAL {
+			res = append(res, note)
+		}
+	}
+	return res
+}
+
+// noteAuthor returns the name of the [auth.Identity] carried by the provided context or an empty string
+// it is only a display name: a username or certificate identity, not a user id
+func noteAuthor(ctx context.Context) string {
+	if id, ok := auth.FromContext(ctx); ok {
+		return id.Name
+	}
+	return ""
+}
@@ -2,6 +2,7 @@ package services
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
@@ -53,6 +54,17 @@ func TestAddNote(t *testing.T) {
 		require.ErrorIs(t, err, serrors.ErrEmptyString)
 		require.Nil(t, res)
 	})
+	t.Run("note-text-too-long", func(t *testing.T) {
+		sts, err := NewStatusThingService(&testStatusThingStore{
+			customStatuses: []*statusthingv1.Status{},
+		})
+		require.NoError(t, err, "should not error")
+		res, err := sts.AddNote(context.TODO(), t.Name(), strings.Repeat("x", noteTextMaxLength+1))
+		require.ErrorIs(t, err, serrors.ErrTooLarge)
+		require.Nil(t, res)
+		_, err = sts.AddItem(context.TODO(), t.Name(), filters.WithNoteText(strings.Repeat("x", noteTextMaxLength+1)))
+		require.ErrorIs(t, err, serrors.ErrTooLarge, "the initial note of an item should be checked too")
+	})
 }
 
 func TestEditNote(t *testing.T) {
@@ -85,6 +97,17 @@ func TestEditNote(t *testing.T) {
 		require.NotNil(t, testStore.lastUpdatedNote, "last note should be updated")
 		require.Equal(t, ts, testStore.lastUpdatedNote.GetTimestamps(), "timestamps should be equal")
 	})
+	t.Run("happy-path-expected-version", func(t *testing.T) {
+		testStore := &testStatusThingStore{
+			customStatuses: []*statusthingv1.Status{},
+		}
+		sts, err := NewStatusThingService(testStore)
+		require.NoError(t, err, "should not error")
+		err = sts.EditNote(context.TODO(), t.Name(), t.Name(), filters.WithExpectedVersion(3))
+		require.NoError(t, err, "should not error")
+		require.NotNil(t, testStore.lastUpdatedNote, "last note should be updated")
+		require.Equal(t, uint64(3), testStore.lastUpdatedNote.GetVersion(), "expected version should be passed to the store")
+	})
 	t.Run("missing-thing-id", func(t *testing.T) {
 		sts, err := NewStatusThingService(&testStatusThingStore{
 			customStatuses: []*statusthingv1.Status{},
@@ -103,6 +126,15 @@ func TestEditNote(t *testing.T) {
 		err = sts.EditNote(context.TODO(), t.Name(), "")
 		require.ErrorIs(t, err, serrors.ErrEmptyString)
 	})
+	t.Run("note-text-too-long", func(t *testing.T) {
+		sts, err := NewStatusThingService(&testStatusThingStore{
+			customStatuses: []*statusthingv1.Status{},
+		})
+		require.NoError(t, err, "should not error")
+		require.NoError(t, sts.EditNote(context.TODO(), t.Name(), strings.Repeat("é", noteTextMaxLength)), "the limit is in characters not bytes")
+		err = sts.EditNote(context.TODO(), t.Name(), strings.Repeat("x", noteTextMaxLength+1))
+		require.ErrorIs(t, err, serrors.ErrTooLarge)
+	})
 }
 func TestRemoveNote(t *testing.T) {
 	t.Run("nil-store", func(t *testing.T) {
@@ -1,5 +1,14 @@
 package services
 
+import (
+	"mime"
+	"time"
+
+	"github.com/lusis/statusthing/internal/serrors"
+	"github.com/lusis/statusthing/internal/storers"
+	"github.com/lusis/statusthing/internal/validation"
+)
+
 // ServiceOption is a functional option for configuring a [StatusThingService]
 type ServiceOption func(s *StatusThingService) error
 
@@ -10,3 +19,100 @@ func WithDefaults() ServiceOption {
 		return nil
 	}
 }
+
+// WithIdempotencyTTL sets how long idempotency keys are kept before they can be reused
+// defaults to [DefaultIdempotencyTTL]
+func WithIdempotencyTTL(ttl time.Duration) ServiceOption {
+	return func(s *StatusThingService) error {
+		if ttl <= 0 {
+			return serrors.NewError("idempotency ttl", serrors.ErrAtLeastOne)
+		}
+		s.idempotencyTTL = ttl
+		return nil
+	}
+}
+
+// WithBackupDir sets the directory [StatusThingService.Backup] writes backups to
+func WithBackupDir(dir string) ServiceOption {
+	return func(s *StatusThingService) error {
+		if !validation.ValidString(dir) {
+			return serrors.NewError("backup dir", serrors.ErrEmptyString)
+		}
+		s.backupDir = dir
+		return nil
+	}
+}
+
+// WithBackupRetention sets how many backups are kept in the backup dir
+// defaults to [DefaultBackupRetention]
+func WithBackupRetention(keep int) ServiceOption {
+	return func(s *StatusThingService) error {
+		if keep < 1 {
+			return serrors.NewError("backup retention", serrors.ErrAtLeastOne)
+		}
+		s.backupRetention = keep
+		return nil
+	}
+}
+
+// WithRetentionPolicy sets what [StatusThingService.Prune] removes
+// by default nothing but expired idempotency keys is removed
+func WithRetentionPolicy(policy RetentionPolicy) ServiceOption {
+	return func(s *StatusThingService) error {
+		if policy.NoteMaxAge < 0 {
+			return serrors.NewError("note max age", serrors.ErrAtLeastOne)
+		}
+		if policy.NoteMaxCount < 0 {
+			return serrors.NewError("note max count", serrors.ErrAtLeastOne)
+		}
+		s.retention = policy
+		return nil
+	}
+}
+
+// WithPruneInterval sets how often [StatusThingService.RunPruner] prunes
+func WithPruneInterval(interval time.Duration) ServiceOption {
+	return func(s *StatusThingService) error {
+		if interval <= 0 {
+			return serrors.NewError("prune interval", serrors.ErrAtLeastOne)
+		}
+		s.pruneInterval = interval
+		return nil
+	}
+}
+
+// WithBlobStorer sets where the contents of attachments are kept
+// defaults to the store itself when it is a [storers.BlobStorer]
+func WithBlobStorer(blobs storers.BlobStorer) ServiceOption {
+	return func(s *StatusThingService) error {
+		if blobs == nil {
+			return serrors.NewError("blob store", serrors.ErrNilVal)
+		}
+		s.blobs = blobs
+		return nil
+	}
+}
+
+// WithAttachmentLimits sets the largest attachment in bytes and the content types that can be attached
+// defaults to [DefaultAttachmentMaxSize] and [DefaultAttachmentTypes]. no types keeps the default types
+func WithAttachmentLimits(maxSize int64, contentTypes ...string) ServiceOption {
+	return func(s *StatusThingService) error {
+		if maxSize < 1 {
+			return serrors.NewError("attachment max size", serrors.ErrAtLeastOne)
+		}
+		s.attachmentMaxSize = maxSize
+		if len(contentTypes) == 0 {
+			return nil
+		}
+		types := make([]string, 0, len(contentTypes))
+		for _, ct := range contentTypes {
+			mediaType, _, err := mime.ParseMediaType(ct)
+			if err != nil {
+				return serrors.NewWrappedError("attachment type "+ct, serrors.ErrInvalidData, err)
+			}
+			types = append(types, mediaType)
+		}
+		s.attachmentTypes = types
+		return nil
+	}
+}
@@ -0,0 +1,189 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"sort"
+	"time"
+
+	"golang.org/x/exp/slog"
+
+	statusthingv1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
+	"github.com/lusis/statusthing/internal/serrors"
+	"github.com/lusis/statusthing/internal/storers"
+)
+
+// pruner metrics are published with [expvar] under the statusthing.pruner name
+var (
+	prunerMetrics             = expvar.NewMap("statusthing.pruner")
+	prunerRuns                = new(expvar.Int)
+	prunerErrors              = new(expvar.Int)
+	prunerNotes               = new(expvar.Int)
+	prunerIdempotencyKeys     = new(expvar.Int)
+	prunerSessions            = new(expvar.Int)
+	prunerBlobs               = new(expvar.Int)
+	prunerLastRun             = new(expvar.Int)
+	prunerLastDurationSeconds = new(expvar.Float)
+)
+
+func init() {
+	prunerMetrics.Set("runs", prunerRuns)
+	prunerMetrics.Set("errors", prunerErrors)
+	prunerMetrics.Set("pruned_notes", prunerNotes)
+	prunerMetrics.Set("pruned_idempotency_keys", prunerIdempotencyKeys)
+	prunerMetrics.Set("pruned_sessions", prunerSessions)
+	prunerMetrics.Set("pruned_blobs", prunerBlobs)
+	prunerMetrics.Set("last_run_unix", prunerLastRun)
+	prunerMetrics.Set("last_duration_seconds", prunerLastDurationSeconds)
+}
+
+// RetentionPolicy is how long data is kept before [StatusThingService.Prune] removes it
+// zero values mean keep forever
+type RetentionPolicy struct {
+	// NoteMaxAge removes notes created longer ago than this
+	// pinned notes are never removed
+	NoteMaxAge time.Duration
+	// NoteMaxCount keeps only this many of the newest unpinned notes on each item
+	NoteMaxCount int
+}
+
+// PruneSummary describes what [StatusThingService.Prune] removed or, for a dry run, would have removed
+type PruneSummary struct {
+	// NoteIDs are the ids of the removed notes
+	NoteIDs []string
+	// IdempotencyKeys is the number of expired idempotency keys removed
+	IdempotencyKeys int
+	// Sessions is the number of expired admin ui sessions removed
+	Sessions int
+	// Blobs is the number of attachment contents removed because their attachment no longer exists
+	Blobs int
+	// SkippedItemIDs are the ids of items with an open incident whose notes were kept
+	SkippedItemIDs []string
+	// DryRun is true when nothing was removed
+	DryRun bool
+}
+
+// Prune removes notes according to the [RetentionPolicy] along with expired idempotency keys and sessions
+// and the contents of attachments that no longer exist
+// notes on items with an open incident, meaning a status that isn't healthy, are never removed
+// when dryRun is true nothing is removed and the summary reports what would have been
+func (sts *StatusThingService) Prune(ctx context.Context, dryRun bool) (*PruneSummary, error) {
+	if sts.store == nil {
+		return nil, serrors.NewError("store", serrors.ErrStoreUnavailable)
+	}
+	if _, ok := sts.store.(storers.Transactor); dryRun && !ok {
+		// without a transaction there is nothing to roll back
+		return nil, serrors.NewError("dry run", serrors.ErrNotImplemented)
+	}
+	now := time.Now()
+	summary := &PruneSummary{DryRun: dryRun}
+	err := sts.withTx(ctx, func(store storers.StatusThingStorer) error {
+		if err := pruneNotes(ctx, store, sts.retention, now, summary); err != nil {
+			return err
+		}
+		deleted, err := store.DeleteExpiredIdempotencyRecords(ctx, now)
+		if err != nil && !errors.Is(err, serrors.ErrNotImplemented) {
+			return err
+		}
+		summary.IdempotencyKeys = deleted
+		sessions, err := store.DeleteExpiredSessions(ctx, now)
+		if err != nil && !errors.Is(err, serrors.ErrNotImplemented) {
+			return err
+		}
+		summary.Sessions = sessions
+		if dryRun {
+			return errDryRun
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errDryRun) {
+		return nil, err
+	}
+	// blobs may not be kept in the store so they are pruned after everything else is committed
+	summary.Blobs, err = sts.pruneBlobs(ctx, dryRun)
+	if err != nil {
+		return nil, err
+	}
+	return summary, nil
+}
+
+// RunPruner calls [StatusThingService.Prune] every prune interval until the provided context is done
+// it returns immediately if no interval was set with [WithPruneInterval]
+// results are published with [expvar] under the statusthing.pruner name
+func (sts *StatusThingService) RunPruner(ctx context.Context) {
+	if sts.pruneInterval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(sts.pruneInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			start := time.Now()
+			summary, err := sts.Prune(ctx, false)
+			prunerRuns.Add(1)
+			prunerLastRun.Set(start.Unix())
+			prunerLastDurationSeconds.Set(time.Since(start).Seconds())
+			if err != nil {
+				prunerErrors.Add(1)
+				slog.Error("pruning failed", "error", err)
+				continue
+			}
+			prunerNotes.Add(int64(len(summary.NoteIDs)))
+			prunerIdempotencyKeys.Add(int64(summary.IdempotencyKeys))
+			prunerSessions.Add(int64(summary.Sessions))
+			prunerBlobs.Add(int64(summary.Blobs))
+			slog.Info("pruning complete", "pruned.notes", len(summary.NoteIDs), "pruned.idempotency_keys", summary.IdempotencyKeys, "pruned.sessions", summary.Sessions, "pruned.blobs", summary.Blobs)
+		}
+	}
+}
+
+func pruneNotes(ctx context.Context, store storers.StatusThingStorer, policy RetentionPolicy, now time.Time, summary *PruneSummary) error {
+	if policy.NoteMaxAge == 0 && policy.NoteMaxCount == 0 {
+		return nil
+	}
+	items, err := store.FindItems(ctx)
+	if err != nil {
+		return err
+	}
+	for _, item := range items {
+		// pinned notes are kept and don't count towards the max count
+		notes := []*statusthingv1.Note{}
+		for _, note := range item.GetNotes() {
+			if !note.GetPinned() {
+				notes = append(notes, note)
+			}
+		}
+		if len(notes) == 0 {
+			continue
+		}
+		if openIncident(item) {
+			summary.SkippedItemIDs = append(summary.SkippedItemIDs, item.GetId())
+			continue
+		}
+		// newest first so everything past the max count is the oldest
+		sort.SliceStable(notes, func(i, j int) bool {
+			return notes[i].GetTimestamps().GetCreated().AsTime().After(notes[j].GetTimestamps().GetCreated().AsTime())
+		})
+		for i, note := range notes {
+			tooMany := policy.NoteMaxCount > 0 && i >= policy.NoteMaxCount
+			tooOld := policy.NoteMaxAge > 0 && now.Sub(note.GetTimestamps().GetCreated().AsTime()) > policy.NoteMaxAge
+			if !tooMany && !tooOld {
+				continue
+			}
+			if err := store.DeleteNote(ctx, note.GetId()); err != nil {
+				return err
+			}
+			summary.NoteIDs = append(summary.NoteIDs, note.GetId())
+		}
+	}
+	return nil
+}
+
+// openIncident is true when the item has a status that isn't healthy
+func openIncident(item *statusthingv1.Item) bool {
+	return kindSeverity[item.GetStatus().GetKind()] > 1
+}
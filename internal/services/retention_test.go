@@ -0,0 +1,120 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	statusthingv1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
+	"github.com/lusis/statusthing/internal/filters"
+	"github.com/lusis/statusthing/internal/serrors"
+	"github.com/lusis/statusthing/internal/storers/memdb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrune(t *testing.T) {
+	ctx := context.TODO()
+	now := time.Now()
+	// setup creates a healthy and a down item each with a note from every one of the last five days
+	// the note from three days ago is pinned
+	setup := func(t *testing.T, policy RetentionPolicy) (*StatusThingService, *statusthingv1.Item, *statusthingv1.Item) {
+		store, err := memdb.New()
+		require.NoError(t, err)
+		sts, err := NewStatusThingService(store, WithRetentionPolicy(policy))
+		require.NoError(t, err)
+		up, err := sts.AddStatus(ctx, "up", statusthingv1.StatusKind_STATUS_KIND_UP)
+		require.NoError(t, err)
+		down, err := sts.AddStatus(ctx, "down", statusthingv1.StatusKind_STATUS_KIND_DOWN)
+		require.NoError(t, err)
+		healthy, err := sts.AddItem(ctx, "healthy", filters.WithStatusID(up.GetId()))
+		require.NoError(t, err)
+		broken, err := sts.AddItem(ctx, "broken", filters.WithStatusID(down.GetId()))
+		require.NoError(t, err)
+		for _, item := range []*statusthingv1.Item{healthy, broken} {
+			for day := 0; day < 5; day++ {
+				created := timestamppb.New(now.Add(-time.Duration(day) * 24 * time.Hour))
+				_, err := store.StoreNote(ctx, &statusthingv1.Note{
+					Id:         fmt.Sprintf("%s-%d", item.GetName(), day),
+					Text:       "note",
+					Pinned:     day == 3,
+					Timestamps: &statusthingv1.Timestamps{Created: created, Updated: created},
+				}, item.GetId())
+				require.NoError(t, err)
+			}
+		}
+		return sts, healthy, broken
+	}
+
+	testCases := map[string]struct {
+		policy   RetentionPolicy
+		expected []string
+	}{
+		"no-policy": {},
+		"max-count": {
+			policy:   RetentionPolicy{NoteMaxCount: 2},
+			expected: []string{"healthy-2", "healthy-4"},
+		},
+		"max-age": {
+			policy:   RetentionPolicy{NoteMaxAge: 36 * time.Hour},
+			expected: []string{"healthy-2", "healthy-4"},
+		},
+		"both": {
+			policy:   RetentionPolicy{NoteMaxAge: 84 * time.Hour, NoteMaxCount: 1},
+			expected: []string{"healthy-1", "healthy-2", "healthy-4"},
+		},
+	}
+	for n, tc := range testCases {
+		t.Run(n, func(t *testing.T) {
+			sts, healthy, broken := setup(t, tc.policy)
+
+			dryRun, err := sts.Prune(ctx, true)
+			require.NoError(t, err)
+			require.True(t, dryRun.DryRun)
+			require.ElementsMatch(t, tc.expected, dryRun.NoteIDs)
+			notes, err := sts.FindNotes(ctx, healthy.GetId())
+			require.NoError(t, err)
+			require.Len(t, notes, 5, "dry run should not remove anything")
+
+			summary, err := sts.Prune(ctx, false)
+			require.NoError(t, err)
+			require.ElementsMatch(t, tc.expected, summary.NoteIDs)
+			notes, err = sts.FindNotes(ctx, healthy.GetId())
+			require.NoError(t, err)
+			require.Len(t, notes, 5-len(tc.expected))
+
+			notes, err = sts.FindNotes(ctx, broken.GetId())
+			require.NoError(t, err)
+			require.Len(t, notes, 5, "notes on items with an open incident should be kept")
+			if len(tc.expected) != 0 {
+				require.Equal(t, []string{broken.GetId()}, summary.SkippedItemIDs)
+			}
+		})
+	}
+	t.Run("invalid-policy", func(t *testing.T) {
+		_, err := NewStatusThingService(&testStatusThingStore{}, WithRetentionPolicy(RetentionPolicy{NoteMaxCount: -1}))
+		require.ErrorIs(t, err, serrors.ErrAtLeastOne)
+		_, err = NewStatusThingService(&testStatusThingStore{}, WithPruneInterval(0))
+		require.ErrorIs(t, err, serrors.ErrAtLeastOne)
+	})
+}
+
+func TestRunPruner(t *testing.T) {
+	store, err := memdb.New()
+	require.NoError(t, err)
+	sts, err := NewStatusThingService(store, WithPruneInterval(time.Millisecond))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	runs := prunerRuns.Value()
+	done := make(chan struct{})
+	go func() {
+		sts.RunPruner(ctx)
+		close(done)
+	}()
+	require.Eventually(t, func() bool { return prunerRuns.Value() > runs }, time.Second, time.Millisecond)
+	cancel()
+	<-done
+}
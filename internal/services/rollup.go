@@ -0,0 +1,170 @@
+package services
+
+import (
+	"context"
+
+	statusthingv1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
+	"github.com/lusis/statusthing/internal/serrors"
+	"github.com/lusis/statusthing/internal/validation"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// kindSeverity ranks [statusthingv1.StatusKind] from least to most severe for rolling up status
+// kinds not listed here (unknown, decomm) do not participate in rollups
+var kindSeverity = map[statusthingv1.StatusKind]int{
+	statusthingv1.StatusKind_STATUS_KIND_UP:            1,
+	statusthingv1.StatusKind_STATUS_KIND_AVAILABLE:     1,
+	statusthingv1.StatusKind_STATUS_KIND_ONLINE:        1,
+	statusthingv1.StatusKind_STATUS_KIND_CREATED:       1,
+	statusthingv1.StatusKind_STATUS_KIND_OBSERVING:     2,
+	statusthingv1.StatusKind_STATUS_KIND_INVESTIGATING: 3,
+	statusthingv1.StatusKind_STATUS_KIND_WARNING:       3,
+	statusthingv1.StatusKind_STATUS_KIND_UNAVAILABLE:   4,
+	statusthingv1.StatusKind_STATUS_KIND_OFFLINE:       5,
+	statusthingv1.StatusKind_STATUS_KIND_DOWN:          5,
+}
+
+// effectiveStatus is the status an item contributes to its parent's rollup
+func effectiveStatus(item *statusthingv1.Item) *statusthingv1.Status {
+	if item.GetRollupStatus() != nil {
+		return item.GetRollupStatus()
+	}
+	return item.GetStatus()
+}
+
+// rollupStatus computes the rolled up [statusthingv1.Status] of the provided children according to the provided [statusthingv1.RollupPolicy]
+// nil is returned when the policy is [statusthingv1.RollupPolicy_ROLLUP_POLICY_IGNORE] or no child has a status that participates in rollups
+func rollupStatus(policy statusthingv1.RollupPolicy, children []*statusthingv1.Item) *statusthingv1.Status {
+	if policy == statusthingv1.RollupPolicy_ROLLUP_POLICY_IGNORE {
+		return nil
+	}
+	var worst *statusthingv1.Status
+	counts := map[string]int{}
+	// order keeps majority selection deterministic
+	order := []*statusthingv1.Status{}
+	for _, child := range children {
+		status := effectiveStatus(child)
+		if _, ok := kindSeverity[status.GetKind()]; !ok {
+			continue
+		}
+		if worst == nil || kindSeverity[status.GetKind()] > kindSeverity[worst.GetKind()] {
+			worst = status
+		}
+		if counts[status.GetId()] == 0 {
+			order = append(order, status)
+		}
+		counts[status.GetId()]++
+	}
+	if policy != statusthingv1.RollupPolicy_ROLLUP_POLICY_MAJORITY {
+		return worst
+	}
+	var majority *statusthingv1.Status
+	for _, candidate := range order {
+		count := counts[candidate.GetId()]
+		if majority == nil {
+			majority = candidate
+			continue
+		}
+		best := counts[majority.GetId()]
+		// ties go to the more severe status so problems aren't hidden
+		if count > best || (count == best && kindSeverity[candidate.GetKind()] > kindSeverity[majority.GetKind()]) {
+			majority = candidate
+		}
+	}
+	return majority
+}
+
+// computeRollups computes the rollup status of every provided item that has children keyed by item id
+// the provided items should be every known item so that rollups are accurate
+func computeRollups(all []*statusthingv1.Item) map[string]*statusthingv1.Status {
+	children := childrenByParent(all)
+	computed := map[string]*statusthingv1.Status{}
+	if len(children) == 0 {
+		return computed
+	}
+	var compute func(item *statusthingv1.Item, seen map[string]bool) *statusthingv1.Status
+	compute = func(item *statusthingv1.Item, seen map[string]bool) *statusthingv1.Status {
+		if status, ok := computed[item.GetId()]; ok {
+			return status
+		}
+		// cycles are rejected on write but we don't want to spin forever on bad data
+		if seen[item.GetId()] {
+			return nil
+		}
+		seen[item.GetId()] = true
+		kids := []*statusthingv1.Item{}
+		for _, child := range children[item.GetId()] {
+			kid := proto.Clone(child).(*statusthingv1.Item)
+			kid.RollupStatus = compute(child, seen)
+			kids = append(kids, kid)
+		}
+		status := rollupStatus(item.GetRollupPolicy(), kids)
+		computed[item.GetId()] = status
+		return status
+	}
+	for _, item := range all {
+		if _, ok := children[item.GetId()]; !ok {
+			continue
+		}
+		compute(item, map[string]bool{})
+	}
+	return computed
+}
+
+// checkParent ensures that the [statusthingv1.Item] with the provided parent id exists
+// and that making it the parent of the item with the provided item id would not create a cycle
+func (sts *StatusThingService) checkParent(ctx context.Context, itemID, parentID string) error {
+	if parentID == itemID {
+		return serrors.NewError("parent", serrors.ErrCycle)
+	}
+	seen := map[string]bool{}
+	current := parentID
+	for validation.ValidString(current) {
+		if current == itemID || seen[current] {
+			return serrors.NewError("parent", serrors.ErrCycle)
+		}
+		seen[current] = true
+		parent, err := sts.store.GetItem(ctx, current)
+		if err != nil {
+			return serrors.NewWrappedError("parent", serrors.ErrNotFound, err)
+		}
+		current = parent.GetParentId()
+	}
+	return nil
+}
+
+// buildItemTree nests the provided items under their parents and returns the top-level items
+// items whose parent is not in the provided items are treated as top-level items
+func buildItemTree(items []*statusthingv1.Item) []*statusthingv1.Item {
+	byID := map[string]*statusthingv1.Item{}
+	for _, item := range items {
+		byID[item.GetId()] = item
+	}
+	roots := []*statusthingv1.Item{}
+	for _, item := range items {
+		parent, ok := byID[item.GetParentId()]
+		if !ok || parent == item {
+			roots = append(roots, item)
+			continue
+		}
+		parent.Children = append(parent.Children, item)
+	}
+	return roots
+}
+
+func childrenByParent(items []*statusthingv1.Item) map[string][]*statusthingv1.Item {
+	res := map[string][]*statusthingv1.Item{}
+	for _, item := range items {
+		if validation.ValidString(item.GetParentId()) {
+			res[item.GetParentId()] = append(res[item.GetParentId()], item)
+		}
+	}
+	return res
+}
+
+// OverallStatus is the worst status of the provided items, usually the top-level items of [StatusThingService.ItemTree]
+// nil is returned if no item has a status that participates in rollups
+func OverallStatus(items []*statusthingv1.Item) *statusthingv1.Status {
+	return rollupStatus(statusthingv1.RollupPolicy_ROLLUP_POLICY_WORST_OF, items)
+}
@@ -0,0 +1,128 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	statusthingv1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
+	"github.com/lusis/statusthing/internal/filters"
+	"github.com/lusis/statusthing/internal/serrors"
+	"github.com/lusis/statusthing/internal/storers/memdb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRollupStatus(t *testing.T) {
+	t.Parallel()
+	up := &statusthingv1.Status{Id: "up", Kind: statusthingv1.StatusKind_STATUS_KIND_UP}
+	down := &statusthingv1.Status{Id: "down", Kind: statusthingv1.StatusKind_STATUS_KIND_DOWN}
+	warn := &statusthingv1.Status{Id: "warn", Kind: statusthingv1.StatusKind_STATUS_KIND_WARNING}
+	decomm := &statusthingv1.Status{Id: "decomm", Kind: statusthingv1.StatusKind_STATUS_KIND_DECOMM}
+	children := func(statuses ...*statusthingv1.Status) []*statusthingv1.Item {
+		res := []*statusthingv1.Item{}
+		for _, s := range statuses {
+			res = append(res, &statusthingv1.Item{Status: s})
+		}
+		return res
+	}
+	testCases := map[string]struct {
+		policy   statusthingv1.RollupPolicy
+		children []*statusthingv1.Item
+		expected *statusthingv1.Status
+	}{
+		"worst-of":             {policy: statusthingv1.RollupPolicy_ROLLUP_POLICY_WORST_OF, children: children(up, up, down), expected: down},
+		"unknown-is-worst-of":  {children: children(up, warn, up), expected: warn},
+		"majority":             {policy: statusthingv1.RollupPolicy_ROLLUP_POLICY_MAJORITY, children: children(up, up, down), expected: up},
+		"majority-tie-worst":   {policy: statusthingv1.RollupPolicy_ROLLUP_POLICY_MAJORITY, children: children(up, down), expected: down},
+		"ignore":               {policy: statusthingv1.RollupPolicy_ROLLUP_POLICY_IGNORE, children: children(up, down)},
+		"decomm-not-counted":   {policy: statusthingv1.RollupPolicy_ROLLUP_POLICY_MAJORITY, children: children(decomm, decomm, up), expected: up},
+		"no-children-statuses": {children: children(nil, nil)},
+		"child-rollup-status-used": {
+			children: []*statusthingv1.Item{
+				{Status: up},
+				{Status: up, RollupStatus: down},
+			},
+			expected: down,
+		},
+	}
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			res := rollupStatus(tc.policy, tc.children)
+			if tc.expected == nil {
+				require.Nil(t, res)
+			} else {
+				require.Equal(t, tc.expected.GetId(), res.GetId())
+			}
+		})
+	}
+}
+
+func TestItemGroups(t *testing.T) {
+	ctx := context.TODO()
+	store, err := memdb.New()
+	require.NoError(t, err)
+	sts, err := NewStatusThingService(store)
+	require.NoError(t, err)
+	up, err := sts.AddStatus(ctx, "up", statusthingv1.StatusKind_STATUS_KIND_UP)
+	require.NoError(t, err)
+	down, err := sts.AddStatus(ctx, "down", statusthingv1.StatusKind_STATUS_KIND_DOWN)
+	require.NoError(t, err)
+
+	api, err := sts.AddItem(ctx, "api", filters.WithStatusID(up.GetId()))
+	require.NoError(t, err)
+	rest, err := sts.AddItem(ctx, "rest", filters.WithParentID(api.GetId()), filters.WithStatusID(up.GetId()))
+	require.NoError(t, err)
+	grpc, err := sts.AddItem(ctx, "grpc", filters.WithParentID(api.GetId()), filters.WithStatusID(down.GetId()))
+	require.NoError(t, err)
+	_, err = sts.AddItem(ctx, "standalone", filters.WithStatusID(up.GetId()))
+	require.NoError(t, err)
+
+	t.Run("missing-parent", func(t *testing.T) {
+		res, err := sts.AddItem(ctx, t.Name(), filters.WithParentID("missing"))
+		require.ErrorIs(t, err, serrors.ErrNotFound)
+		require.Nil(t, res)
+	})
+	t.Run("rollup", func(t *testing.T) {
+		res, err := sts.GetItem(ctx, api.GetId())
+		require.NoError(t, err)
+		require.Equal(t, down.GetId(), res.GetRollupStatus().GetId())
+		require.Equal(t, up.GetId(), res.GetStatus().GetId())
+	})
+	t.Run("majority", func(t *testing.T) {
+		require.NoError(t, sts.EditItem(ctx, api.GetId(), filters.WithRollupPolicy(statusthingv1.RollupPolicy_ROLLUP_POLICY_MAJORITY)))
+		_, err := sts.AddItem(ctx, "webhooks", filters.WithParentID(api.GetId()), filters.WithStatusID(up.GetId()))
+		require.NoError(t, err)
+		res, err := sts.GetItem(ctx, api.GetId())
+		require.NoError(t, err)
+		require.Equal(t, up.GetId(), res.GetRollupStatus().GetId())
+	})
+	t.Run("tree", func(t *testing.T) {
+		res, err := sts.ItemTree(ctx)
+		require.NoError(t, err)
+		require.Len(t, res, 2)
+		for _, item := range res {
+			if item.GetId() == api.GetId() {
+				require.Len(t, item.GetChildren(), 3)
+			} else {
+				require.Empty(t, item.GetChildren())
+			}
+		}
+	})
+	t.Run("self-cycle", func(t *testing.T) {
+		err := sts.EditItem(ctx, api.GetId(), filters.WithParentID(api.GetId()))
+		require.ErrorIs(t, err, serrors.ErrCycle)
+	})
+	t.Run("descendant-cycle", func(t *testing.T) {
+		nested, err := sts.AddItem(ctx, "nested", filters.WithParentID(rest.GetId()))
+		require.NoError(t, err)
+		err = sts.EditItem(ctx, api.GetId(), filters.WithParentID(nested.GetId()))
+		require.ErrorIs(t, err, serrors.ErrCycle)
+	})
+	t.Run("regroup", func(t *testing.T) {
+		require.NoError(t, sts.EditItem(ctx, grpc.GetId(), filters.WithParentID(rest.GetId())))
+		res, err := sts.FindItems(ctx, filters.WithParentID(rest.GetId()))
+		require.NoError(t, err)
+		require.Len(t, res, 2)
+	})
+}
@@ -0,0 +1,50 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/lusis/statusthing/internal/serrors"
+	"github.com/lusis/statusthing/internal/validation"
+)
+
+// StoreSession creates or replaces the admin ui session with the provided token
+func (sts *StatusThingService) StoreSession(ctx context.Context, token string, data []byte, expires time.Time) error {
+	if sts.store == nil {
+		return serrors.NewError("store", serrors.ErrStoreUnavailable)
+	}
+	if !validation.ValidString(token) {
+		return serrors.NewError("token", serrors.ErrEmptyString)
+	}
+	return sts.store.StoreSession(ctx, token, data, expires)
+}
+
+// GetSession gets the data of the admin ui session with the provided token
+func (sts *StatusThingService) GetSession(ctx context.Context, token string) ([]byte, error) {
+	if sts.store == nil {
+		return nil, serrors.NewError("store", serrors.ErrStoreUnavailable)
+	}
+	if !validation.ValidString(token) {
+		return nil, serrors.NewError("token", serrors.ErrEmptyString)
+	}
+	return sts.store.GetSession(ctx, token)
+}
+
+// FindSessions gets the data of every admin ui session that hasn't expired by token
+func (sts *StatusThingService) FindSessions(ctx context.Context) (map[string][]byte, error) {
+	if sts.store == nil {
+		return nil, serrors.NewError("store", serrors.ErrStoreUnavailable)
+	}
+	return sts.store.FindSessions(ctx)
+}
+
+// RemoveSession deletes the admin ui session with the provided token
+func (sts *StatusThingService) RemoveSession(ctx context.Context, token string) error {
+	if sts.store == nil {
+		return serrors.NewError("store", serrors.ErrStoreUnavailable)
+	}
+	if !validation.ValidString(token) {
+		return serrors.NewError("token", serrors.ErrEmptyString)
+	}
+	return sts.store.DeleteSession(ctx, token)
+}
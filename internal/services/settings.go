@@ -0,0 +1,248 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	// settings can name any time zone, even on systems without a time zone database
+	_ "time/tzdata"
+
+	"google.golang.org/protobuf/proto"
+
+	v1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
+	"github.com/lusis/statusthing/internal/serrors"
+	"github.com/lusis/statusthing/internal/storers"
+)
+
+const (
+	// DefaultPageTitle is the page title used until one is set
+	DefaultPageTitle = "StatusThing"
+	// DefaultTimezone is the time zone used until one is set
+	DefaultTimezone = "UTC"
+	// SettingsImageMaxSize is the largest logo or favicon in bytes
+	SettingsImageMaxSize = 1 << 20
+	// pageTitleMaxLength and footerTextMaxLength are the most characters those settings can have
+	pageTitleMaxLength  = 100
+	footerTextMaxLength = 1000
+)
+
+// SettingsFields are the names of the fields that can be changed by [StatusThingService.UpdateSettings]
+var SettingsFields = []string{"page_title", "accent_color", "accent_text_color", "support_url", "footer_text", "timezone", storers.SettingsLogo, storers.SettingsFavicon}
+
+// settingsImageTypes are the content types a logo or favicon can be
+var settingsImageTypes = []string{"image/png", "image/jpeg", "image/gif", "image/webp", "image/x-icon", "image/vnd.microsoft.icon"}
+
+// hexColor matches the css hex colors the accent colors can be. nothing else is allowed since they end up in a stylesheet
+var hexColor = regexp.MustCompile(`^#(?:[0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+
+// SettingsUpdate is a change to the [v1.Settings]
+type SettingsUpdate struct {
+	// Settings holds the new values of the fields being changed
+	Settings *v1.Settings
+	// Fields are the names of the fields to change from [SettingsFields]. changed fields left empty are cleared
+	Fields []string
+	// Logo is a new logo image. with logo in Fields an empty Logo removes the logo
+	Logo []byte
+	// Favicon is a new favicon image. with favicon in Fields an empty Favicon removes the favicon
+	Favicon []byte
+	// ExpectedVersion rejects the update with [serrors.ErrConflict] unless it matches the current version
+	ExpectedVersion uint64
+}
+
+// GetSettings gets the [v1.Settings] with the page title and time zone defaulted when they aren't set
+func (sts *StatusThingService) GetSettings(ctx context.Context) (*v1.Settings, error) {
+	if sts.store == nil {
+		return nil, serrors.NewError("store", serrors.ErrStoreUnavailable)
+	}
+	sts.l.RLock()
+	cached := sts.settings
+	sts.l.RUnlock()
+	if cached == nil {
+		res, err := sts.store.GetSettings(ctx)
+		if err != nil {
+			return nil, err
+		}
+		cached = withSettingsDefaults(res)
+		sts.l.Lock()
+		// an update may have cached newer settings while these were read
+		if sts.settings == nil {
+			sts.settings = cached
+		}
+		sts.l.Unlock()
+	}
+	res, ok := proto.Clone(cached).(*v1.Settings)
+	if !ok {
+		return nil, serrors.NewError("settings", serrors.ErrInvalidData)
+	}
+	return res, nil
+}
+
+// UpdateSettings changes the fields of the [v1.Settings] named in the [SettingsUpdate]
+func (sts *StatusThingService) UpdateSettings(ctx context.Context, update SettingsUpdate) (*v1.Settings, error) {
+	if sts.store == nil {
+		return nil, serrors.NewError("store", serrors.ErrStoreUnavailable)
+	}
+	if update.Settings == nil {
+		return nil, serrors.NewError("settings", serrors.ErrNilVal)
+	}
+	if len(update.Fields) == 0 {
+		return nil, serrors.NewError("fields", serrors.ErrAtLeastOne)
+	}
+	current, err := sts.store.GetSettings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	next, ok := proto.Clone(current).(*v1.Settings)
+	if !ok {
+		return nil, serrors.NewError("settings", serrors.ErrInvalidData)
+	}
+	images := []*storers.SettingsImage{}
+	for _, field := range update.Fields {
+		switch field {
+		case "page_title":
+			next.PageTitle = strings.TrimSpace(update.Settings.GetPageTitle())
+		case "accent_color":
+			next.AccentColor = strings.TrimSpace(update.Settings.GetAccentColor())
+		case "accent_text_color":
+			next.AccentTextColor = strings.TrimSpace(update.Settings.GetAccentTextColor())
+		case "support_url":
+			next.SupportUrl = strings.TrimSpace(update.Settings.GetSupportUrl())
+		case "footer_text":
+			next.FooterText = strings.TrimSpace(update.Settings.GetFooterText())
+		case "timezone":
+			next.Timezone = strings.TrimSpace(update.Settings.GetTimezone())
+		case storers.SettingsLogo:
+			img, err := settingsImage(storers.SettingsLogo, update.Logo)
+			if err != nil {
+				return nil, err
+			}
+			next.LogoUrl = settingsImageURL(img)
+			images = append(images, img)
+		case storers.SettingsFavicon:
+			img, err := settingsImage(storers.SettingsFavicon, update.Favicon)
+			if err != nil {
+				return nil, err
+			}
+			next.FaviconUrl = settingsImageURL(img)
+			images = append(images, img)
+		default:
+			return nil, serrors.NewError(field, serrors.ErrUnknownField)
+		}
+	}
+	if err := validateSettings(next); err != nil {
+		return nil, err
+	}
+	res, err := sts.store.StoreSettings(ctx, next, update.ExpectedVersion, images...)
+	if err != nil {
+		return nil, err
+	}
+	res = withSettingsDefaults(res)
+	sts.l.Lock()
+	sts.settings = res
+	sts.l.Unlock()
+	return proto.Clone(res).(*v1.Settings), nil
+}
+
+// GetSettingsImage gets the logo or favicon with the provided name
+func (sts *StatusThingService) GetSettingsImage(ctx context.Context, name string) (*storers.SettingsImage, error) {
+	if sts.store == nil {
+		return nil, serrors.NewError("store", serrors.ErrStoreUnavailable)
+	}
+	return sts.store.GetSettingsImage(ctx, name)
+}
+
+// Location returns the time zone from the [v1.Settings], falling back to UTC if they can't be read
+func (sts *StatusThingService) Location(ctx context.Context) *time.Location {
+	settings, err := sts.GetSettings(ctx)
+	if err != nil {
+		return time.UTC
+	}
+	loc, err := time.LoadLocation(settings.GetTimezone())
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// withSettingsDefaults fills in the settings that have defaults
+func withSettingsDefaults(settings *v1.Settings) *v1.Settings {
+	if settings.GetPageTitle() == "" {
+		settings.PageTitle = DefaultPageTitle
+	}
+	if settings.GetTimezone() == "" {
+		settings.Timezone = DefaultTimezone
+	}
+	return settings
+}
+
+// validateSettings checks every setting that has a value
+func validateSettings(settings *v1.Settings) error {
+	if title := settings.GetPageTitle(); utf8.RuneCountInString(title) > pageTitleMaxLength || strings.ContainsAny(title, "\r\n") {
+		return serrors.NewError("page_title", serrors.ErrInvalidData)
+	}
+	if utf8.RuneCountInString(settings.GetFooterText()) > footerTextMaxLength {
+		return serrors.NewError("footer_text", serrors.ErrTooLarge)
+	}
+	for name, color := range map[string]string{"accent_color": settings.GetAccentColor(), "accent_text_color": settings.GetAccentTextColor()} {
+		if color != "" && !hexColor.MatchString(color) {
+			return serrors.NewError(name+" "+color, serrors.ErrInvalidData)
+		}
+	}
+	if support := settings.GetSupportUrl(); support != "" {
+		u, err := url.Parse(support)
+		if err != nil {
+			return serrors.NewWrappedError("support_url", serrors.ErrInvalidData, err)
+		}
+		switch {
+		case u.Scheme == "mailto" && u.Opaque != "":
+		case (u.Scheme == "http" || u.Scheme == "https") && u.Host != "":
+		default:
+			return serrors.NewError("support_url must be an http, https or mailto url", serrors.ErrInvalidData)
+		}
+	}
+	if tz := settings.GetTimezone(); tz != "" {
+		if tz == "Local" {
+			return serrors.NewError("timezone "+tz, serrors.ErrInvalidData)
+		}
+		if _, err := time.LoadLocation(tz); err != nil {
+			return serrors.NewWrappedError("timezone "+tz, serrors.ErrInvalidData, err)
+		}
+	}
+	return nil
+}
+
+// settingsImage checks the provided image and returns it as a [storers.SettingsImage]
+// an empty image is returned as is so it is removed
+func settingsImage(name string, data []byte) (*storers.SettingsImage, error) {
+	img := &storers.SettingsImage{Name: name}
+	if len(data) == 0 {
+		return img, nil
+	}
+	if len(data) > SettingsImageMaxSize {
+		return nil, serrors.NewError(name, serrors.ErrTooLarge)
+	}
+	img.ContentType = sniffContentType(data)
+	for _, allowed := range settingsImageTypes {
+		if img.ContentType == allowed {
+			img.Data = data
+			return img, nil
+		}
+	}
+	return nil, serrors.NewError(name+" "+img.ContentType, serrors.ErrUnsupportedType)
+}
+
+// settingsImageURL returns the path the provided image is served at
+// the path changes along with the image so it can be cached
+func settingsImageURL(img *storers.SettingsImage) string {
+	if len(img.Data) == 0 {
+		return ""
+	}
+	sum := sha256.Sum256(img.Data)
+	return "/branding/" + img.Name + "?v=" + hex.EncodeToString(sum[:6])
+}
@@ -0,0 +1,95 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	v1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
+	"github.com/lusis/statusthing/internal/serrors"
+	"github.com/lusis/statusthing/internal/storers"
+	"github.com/lusis/statusthing/internal/storers/memdb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSettings(t *testing.T) {
+	ctx := context.TODO()
+	store, err := memdb.New()
+	require.NoError(t, err)
+	sts, err := NewStatusThingService(store)
+	require.NoError(t, err)
+
+	defaults, err := sts.GetSettings(ctx)
+	require.NoError(t, err)
+	require.Equal(t, DefaultPageTitle, defaults.GetPageTitle())
+	require.Equal(t, DefaultTimezone, defaults.GetTimezone())
+	require.Equal(t, time.UTC, sts.Location(ctx))
+
+	res, err := sts.UpdateSettings(ctx, SettingsUpdate{
+		Settings: &v1.Settings{PageTitle: " Acme ", AccentColor: "#3273dc", SupportUrl: "mailto:help@example.com", Timezone: "America/New_York"},
+		Fields:   []string{"page_title", "accent_color", "support_url", "timezone", "logo"},
+		Logo:     pngData,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "Acme", res.GetPageTitle())
+	require.Equal(t, uint64(1), res.GetVersion())
+	require.True(t, strings.HasPrefix(res.GetLogoUrl(), "/branding/logo?v="))
+	require.Equal(t, "America/New_York", sts.Location(ctx).String())
+	logo, err := sts.GetSettingsImage(ctx, storers.SettingsLogo)
+	require.NoError(t, err)
+	require.Equal(t, "image/png", logo.ContentType)
+
+	// fields that aren't named are kept and named fields left empty are cleared
+	res, err = sts.UpdateSettings(ctx, SettingsUpdate{
+		Settings:        &v1.Settings{FooterText: "we're here to help"},
+		Fields:          []string{"footer_text", "timezone", "logo"},
+		ExpectedVersion: 1,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "Acme", res.GetPageTitle())
+	require.Equal(t, "we're here to help", res.GetFooterText())
+	require.Equal(t, DefaultTimezone, res.GetTimezone())
+	require.Empty(t, res.GetLogoUrl())
+	_, err = sts.GetSettingsImage(ctx, storers.SettingsLogo)
+	require.ErrorIs(t, err, serrors.ErrNotFound)
+	cached, err := sts.GetSettings(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "we're here to help", cached.GetFooterText(), "updates should replace the cached settings")
+
+	_, err = sts.UpdateSettings(ctx, SettingsUpdate{Settings: &v1.Settings{PageTitle: "Old"}, Fields: []string{"page_title"}, ExpectedVersion: 1})
+	require.ErrorIs(t, err, serrors.ErrConflict)
+
+	testCases := map[string]struct {
+		update SettingsUpdate
+		err    error
+	}{
+		"no-fields":         {update: SettingsUpdate{Settings: &v1.Settings{}}, err: serrors.ErrAtLeastOne},
+		"nil-settings":      {update: SettingsUpdate{Fields: []string{"page_title"}}, err: serrors.ErrNilVal},
+		"unknown-field":     {update: SettingsUpdate{Settings: &v1.Settings{}, Fields: []string{"version"}}, err: serrors.ErrUnknownField},
+		"long-title":        {update: SettingsUpdate{Settings: &v1.Settings{PageTitle: strings.Repeat("a", 101)}, Fields: []string{"page_title"}}, err: serrors.ErrInvalidData},
+		"multiline-title":   {update: SettingsUpdate{Settings: &v1.Settings{PageTitle: "a\nb"}, Fields: []string{"page_title"}}, err: serrors.ErrInvalidData},
+		"named-color":       {update: SettingsUpdate{Settings: &v1.Settings{AccentColor: "red"}, Fields: []string{"accent_color"}}, err: serrors.ErrInvalidData},
+		"css-in-color":      {update: SettingsUpdate{Settings: &v1.Settings{AccentTextColor: "#fff;}body{display:none"}, Fields: []string{"accent_text_color"}}, err: serrors.ErrInvalidData},
+		"javascript-url":    {update: SettingsUpdate{Settings: &v1.Settings{SupportUrl: "javascript:alert(1)"}, Fields: []string{"support_url"}}, err: serrors.ErrInvalidData},
+		"relative-url":      {update: SettingsUpdate{Settings: &v1.Settings{SupportUrl: "/help"}, Fields: []string{"support_url"}}, err: serrors.ErrInvalidData},
+		"unknown-timezone":  {update: SettingsUpdate{Settings: &v1.Settings{Timezone: "Mars/Olympus_Mons"}, Fields: []string{"timezone"}}, err: serrors.ErrInvalidData},
+		"local-timezone":    {update: SettingsUpdate{Settings: &v1.Settings{Timezone: "Local"}, Fields: []string{"timezone"}}, err: serrors.ErrInvalidData},
+		"long-footer":       {update: SettingsUpdate{Settings: &v1.Settings{FooterText: strings.Repeat("a", 1001)}, Fields: []string{"footer_text"}}, err: serrors.ErrTooLarge},
+		"svg-favicon":       {update: SettingsUpdate{Settings: &v1.Settings{}, Fields: []string{"favicon"}, Favicon: []byte("<svg><script>alert(1)</script></svg>")}, err: serrors.ErrUnsupportedType},
+		"large-logo":        {update: SettingsUpdate{Settings: &v1.Settings{}, Fields: []string{"logo"}, Logo: append(append([]byte{}, pngData...), bytes.Repeat([]byte{0}, SettingsImageMaxSize)...)}, err: serrors.ErrTooLarge},
+		"ico-favicon":       {update: SettingsUpdate{Settings: &v1.Settings{}, Fields: []string{"favicon"}, Favicon: append([]byte{0, 0, 1, 0}, bytes.Repeat([]byte{0}, 16)...)}},
+		"https-support-url": {update: SettingsUpdate{Settings: &v1.Settings{SupportUrl: "https://example.com/help"}, Fields: []string{"support_url"}}},
+	}
+	for n, tc := range testCases {
+		t.Run(n, func(t *testing.T) {
+			_, err := sts.UpdateSettings(ctx, tc.update)
+			if tc.err == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tc.err)
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -25,6 +26,18 @@ type StatusThingService struct {
 	store           storers.StatusThingStorer
 	loadDefaults    bool
 	defaultStatuses []*statusthingv1.Status
+	idempotencyTTL  time.Duration
+	backupDir       string
+	backupRetention int
+	retention       RetentionPolicy
+	pruneInterval   time.Duration
+	blobs           storers.BlobStorer
+	// attachmentsMu is read locked while an attachment is uploaded and locked while blobs are pruned
+	attachmentsMu     sync.RWMutex
+	attachmentMaxSize int64
+	attachmentTypes   []string
+	// settings is the cached copy of the [statusthingv1.Settings] with defaults filled in
+	settings *statusthingv1.Settings
 }
 
 // NewStatusThingService returns a new [StatusThingService]
@@ -33,9 +46,16 @@ func NewStatusThingService(store storers.StatusThingStorer, opts ...ServiceOptio
 		return nil, fmt.Errorf("nil store provided: %w", serrors.ErrStoreUnavailable)
 	}
 	svc := &StatusThingService{
-		l:               &sync.RWMutex{},
-		store:           store,
-		defaultStatuses: make([]*statusthingv1.Status, 0),
+		l:                 &sync.RWMutex{},
+		store:             store,
+		defaultStatuses:   make([]*statusthingv1.Status, 0),
+		idempotencyTTL:    DefaultIdempotencyTTL,
+		backupRetention:   DefaultBackupRetention,
+		attachmentMaxSize: DefaultAttachmentMaxSize,
+		attachmentTypes:   DefaultAttachmentTypes,
+	}
+	if blobs, ok := store.(storers.BlobStorer); ok {
+		svc.blobs = blobs
 	}
 	for _, opt := range opts {
 		svc.l.Lock()
@@ -81,6 +101,16 @@ func (sts *StatusThingService) CreateDefaultStatuses() error {
 	}
 	return nil
 }
+
+// withTx calls the provided function with a store that does all of its work in a single transaction
+// if the store is not a [storers.Transactor] the function is called with the store as-is
+func (sts *StatusThingService) withTx(ctx context.Context, fn func(storers.StatusThingStorer) error) error {
+	if tx, ok := sts.store.(storers.Transactor); ok {
+		return tx.WithTx(ctx, fn)
+	}
+	return fn(sts.store)
+}
+
 func makeTsNow() *statusthingv1.Timestamps {
 	now := timestamppb.Now()
 	return &statusthingv1.Timestamps{
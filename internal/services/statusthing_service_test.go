@@ -162,6 +162,7 @@ func (ts *testStatusThingStore) UpdateNote(_ context.Context, noteID string, opt
 		Id:         noteID,
 		Timestamps: f.Timestamps(),
 		Text:       f.NoteText(),
+		Version:    f.ExpectedVersion(),
 	}
 	return nil
 }
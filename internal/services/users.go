@@ -3,6 +3,10 @@ package services
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"time"
 
 	v1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
 	"github.com/lusis/statusthing/internal/filters"
@@ -15,7 +19,27 @@ import (
 	"github.com/segmentio/ksuid"
 )
 
+const (
+	// DefaultAdminUsername is the username [StatusThingService.CreateAdmin] uses unless told otherwise
+	DefaultAdminUsername = "admin"
+	// DefaultAdminPassword is the password admin users were created with before [StatusThingService.CreateAdmin]
+	// users still logging in with it have to change it
+	DefaultAdminPassword = "password"
+	// DefaultAdminEmail is the email address [StatusThingService.CreateAdmin] uses unless told otherwise
+	DefaultAdminEmail = "admin@localhost"
+	// externalPassword is the password of users provisioned by a single sign-on provider
+	// it isn't a valid hash so they can never log in with a password
+	externalPassword = "!external"
+)
+
 // AddUser creates a new user
+// supported options:
+// - [filters.WithUserID]
+// - [filters.WithRole] defaults to admin
+// - [filters.WithPasswordChangeRequired] to make the user change the password before doing anything else
+// - [filters.WithFirstName]
+// - [filters.WithLastName]
+// - [filters.WithLastLogin]
 func (sts *StatusThingService) AddUser(ctx context.Context, username string, password string, emailAddress string, opts ...filters.FilterOption) (*v1.User, error) {
 	if sts.store == nil {
 		return nil, serrors.NewError("store", serrors.ErrStoreUnavailable)
@@ -47,8 +71,13 @@ func (sts *StatusThingService) AddUser(ctx context.Context, username string, pas
 		Username:     username,
 		Password:     hash,
 		EmailAddress: emailAddress,
+		Role:         v1.Role_ROLE_ADMIN,
 		Timestamps:   makeTsNow(),
 	}
+	if f.Role() != v1.Role_ROLE_UNKNOWN {
+		u.Role = f.Role()
+	}
+	u.PasswordChangeRequired, _ = f.PasswordChangeRequired()
 
 	if validation.ValidString(f.FirstName()) {
 		u.FirstName = f.FirstName()
@@ -90,7 +119,117 @@ func (sts *StatusThingService) CheckPassword(ctx context.Context, username strin
 	return sts.checkPassword(ctx, username, password)
 }
 
-// ChangePassword changes the password
+// CreateAdmin creates an admin user with a random password that has to be changed at the first login
+// the password is only returned here. [serrors.ErrConflict] is returned if the user already exists
+func (sts *StatusThingService) CreateAdmin(ctx context.Context, username string, emailAddress string) (*v1.User, string, error) {
+	if sts.store == nil {
+		return nil, "", serrors.NewError("store", serrors.ErrStoreUnavailable)
+	}
+	if !validation.ValidString(username) {
+		return nil, "", serrors.NewError("username", serrors.ErrEmptyString)
+	}
+	if _, err := sts.store.GetUser(ctx, username); err == nil {
+		return nil, "", serrors.NewError("user "+username, serrors.ErrConflict)
+	} else if !errors.Is(err, serrors.ErrNotFound) {
+		return nil, "", err
+	}
+	b := make([]byte, 18)
+	if _, err := rand.Read(b); err != nil {
+		return nil, "", serrors.NewWrappedError("random", serrors.ErrUnrecoverable, err)
+	}
+	password := base64.RawURLEncoding.EncodeToString(b)
+	user, err := sts.AddUser(ctx, username, password, emailAddress, filters.WithRole(v1.Role_ROLE_ADMIN), filters.WithPasswordChangeRequired(true))
+	if err != nil {
+		return nil, "", err
+	}
+	return user, password, nil
+}
+
+// Login checks the password of the user and records the login
+// users logging in with [DefaultAdminPassword] are made to change it
+func (sts *StatusThingService) Login(ctx context.Context, username string, password string) (*v1.User, error) {
+	user, err := sts.CheckPassword(ctx, username, password)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	opts := []filters.FilterOption{filters.WithLastLogin(&now)}
+	if password == DefaultAdminPassword && !user.GetPasswordChangeRequired() {
+		user.PasswordChangeRequired = true
+		opts = append(opts, filters.WithPasswordChangeRequired(true))
+	}
+	if err := sts.EditUser(ctx, username, opts...); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// ProvisionUser records a login through a single sign-on provider
+// the user is created the first time and its email address, role and names are updated from the provider after that
+// users created with [StatusThingService.AddUser] can't be taken over this way and return [serrors.ErrConflict]
+// supported options:
+// - [filters.WithFirstName]
+// - [filters.WithLastName]
+func (sts *StatusThingService) ProvisionUser(ctx context.Context, username string, emailAddress string, role v1.Role, opts ...filters.FilterOption) (*v1.User, error) {
+	if sts.store == nil {
+		return nil, serrors.NewError("store", serrors.ErrStoreUnavailable)
+	}
+	if !validation.ValidString(username) {
+		return nil, serrors.NewError("username", serrors.ErrEmptyString)
+	}
+	if !validation.ValidString(emailAddress) {
+		return nil, serrors.NewError("email_address", serrors.ErrEmptyString)
+	}
+	if role == v1.Role_ROLE_UNKNOWN {
+		return nil, serrors.NewError("role", serrors.ErrEmptyEnum)
+	}
+	f, err := filters.New(opts...)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	existing, err := sts.store.GetUser(ctx, username)
+	if errors.Is(err, serrors.ErrNotFound) {
+		u := &v1.User{
+			Id:           ksuid.New().String(),
+			Username:     username,
+			Password:     externalPassword,
+			FirstName:    f.FirstName(),
+			LastName:     f.LastName(),
+			EmailAddress: emailAddress,
+			Role:         role,
+			LastLogin:    timestamppb.New(now),
+			Timestamps:   makeTsNow(),
+		}
+		return sts.store.StoreUser(ctx, u)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !IsExternalUser(existing) {
+		return nil, serrors.NewError("username "+username+" belongs to a local user", serrors.ErrConflict)
+	}
+	edits := []filters.FilterOption{filters.WithEmailAddress(emailAddress), filters.WithRole(role), filters.WithLastLogin(&now)}
+	if validation.ValidString(f.FirstName()) {
+		edits = append(edits, filters.WithFirstName(f.FirstName()))
+	}
+	if validation.ValidString(f.LastName()) {
+		edits = append(edits, filters.WithLastName(f.LastName()))
+	}
+	if err := sts.EditUser(ctx, username, edits...); err != nil {
+		return nil, err
+	}
+	return sts.store.GetUser(ctx, username)
+}
+
+// IsExternalUser is true for users provisioned by a single sign-on provider
+// they don't have a password
+func IsExternalUser(u *v1.User) bool {
+	return u.GetPassword() == externalPassword
+}
+
+// ChangePassword changes the password and clears [v1.User.PasswordChangeRequired]
+// the new password has to be different from the current one
 func (sts *StatusThingService) ChangePassword(ctx context.Context, username string, currPass string, newPass string) error {
 	if sts.store == nil {
 		return serrors.NewError("store", serrors.ErrStoreUnavailable)
@@ -104,6 +243,9 @@ func (sts *StatusThingService) ChangePassword(ctx context.Context, username stri
 	if !validation.ValidString(newPass) {
 		return serrors.NewError("new password", serrors.ErrEmptyEnum)
 	}
+	if newPass == currPass || newPass == DefaultAdminPassword {
+		return serrors.NewError("new password", serrors.ErrInvalidData)
+	}
 
 	if _, err := sts.checkPassword(ctx, username, currPass); err != nil {
 		return err
@@ -112,7 +254,7 @@ func (sts *StatusThingService) ChangePassword(ctx context.Context, username stri
 	if err != nil {
 		return serrors.NewWrappedError("hashed-password", serrors.ErrUnrecoverable, err)
 	}
-	return sts.EditUser(ctx, username, filters.WithPassword(hash))
+	return sts.EditUser(ctx, username, filters.WithPassword(hash), filters.WithPasswordChangeRequired(false))
 }
 
 // EditUser edits the user
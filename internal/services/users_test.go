@@ -4,6 +4,8 @@ import (
 	"context"
 	"testing"
 
+	v1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
+	"github.com/lusis/statusthing/internal/filters"
 	"github.com/lusis/statusthing/internal/serrors"
 	"github.com/lusis/statusthing/internal/storers/memdb"
 	"github.com/stretchr/testify/require"
@@ -54,3 +56,85 @@ func TestUsers(t *testing.T) {
 	require.ErrorIs(t, ferr, serrors.ErrNotFound)
 	require.Nil(t, fres)
 }
+
+func TestLogin(t *testing.T) {
+	store, err := memdb.New()
+	require.NoError(t, err)
+	svc, err := NewStatusThingService(store)
+	require.NoError(t, err)
+	ctx := context.TODO()
+
+	_, err = svc.Login(ctx, DefaultAdminUsername, DefaultAdminPassword)
+	require.ErrorIs(t, err, serrors.ErrNotFound, "logging in should never create a user")
+
+	admin, password, err := svc.CreateAdmin(ctx, DefaultAdminUsername, DefaultAdminEmail)
+	require.NoError(t, err)
+	require.Equal(t, v1.Role_ROLE_ADMIN, admin.GetRole())
+	require.True(t, admin.GetPasswordChangeRequired())
+	require.NotEqual(t, DefaultAdminPassword, password)
+	_, _, err = svc.CreateAdmin(ctx, DefaultAdminUsername, DefaultAdminEmail)
+	require.ErrorIs(t, err, serrors.ErrConflict)
+
+	_, err = svc.Login(ctx, DefaultAdminUsername, "wrong")
+	require.ErrorIs(t, err, serrors.ErrInvalidPassword)
+	admin, err = svc.Login(ctx, DefaultAdminUsername, password)
+	require.NoError(t, err)
+	require.True(t, admin.GetPasswordChangeRequired(), "the generated password has to be changed")
+	stored, err := svc.GetUser(ctx, DefaultAdminUsername)
+	require.NoError(t, err)
+	require.True(t, stored.GetLastLogin().IsValid(), "logins should be recorded")
+
+	require.ErrorIs(t, svc.ChangePassword(ctx, DefaultAdminUsername, password, password), serrors.ErrInvalidData, "the new password has to be different")
+	require.ErrorIs(t, svc.ChangePassword(ctx, DefaultAdminUsername, password, DefaultAdminPassword), serrors.ErrInvalidData)
+	require.NoError(t, svc.ChangePassword(ctx, DefaultAdminUsername, password, "better password"))
+	_, err = svc.Login(ctx, DefaultAdminUsername, password)
+	require.ErrorIs(t, err, serrors.ErrInvalidPassword, "the generated password should stop working once changed")
+	admin, err = svc.Login(ctx, DefaultAdminUsername, "better password")
+	require.NoError(t, err)
+	require.False(t, admin.GetPasswordChangeRequired())
+
+	// admins created before CreateAdmin still have the old default password
+	_, err = svc.AddUser(ctx, "legacy", DefaultAdminPassword, "legacy@localhost")
+	require.NoError(t, err)
+	legacy, err := svc.Login(ctx, "legacy", DefaultAdminPassword)
+	require.NoError(t, err)
+	require.True(t, legacy.GetPasswordChangeRequired())
+	stored, err = svc.GetUser(ctx, "legacy")
+	require.NoError(t, err)
+	require.True(t, stored.GetPasswordChangeRequired())
+}
+
+func TestProvisionUser(t *testing.T) {
+	store, err := memdb.New()
+	require.NoError(t, err)
+	svc, err := NewStatusThingService(store)
+	require.NoError(t, err)
+	ctx := context.TODO()
+
+	_, err = svc.ProvisionUser(ctx, "sso", "", v1.Role_ROLE_VIEWER)
+	require.ErrorIs(t, err, serrors.ErrEmptyString)
+	_, err = svc.ProvisionUser(ctx, "sso", "sso@example.com", v1.Role_ROLE_UNKNOWN)
+	require.ErrorIs(t, err, serrors.ErrEmptyEnum)
+
+	u, err := svc.ProvisionUser(ctx, "sso", "sso@example.com", v1.Role_ROLE_VIEWER, filters.WithFirstName("Sam"))
+	require.NoError(t, err)
+	require.True(t, IsExternalUser(u))
+	require.Equal(t, v1.Role_ROLE_VIEWER, u.GetRole())
+	require.Equal(t, "Sam", u.GetFirstName())
+	require.True(t, u.GetLastLogin().IsValid(), "logins should be recorded")
+	_, err = svc.Login(ctx, "sso", externalPassword)
+	require.ErrorIs(t, err, serrors.ErrInvalidPassword, "provisioned users should not be able to log in with a password")
+
+	u, err = svc.ProvisionUser(ctx, "sso", "new@example.com", v1.Role_ROLE_ADMIN)
+	require.NoError(t, err)
+	require.Equal(t, "new@example.com", u.GetEmailAddress(), "the provider should be the source of truth")
+	require.Equal(t, v1.Role_ROLE_ADMIN, u.GetRole())
+	require.Equal(t, "Sam", u.GetFirstName())
+
+	local, err := svc.AddUser(ctx, "local", "password1", "local@example.com")
+	require.NoError(t, err)
+	require.False(t, IsExternalUser(local))
+	require.Equal(t, v1.Role_ROLE_ADMIN, local.GetRole(), "users are admins unless a role is provided")
+	_, err = svc.ProvisionUser(ctx, "local", "local@example.com", v1.Role_ROLE_ADMIN)
+	require.ErrorIs(t, err, serrors.ErrConflict, "local users should not be taken over")
+}
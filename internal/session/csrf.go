@@ -0,0 +1,57 @@
+package session
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+)
+
+// csrfTokenSize is the number of random bytes in a csrf token
+const csrfTokenSize = 32
+
+// CSRFToken returns the csrf token of the session in ctx, creating one if the session doesn't have one yet
+func CSRFToken(ctx context.Context) (string, error) {
+	if token := Sessions.GetString(ctx, CSRFTokenKey); token != "" {
+		return token, nil
+	}
+	return RenewCSRFToken(ctx)
+}
+
+// RenewCSRFToken replaces the csrf token of the session in ctx
+func RenewCSRFToken(ctx context.Context) (string, error) {
+	b := make([]byte, csrfTokenSize)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	token := base64.RawURLEncoding.EncodeToString(b)
+	Sessions.Put(ctx, CSRFTokenKey, token)
+	return token, nil
+}
+
+// ValidCSRFToken is true when the provided token is the csrf token of the session in ctx
+func ValidCSRFToken(ctx context.Context, token string) bool {
+	expected := Sessions.GetString(ctx, CSRFTokenKey)
+	if expected == "" || token == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(expected), []byte(token)) == 1
+}
+
+// SignOutEverywhere ends every session of the user with the provided username, including the one in ctx
+// it returns how many sessions were ended
+func SignOutEverywhere(ctx context.Context, username string) (int, error) {
+	ended := 0
+	err := Sessions.Iterate(ctx, func(sctx context.Context) error {
+		if Sessions.GetString(sctx, UsernameKey) != username {
+			return nil
+		}
+		ended++
+		return Sessions.Destroy(sctx)
+	})
+	if err != nil {
+		return ended, err
+	}
+	// the session in ctx would be saved again at the end of the request if it weren't destroyed here too
+	return ended, Sessions.Destroy(ctx)
+}
@@ -3,26 +3,118 @@ package session
 
 import (
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/alexedwards/scs/v2"
 	"golang.org/x/exp/slog"
+
+	"github.com/lusis/statusthing/internal/serrors"
 )
 
 const (
 	// LoggedInKey is the session key for being logged in
 	LoggedInKey = "loggedin"
+	// UsernameKey is the session key for the name of the logged in user
+	UsernameKey = "username"
+	// CSRFTokenKey is the session key for the token that has to be sent with every change
+	CSRFTokenKey = "csrf_token"
+	// OIDCStateKey is the session key for the state of a login with an OpenID Connect provider
+	OIDCStateKey = "oidc_state"
+	// OIDCNonceKey is the session key for the nonce of a login with an OpenID Connect provider
+	OIDCNonceKey = "oidc_nonce"
+	// OIDCVerifierKey is the session key for the pkce verifier of a login with an OpenID Connect provider
+	OIDCVerifierKey = "oidc_verifier"
 )
 
 // Sessions is the global session manager
 var Sessions *scs.SessionManager
 
-// NewSession creates a new session manager
-func NewSession() {
+// Config is the session cookie configuration
+type Config struct {
+	// CookieName is the name of the session cookie
+	CookieName string `yaml:"cookie_name"`
+	// Lifetime is how long a session lasts regardless of activity
+	Lifetime time.Duration `yaml:"lifetime"`
+	// IdleTimeout ends sessions with no activity for this long. 0 disables it
+	IdleTimeout time.Duration `yaml:"idle_timeout"`
+	// Domain is the domain of the session cookie
+	Domain string `yaml:"domain"`
+	// Path is the path of the session cookie
+	Path string `yaml:"path"`
+	// Secure only sends the session cookie over https
+	Secure bool `yaml:"secure"`
+	// SameSite is the SameSite attribute of the session cookie: lax, strict or none
+	SameSite string `yaml:"same_site"`
+	// Persist keeps the session cookie after the browser is closed
+	Persist bool `yaml:"persist"`
+}
+
+// DefaultConfig returns the default session [Config]
+func DefaultConfig() Config {
+	return Config{
+		CookieName: "session",
+		Lifetime:   24 * time.Hour,
+		Path:       "/",
+		SameSite:   "lax",
+		Persist:    true,
+	}
+}
+
+// Validate checks the [Config] for errors
+func (c Config) Validate() error {
+	if strings.TrimSpace(c.CookieName) == "" {
+		return serrors.NewError("cookie_name", serrors.ErrEmptyString)
+	}
+	if c.Lifetime <= 0 {
+		return serrors.NewError("lifetime", serrors.ErrAtLeastOne)
+	}
+	if c.IdleTimeout < 0 {
+		return serrors.NewError("idle_timeout", serrors.ErrAtLeastOne)
+	}
+	if _, err := c.sameSite(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c Config) sameSite() (http.SameSite, error) {
+	switch strings.ToLower(c.SameSite) {
+	case "", "lax":
+		return http.SameSiteLaxMode, nil
+	case "strict":
+		return http.SameSiteStrictMode, nil
+	case "none":
+		return http.SameSiteNoneMode, nil
+	default:
+		return 0, serrors.NewError("same_site "+c.SameSite, serrors.ErrInvalidData)
+	}
+}
+
+// NewSession creates a new session manager with the provided [Config]
+// sessions are kept in the provided [Store] so they last across restarts. without one they're kept in memory
+func NewSession(cfg Config, store Store) error {
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+	sameSite, _ := cfg.sameSite()
 	s := scs.New()
+	if store != nil {
+		s.Store = &dbStore{store: store}
+	}
+	s.Lifetime = cfg.Lifetime
+	s.IdleTimeout = cfg.IdleTimeout
+	s.Cookie.Name = cfg.CookieName
+	s.Cookie.Domain = cfg.Domain
+	s.Cookie.Path = cfg.Path
+	s.Cookie.Secure = cfg.Secure
+	s.Cookie.SameSite = sameSite
+	s.Cookie.Persist = cfg.Persist
 	s.Cookie.HttpOnly = true
 	s.ErrorFunc = func(w http.ResponseWriter, r *http.Request, err error) {
 		slog.Error("error in session", "err", err)
 		http.Error(w, "internal error", http.StatusInternalServerError)
 	}
 	Sessions = s
+	return nil
 }
@@ -0,0 +1,78 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/lusis/statusthing/internal/serrors"
+)
+
+// Store is where sessions are kept. [services.StatusThingService] is one
+type Store interface {
+	// StoreSession creates or replaces the session with the provided token
+	StoreSession(ctx context.Context, token string, data []byte, expires time.Time) error
+	// GetSession gets the data of the session with the provided token
+	GetSession(ctx context.Context, token string) ([]byte, error)
+	// FindSessions gets the data of every session that hasn't expired by token
+	FindSessions(ctx context.Context) (map[string][]byte, error)
+	// RemoveSession deletes the session with the provided token
+	RemoveSession(ctx context.Context, token string) error
+}
+
+// dbStore adapts a [Store] to the store interfaces of the session manager
+type dbStore struct {
+	store Store
+}
+
+// Find implements scs.Store
+func (d *dbStore) Find(token string) ([]byte, bool, error) {
+	return d.FindCtx(context.Background(), token)
+}
+
+// Commit implements scs.Store
+func (d *dbStore) Commit(token string, b []byte, expiry time.Time) error {
+	return d.CommitCtx(context.Background(), token, b, expiry)
+}
+
+// Delete implements scs.Store
+func (d *dbStore) Delete(token string) error {
+	return d.DeleteCtx(context.Background(), token)
+}
+
+// All implements scs.IterableStore
+func (d *dbStore) All() (map[string][]byte, error) {
+	return d.AllCtx(context.Background())
+}
+
+// FindCtx implements scs.CtxStore
+// sessions that don't exist or have expired are not found rather than an error
+func (d *dbStore) FindCtx(ctx context.Context, token string) ([]byte, bool, error) {
+	b, err := d.store.GetSession(ctx, token)
+	if errors.Is(err, serrors.ErrNotFound) || errors.Is(err, serrors.ErrEmptyString) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return b, true, nil
+}
+
+// CommitCtx implements scs.CtxStore
+func (d *dbStore) CommitCtx(ctx context.Context, token string, b []byte, expiry time.Time) error {
+	return d.store.StoreSession(ctx, token, b, expiry)
+}
+
+// DeleteCtx implements scs.CtxStore
+// sessions that were never stored have no token so there is nothing to delete
+func (d *dbStore) DeleteCtx(ctx context.Context, token string) error {
+	if token == "" {
+		return nil
+	}
+	return d.store.RemoveSession(ctx, token)
+}
+
+// AllCtx implements scs.IterableCtxStore
+func (d *dbStore) AllCtx(ctx context.Context) (map[string][]byte, error) {
+	return d.store.FindSessions(ctx)
+}
@@ -3,13 +3,25 @@ package internal
 
 import (
 	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"net"
 	"net/http"
+	"os"
+	"time"
 
 	grpcreflect "github.com/bufbuild/connect-grpcreflect-go"
 	"github.com/go-chi/chi"
 
 	v1connect "github.com/lusis/statusthing/gen/go/statusthing/v1/statusthingv1connect"
+	"github.com/lusis/statusthing/internal/auth"
+	"github.com/lusis/statusthing/internal/certs"
+	"github.com/lusis/statusthing/internal/config"
 	"github.com/lusis/statusthing/internal/handlers"
+	"github.com/lusis/statusthing/internal/oidc"
 	"github.com/lusis/statusthing/internal/serrors"
 	"github.com/lusis/statusthing/internal/services"
 	"github.com/lusis/statusthing/internal/storers"
@@ -21,71 +33,282 @@ import (
 )
 
 // StatusThing is a statuspage application
+// the api, admin ui and public page are each served by their own optional listener
 type StatusThing struct {
-	apiHandler   *handlers.APIHandler
-	adminHandler *handlers.AdminHandler
-	svc          *services.StatusThingService
-	store        storers.StatusThingStorer
-	mux          chi.Router
-	httpServer   *http.Server
+	apiHandler         *handlers.APIHandler
+	adminHandler       *handlers.AdminHandler
+	publicHandler      *handlers.PublicHandler
+	svc                *services.StatusThingService
+	store              storers.StatusThingStorer
+	mux                chi.Router
+	listeners          []*listener
+	stopBackground     context.CancelFunc
+	certs              *certs.Reloader
+	certReloadInterval time.Duration
+	backupInterval     time.Duration
+	theme              fs.FS
 }
 
-// New returns a new StatusThing
-func New(store storers.StatusThingStorer, listenAddress string, logHandler slog.Handler, devMode bool) (*StatusThing, error) {
-	if !validation.ValidString(listenAddress) {
-		return nil, serrors.NewError("listenAddress", serrors.ErrEmptyString)
+// listener is one of the servers run by a [StatusThing]
+type listener struct {
+	name    string
+	network string
+	address string
+	server  *http.Server
+}
+
+// New returns a new StatusThing listening with the provided [config.Config]
+// the provided [services.ServiceOption] are used to configure the underlying service
+func New(store storers.StatusThingStorer, cfg *config.Config, logHandler slog.Handler, svcOpts ...services.ServiceOption) (*StatusThing, error) {
+	if cfg == nil {
+		return nil, serrors.NewError("config", serrors.ErrNilVal)
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
 	}
 	if store == nil {
 		return nil, serrors.NewError("store", serrors.ErrNilVal)
 	}
-	svc, err := services.NewStatusThingService(store)
+	svc, err := services.NewStatusThingService(store, svcOpts...)
 	if err != nil {
 		return nil, serrors.NewWrappedError("service", serrors.ErrDependencyMissing, err)
 	}
-	mux := chi.NewRouter()
-	// session.NewSession()
-	// mux.Use(session.Sessions.LoadAndSave)
-	if err := registerAPIHandler(mux, svc, devMode); err != nil {
-		return nil, err
+	st := &StatusThing{
+		store:          store,
+		svc:            svc,
+		backupInterval: cfg.Backup.Interval,
 	}
-	adminHandler, err := handlers.NewAdminHandler(svc, mux, devMode)
-	if err != nil {
-		return nil, serrors.NewWrappedError("adminhandler", serrors.ErrDependencyMissing, err)
+	if validation.ValidString(cfg.ThemeDir) {
+		info, err := os.Stat(cfg.ThemeDir)
+		if err != nil {
+			return nil, serrors.NewWrappedError("theme_dir", serrors.ErrNotFound, err)
+		}
+		if !info.IsDir() {
+			return nil, serrors.NewError("theme_dir "+cfg.ThemeDir+" is not a directory", serrors.ErrInvalidData)
+		}
+		st.theme = os.DirFS(cfg.ThemeDir)
 	}
-	server := &http.Server{
-		Addr:     listenAddress,
-		Handler:  h2c.NewHandler(requestLogger(mux), &http2.Server{}),
-		ErrorLog: slog.NewLogLogger(logHandler, slog.LevelError),
+	var serverTLS, clientTLS *tls.Config
+	if cfg.TLS.Enabled() {
+		serverTLS, clientTLS, err = st.configureTLS(cfg.TLS)
+		if err != nil {
+			return nil, err
+		}
 	}
-	st := &StatusThing{
-		adminHandler: adminHandler,
-		store:        store,
-		mux:          mux,
-		svc:          svc,
-		httpServer:   server,
+	identify := func(next http.Handler) http.Handler { return next }
+	if clientTLS != nil {
+		identify = certs.IdentityMiddleware(cfg.TLS.Identities())
+	}
+	errorLog := slog.NewLogLogger(logHandler, slog.LevelError)
+
+	if validation.ValidString(cfg.Listen.API) {
+		mux := chi.NewRouter()
+		if err := registerAPIHandler(mux, svc, cfg.DevMode); err != nil {
+			return nil, err
+		}
+		if cfg.Listen.SharedAdmin() {
+			if err := st.registerAdminHandler(mux, cfg); err != nil {
+				return nil, err
+			}
+		}
+		var handler http.Handler = identify(requestLogger("api", mux))
+		if cfg.Listen.H2C {
+			handler = h2c.NewHandler(handler, &http2.Server{})
+		}
+		st.mux = mux
+		st.addListener("api", "tcp", cfg.Listen.API, handler, clientTLS, errorLog)
+	}
+	if !cfg.Listen.SharedAdmin() {
+		mux := chi.NewRouter()
+		if err := st.registerAdminHandler(mux, cfg); err != nil {
+			return nil, err
+		}
+		if st.mux == nil {
+			st.mux = mux
+		}
+		if validation.ValidString(cfg.Listen.Admin) {
+			st.addListener("admin", "tcp", cfg.Listen.Admin, identify(requestLogger("admin", mux)), clientTLS, errorLog)
+		}
+		if validation.ValidString(cfg.Listen.AdminSocket) {
+			// only the owner can connect to the socket so there is nothing for tls to add
+			st.addListener("admin-socket", "unix", cfg.Listen.AdminSocket, requestLogger("admin-socket", mux), nil, errorLog)
+		}
+	}
+	if validation.ValidString(cfg.Listen.Public) {
+		publicHandler, err := handlers.NewPublicHandler(svc, cfg.DevMode, st.theme)
+		if err != nil {
+			return nil, serrors.NewWrappedError("publichandler", serrors.ErrDependencyMissing, err)
+		}
+		st.publicHandler = publicHandler
+		// visitors to the public page never need a client certificate
+		st.addListener("public", "tcp", cfg.Listen.Public, requestLogger("public", publicHandler), serverTLS, errorLog)
 	}
 
 	return st, nil
 }
 
-// Start starts the server
-func (st *StatusThing) Start() error {
-	if err := st.httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
-		return err
+// registerAdminHandler mounts the admin ui on the provided mux
+func (st *StatusThing) registerAdminHandler(mux chi.Router, cfg *config.Config) error {
+	var provider *oidc.Provider
+	if cfg.OIDC.Enabled() {
+		p, err := oidc.NewProvider(cfg.OIDC)
+		if err != nil {
+			return serrors.NewWrappedError("oidc", serrors.ErrInvalidData, err)
+		}
+		provider = p
+	}
+	adminHandler, err := handlers.NewAdminHandler(st.svc, mux, cfg.DevMode, cfg.Session, st.theme, provider)
+	if err != nil {
+		return serrors.NewWrappedError("adminhandler", serrors.ErrDependencyMissing, err)
 	}
+	st.adminHandler = adminHandler
 	return nil
 }
 
-// Stop stops the server
+func (st *StatusThing) addListener(name, network, address string, handler http.Handler, tlsConfig *tls.Config, errorLog *log.Logger) {
+	st.listeners = append(st.listeners, &listener{
+		name:    name,
+		network: network,
+		address: address,
+		server: &http.Server{
+			Addr:      address,
+			Handler:   handler,
+			TLSConfig: tlsConfig,
+			ErrorLog:  errorLog,
+		},
+	})
+}
+
+// configureTLS returns tls configs with a certificate that is reloaded when it changes
+// the first config never asks for client certificates and the second verifies them when client auth is configured
+func (st *StatusThing) configureTLS(cfg config.TLS) (*tls.Config, *tls.Config, error) {
+	reloader, err := certs.NewReloader(cfg.CertFile, cfg.KeyFile)
+	if err != nil {
+		return nil, nil, err
+	}
+	clientAuth, err := cfg.ClientAuthType()
+	if err != nil {
+		return nil, nil, err
+	}
+	serverTLS := &tls.Config{
+		MinVersion:     tls.VersionTLS12,
+		GetCertificate: reloader.GetCertificate,
+	}
+	clientTLS := serverTLS.Clone()
+	clientTLS.ClientAuth = clientAuth
+	if clientAuth != tls.NoClientCert {
+		pool, err := certs.ClientCAs(cfg.ClientCAFile)
+		if err != nil {
+			return nil, nil, err
+		}
+		clientTLS.ClientCAs = pool
+	}
+	st.certs = reloader
+	st.certReloadInterval = cfg.ReloadInterval
+	return serverTLS, clientTLS, nil
+}
+
+// Start starts every listener along with the background pruner, periodic backups and certificate reloading
+// it returns once every listener has stopped. if one listener fails the others are stopped
+func (st *StatusThing) Start() error {
+	// listen on everything first so a bad address fails before anything is served
+	lns := []net.Listener{}
+	for _, l := range st.listeners {
+		ln, err := l.listen()
+		if err != nil {
+			for _, open := range lns {
+				_ = open.Close()
+			}
+			return err
+		}
+		lns = append(lns, ln)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	st.stopBackground = cancel
+	go st.svc.RunPruner(ctx)
+	if st.backupInterval > 0 {
+		go st.svc.RunBackups(ctx, st.backupInterval)
+	}
+	if st.certs != nil {
+		go st.certs.Watch(ctx, st.certReloadInterval)
+	}
+	errs := make(chan error, len(st.listeners))
+	for i, l := range st.listeners {
+		slog.Info("listening", "listener", l.name, "address", l.address, "tls", l.server.TLSConfig != nil)
+		go func(l *listener, ln net.Listener) {
+			errs <- l.serve(ln)
+		}(l, lns[i])
+	}
+	var firstErr error
+	for range st.listeners {
+		err := <-errs
+		if err != nil && !errors.Is(err, http.ErrServerClosed) && firstErr == nil {
+			firstErr = err
+			go func() {
+				if err := st.Stop(context.Background()); err != nil {
+					slog.Error("error stopping listeners", "error", err)
+				}
+			}()
+		}
+	}
+	return firstErr
+}
+
+// Stop stops every listener and everything running in the background
 func (st *StatusThing) Stop(ctx context.Context) error {
-	return st.httpServer.Shutdown(ctx)
+	if st.stopBackground != nil {
+		st.stopBackground()
+	}
+	errs := []error{}
+	for _, l := range st.listeners {
+		if err := l.server.Shutdown(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", l.name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Service returns the service every listener is served by
+func (st *StatusThing) Service() *services.StatusThingService {
+	return st.svc
 }
 
 // Mux returns the configured mux for adding additiona routes
+// this is the api mux unless the api listener is disabled
 func (st *StatusThing) Mux() chi.Router {
 	return st.mux
 }
 
+func (l *listener) listen() (net.Listener, error) {
+	if l.network == "unix" {
+		// a socket left behind by an unclean shutdown would make listening fail
+		if info, err := os.Stat(l.address); err == nil && info.Mode()&os.ModeSocket != 0 {
+			if err := os.Remove(l.address); err != nil {
+				return nil, serrors.NewWrappedError(l.name, serrors.ErrUnrecoverable, err)
+			}
+		}
+	}
+	ln, err := net.Listen(l.network, l.address)
+	if err != nil {
+		return nil, serrors.NewWrappedError(l.name, serrors.ErrUnrecoverable, err)
+	}
+	if l.network == "unix" {
+		if err := os.Chmod(l.address, 0o600); err != nil {
+			_ = ln.Close()
+			return nil, serrors.NewWrappedError(l.name, serrors.ErrUnrecoverable, err)
+		}
+	}
+	return ln, nil
+}
+
+func (l *listener) serve(ln net.Listener) error {
+	if l.server.TLSConfig != nil {
+		// the certificate comes from TLSConfig
+		return l.server.ServeTLS(ln, "", "")
+	}
+	return l.server.Serve(ln)
+}
+
 func registerAPIHandler(mux chi.Router, svc *services.StatusThingService, reflect bool) error {
 	apiHandler, err := handlers.NewAPIHandler(svc)
 	if err != nil {
@@ -96,6 +319,8 @@ func registerAPIHandler(mux chi.Router, svc *services.StatusThingService, reflec
 			"statusthing.v1.ItemsService",
 			"statusthing.v1.StatusService",
 			"statusthing.v1.NotesService",
+			"statusthing.v1.DataService",
+			"statusthing.v1.SettingsService",
 		)
 		mux.Mount(grpcreflect.NewHandlerV1(reflector))
 		mux.Mount(grpcreflect.NewHandlerV1Alpha(reflector))
@@ -103,13 +328,19 @@ func registerAPIHandler(mux chi.Router, svc *services.StatusThingService, reflec
 	mux.Mount(v1connect.NewItemsServiceHandler(apiHandler))
 	mux.Mount(v1connect.NewNotesServiceHandler(apiHandler))
 	mux.Mount(v1connect.NewStatusServiceHandler(apiHandler))
+	mux.Mount(v1connect.NewDataServiceHandler(apiHandler))
+	mux.Mount(v1connect.NewSettingsServiceHandler(apiHandler))
 
 	return nil
 }
 
-func requestLogger(next http.Handler) http.HandlerFunc {
+func requestLogger(name string, next http.Handler) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		slog.Info("handling request", "http.path", r.URL.Path, "http.host", r.Host, "http.method", r.Method, "http.client", r.Header.Get("User-Agent"), "content-type", r.Header.Get("content-type"))
+		identity := ""
+		if id, ok := auth.FromContext(r.Context()); ok {
+			identity = id.Name
+		}
+		slog.Info("handling request", "listener", name, "http.path", r.URL.Path, "http.host", r.Host, "http.method", r.Method, "http.client", r.Header.Get("User-Agent"), "content-type", r.Header.Get("content-type"), "auth.identity", identity)
 		slog.Debug("all headers", "headers", r.Header)
 		next.ServeHTTP(w, r)
 	}
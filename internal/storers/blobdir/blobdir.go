@@ -0,0 +1,137 @@
+package blobdir
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/lusis/statusthing/internal/serrors"
+	"github.com/lusis/statusthing/internal/validation"
+)
+
+// tmpPrefix is the prefix of files being written. they are renamed once complete
+const tmpPrefix = ".tmp-"
+
+// Store stores blobs as files in a directory, one file per key
+type Store struct {
+	dir string
+}
+
+// New returns a new [Store] keeping blobs in the provided directory, creating it if needed
+func New(dir string) (*Store, error) {
+	if !validation.ValidString(dir) {
+		return nil, serrors.NewError("dir", serrors.ErrEmptyString)
+	}
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, serrors.NewWrappedError(dir, serrors.ErrStoreUnavailable, err)
+	}
+	return &Store{dir: dir}, nil
+}
+
+// PutBlob stores everything read from the provided reader under the provided key
+// the blob is written to a temporary file first so a failed write never leaves a partial blob behind
+func (s *Store) PutBlob(ctx context.Context, key string, r io.Reader) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(s.dir, tmpPrefix+key+"-")
+	if err != nil {
+		return serrors.NewWrappedError("create", serrors.ErrStoreUnavailable, err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := io.Copy(tmp, &ctxReader{ctx: ctx, r: r}); err != nil {
+		tmp.Close()
+		return serrors.NewWrappedError("write", serrors.ErrUnrecoverable, err)
+	}
+	if err := tmp.Close(); err != nil {
+		return serrors.NewWrappedError("close", serrors.ErrUnrecoverable, err)
+	}
+	if _, err := os.Stat(path); err == nil {
+		return serrors.NewError(key, serrors.ErrAlreadySet)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return serrors.NewWrappedError("rename", serrors.ErrUnrecoverable, err)
+	}
+	return nil
+}
+
+// GetBlob returns a reader for the blob with the provided key
+func (s *Store) GetBlob(_ context.Context, key string) (io.ReadCloser, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, serrors.NewWrappedError("blob", serrors.ErrNotFound, err)
+	}
+	if err != nil {
+		return nil, serrors.NewWrappedError("open", serrors.ErrStoreUnavailable, err)
+	}
+	return f, nil
+}
+
+// ListBlobs returns the key of every stored blob
+func (s *Store) ListBlobs(_ context.Context) ([]string, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, serrors.NewWrappedError("list", serrors.ErrStoreUnavailable, err)
+	}
+	keys := []string{}
+	for _, entry := range entries {
+		if entry.Type().IsRegular() && !strings.HasPrefix(entry.Name(), tmpPrefix) {
+			keys = append(keys, entry.Name())
+		}
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+// DeleteBlob deletes the blob with the provided key
+func (s *Store) DeleteBlob(_ context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	err = os.Remove(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return serrors.NewWrappedError("blob", serrors.ErrNotFound, err)
+	}
+	if err != nil {
+		return serrors.NewWrappedError("remove", serrors.ErrUnrecoverable, err)
+	}
+	return nil
+}
+
+// path returns the path of the file for the provided key
+// keys are used as file names so only letters, digits, - and _ are allowed
+func (s *Store) path(key string) (string, error) {
+	if !validation.ValidString(key) {
+		return "", serrors.NewError("key", serrors.ErrEmptyString)
+	}
+	for _, r := range key {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' || r == '_') {
+			return "", serrors.NewError("key "+key, serrors.ErrInvalidData)
+		}
+	}
+	return filepath.Join(s.dir, key), nil
+}
+
+// ctxReader stops reading once its context is done
+type ctxReader struct {
+	ctx context.Context
+	r   io.Reader
+}
+
+func (c *ctxReader) Read(p []byte) (int, error) {
+	if err := c.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return c.r.Read(p)
+}
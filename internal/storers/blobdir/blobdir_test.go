@@ -0,0 +1,50 @@
+package blobdir
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/lusis/statusthing/internal/serrors"
+	"github.com/lusis/statusthing/internal/storers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStore(t *testing.T) {
+	ctx := context.TODO()
+	_, err := New(" ")
+	require.ErrorIs(t, err, serrors.ErrEmptyString)
+
+	dir := filepath.Join(t.TempDir(), "blobs")
+	store, err := New(dir)
+	require.NoError(t, err)
+	require.Implements(t, (*storers.BlobStorer)(nil), store)
+
+	require.NoError(t, store.PutBlob(ctx, "first", strings.NewReader("hello")))
+	require.ErrorIs(t, store.PutBlob(ctx, "first", strings.NewReader("again")), serrors.ErrAlreadySet)
+	require.ErrorIs(t, store.PutBlob(ctx, "../escape", strings.NewReader("x")), serrors.ErrInvalidData)
+	_, err = os.Stat(filepath.Join(filepath.Dir(dir), "escape"))
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	r, err := store.GetBlob(ctx, "first")
+	require.NoError(t, err)
+	b, err := io.ReadAll(r)
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+	require.Equal(t, "hello", string(b))
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	require.Error(t, store.PutBlob(cancelled, "second", strings.NewReader("never")))
+	keys, err := store.ListBlobs(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []string{"first"}, keys, "failed writes should not leave anything behind")
+
+	require.NoError(t, store.DeleteBlob(ctx, "first"))
+	require.ErrorIs(t, store.DeleteBlob(ctx, "first"), serrors.ErrNotFound)
+	_, err = store.GetBlob(ctx, "first")
+	require.ErrorIs(t, err, serrors.ErrNotFound)
+}
@@ -0,0 +1,2 @@
+// Package blobdir implements a [storers.BlobStorer] backed by a local directory
+package blobdir
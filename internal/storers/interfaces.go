@@ -2,6 +2,8 @@ package storers
 
 import (
 	"context"
+	"io"
+	"time"
 
 	v1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
 	"github.com/lusis/statusthing/internal/filters"
@@ -17,6 +19,86 @@ type StatusThingStorer interface {
 	StatusStorer
 	ItemStorer
 	UserStorer
+	DependencyStorer
+	ItemBatchStorer
+	IdempotencyStorer
+	AttachmentStorer
+	SettingsStorer
+	SessionStorer
+}
+
+// Transactor is implemented by stores that can run many operations in a single transaction
+// it is an optional capability and not part of [StatusThingStorer]
+type Transactor interface {
+	// WithTx calls the provided function with a [StatusThingStorer] that does all of its work in a single transaction
+	// the transaction is committed if the function returns nil and rolled back otherwise
+	WithTx(ctx context.Context, fn func(StatusThingStorer) error) error
+}
+
+// Backuper is implemented by stores that can write a consistent copy of themselves while in use
+// it is an optional capability and not part of [StatusThingStorer]
+type Backuper interface {
+	// Backup writes a copy of the store to a new file at the provided path
+	// [serrors.ErrAlreadySet] is returned if the file already exists
+	Backup(ctx context.Context, path string) error
+}
+
+// BlobStorer stores the contents of files such as attachments by key
+// the sqlite store keeps blobs in the database. other implementations can keep them elsewhere
+type BlobStorer interface {
+	// PutBlob stores everything read from the provided reader under the provided key
+	PutBlob(ctx context.Context, key string, r io.Reader) error
+	// GetBlob returns a reader for the blob with the provided key. callers must close it
+	GetBlob(ctx context.Context, key string) (io.ReadCloser, error)
+	// ListBlobs returns the key of every stored blob
+	ListBlobs(ctx context.Context) ([]string, error)
+	// DeleteBlob deletes the blob with the provided key
+	DeleteBlob(ctx context.Context, key string) error
+}
+
+const (
+	// SettingsLogo is the name of the logo [SettingsImage]
+	SettingsLogo = "logo"
+	// SettingsFavicon is the name of the favicon [SettingsImage]
+	SettingsFavicon = "favicon"
+)
+
+// SettingsImage is an image stored along with the [v1.Settings]
+type SettingsImage struct {
+	// Name is which image this is: [SettingsLogo] or [SettingsFavicon]
+	Name string
+	// ContentType is the content type of the image
+	ContentType string
+	// Data is the image. empty data removes the image
+	Data []byte
+}
+
+// SettingsStorer stores the [v1.Settings]
+type SettingsStorer interface {
+	// GetSettings gets the [v1.Settings]
+	// settings that were never stored are returned empty with a version of 0
+	GetSettings(ctx context.Context) (*v1.Settings, error)
+	// StoreSettings replaces the [v1.Settings] and stores the provided images
+	// if expectedVersion is not zero the settings are only stored when their current version matches and [serrors.ErrConflict] is returned otherwise
+	StoreSettings(ctx context.Context, settings *v1.Settings, expectedVersion uint64, images ...*SettingsImage) (*v1.Settings, error)
+	// GetSettingsImage gets the [SettingsImage] with the provided name
+	GetSettingsImage(ctx context.Context, name string) (*SettingsImage, error)
+}
+
+// SessionStorer stores admin ui sessions
+// the data of a session is opaque to the store
+type SessionStorer interface {
+	// StoreSession creates or replaces the session with the provided token
+	StoreSession(ctx context.Context, token string, data []byte, expires time.Time) error
+	// GetSession gets the data of the session with the provided token
+	// sessions that have expired are [serrors.ErrNotFound]
+	GetSession(ctx context.Context, token string) ([]byte, error)
+	// FindSessions gets the data of every session that hasn't expired by token
+	FindSessions(ctx context.Context) (map[string][]byte, error)
+	// DeleteSession deletes the session with the provided token. deleting a session that doesn't exist is not an error
+	DeleteSession(ctx context.Context, token string) error
+	// DeleteExpiredSessions deletes every session that expired before the provided time and returns how many were deleted
+	DeleteExpiredSessions(ctx context.Context, before time.Time) (int, error)
 }
 
 // UserStorer stores [v1.User]
@@ -31,6 +113,8 @@ type UserStorer interface {
 	UpdateUser(ctx context.Context, username string, opts ...filters.FilterOption) error
 	// DeleteUser deletes a [v1.User]
 	DeleteUser(ctx context.Context, username string) error
+	// GetAvatar gets the avatar image of a [v1.User] by username
+	GetAvatar(ctx context.Context, username string) ([]byte, error)
 }
 
 // ItemStorer storers [statusthingv1.Item]
@@ -47,6 +131,55 @@ type ItemStorer interface {
 	DeleteItem(ctx context.Context, itemID string) error
 }
 
+// ItemBatchStorer changes many [statusthingv1.Item] at once
+// batch operations are all-or-nothing and return a [serrors.BatchError] with the error for each failed item
+type ItemBatchStorer interface {
+	// BatchUpdateItems updates every [statusthingv1.Item] with the provided ids with the provided [filters.FilterOption]
+	// and stores the [statusthingv1.Note] for each item id in notes, if any
+	BatchUpdateItems(ctx context.Context, itemIDs []string, notes map[string]*v1.Note, opts ...filters.FilterOption) error
+	// BatchDeleteItems deletes every [statusthingv1.Item] with the provided ids
+	BatchDeleteItems(ctx context.Context, itemIDs []string) error
+}
+
+// DependencyStorer stores [statusthingv1.ItemDependency]
+type DependencyStorer interface {
+	// StoreDependency stores the provided [statusthingv1.ItemDependency]
+	StoreDependency(ctx context.Context, dep *v1.ItemDependency) (*v1.ItemDependency, error)
+	// FindDependencies returns all known [statusthingv1.ItemDependency] optionally filtered by the provided [filters.FilterOption]
+	FindDependencies(ctx context.Context, opts ...filters.FilterOption) ([]*v1.ItemDependency, error)
+	// DeleteDependency deletes the [statusthingv1.ItemDependency] between the provided item ids
+	DeleteDependency(ctx context.Context, itemID, dependsOnID string) error
+}
+
+// IdempotencyRecord is the stored result of a request made with an idempotency key
+type IdempotencyRecord struct {
+	// Key is the idempotency key provided by the client
+	Key string
+	// RequestHash identifies the request the key was first used with
+	RequestHash string
+	// Response is the serialized response. it is empty while the request is still being handled
+	Response []byte
+	// Created is when the key was first used
+	Created time.Time
+	// Expires is when the key can be reused
+	Expires time.Time
+}
+
+// IdempotencyStorer stores [IdempotencyRecord]
+type IdempotencyStorer interface {
+	// StoreIdempotencyRecord stores the provided [IdempotencyRecord]
+	// [serrors.ErrConflict] is returned if a record with the same key already exists
+	StoreIdempotencyRecord(ctx context.Context, rec *IdempotencyRecord) error
+	// GetIdempotencyRecord gets an [IdempotencyRecord] by its key
+	GetIdempotencyRecord(ctx context.Context, key string) (*IdempotencyRecord, error)
+	// UpdateIdempotencyRecord sets the response of the [IdempotencyRecord] with the provided key
+	UpdateIdempotencyRecord(ctx context.Context, key string, response []byte) error
+	// DeleteIdempotencyRecord deletes an [IdempotencyRecord] by its key
+	DeleteIdempotencyRecord(ctx context.Context, key string) error
+	// DeleteExpiredIdempotencyRecords deletes every [IdempotencyRecord] that expired before the provided time and returns how many were deleted
+	DeleteExpiredIdempotencyRecords(ctx context.Context, before time.Time) (int, error)
+}
+
 // NoteStorer stores [statusthingv1.Note]
 type NoteStorer interface {
 	// StoreNote stores the provided [statusthingv1.Note] associated with the provided [statusthingv1.StatusThing] by its id
@@ -56,11 +189,29 @@ type NoteStorer interface {
 	// FindNotes gets all known [statusthingv1.Note] for the provided item id
 	FindNotes(ctx context.Context, itemID string, opts ...filters.FilterOption) ([]*v1.Note, error)
 	// UpdateNote updates the [statusthingv1.Note] with the provided [filters.FilterOption]
+	// the note as it was before the update is kept as a [statusthingv1.NoteRevision]
 	UpdateNote(ctx context.Context, noteID string, opts ...filters.FilterOption) error
+	// FindNoteRevisions gets every [statusthingv1.NoteRevision] of the [statusthingv1.Note] with the provided id, oldest first
+	FindNoteRevisions(ctx context.Context, noteID string) ([]*v1.NoteRevision, error)
 	// DeleteNote deletes a [statusthingv1.Note] by its id
 	DeleteNote(ctx context.Context, noteID string) error
 }
 
+// AttachmentStorer stores [statusthingv1.Attachment]
+// only the details of an attachment are stored here. the contents are kept in a [BlobStorer] under the attachment id
+type AttachmentStorer interface {
+	// StoreAttachment stores the provided [statusthingv1.Attachment]
+	StoreAttachment(ctx context.Context, att *v1.Attachment) (*v1.Attachment, error)
+	// GetAttachment gets a [statusthingv1.Attachment] by its id
+	GetAttachment(ctx context.Context, attachmentID string) (*v1.Attachment, error)
+	// FindAttachments gets every [statusthingv1.Attachment] of the [statusthingv1.Note] with the provided id, oldest first
+	FindAttachments(ctx context.Context, noteID string) ([]*v1.Attachment, error)
+	// AttachmentExists is true when an [statusthingv1.Attachment] with the provided id is stored
+	AttachmentExists(ctx context.Context, attachmentID string) (bool, error)
+	// DeleteAttachment deletes a [statusthingv1.Attachment] by its id
+	DeleteAttachment(ctx context.Context, attachmentID string) error
+}
+
 // StatusStorer stores [statusthingv1.Status]
 type StatusStorer interface {
 	// StoreStatus stores the provided [statusthingv1.Status]
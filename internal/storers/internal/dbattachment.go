@@ -0,0 +1,87 @@
+package internal
+
+import (
+	statusthingv1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
+	"github.com/lusis/statusthing/internal/serrors"
+	"github.com/lusis/statusthing/internal/storers"
+	"github.com/lusis/statusthing/internal/validation"
+)
+
+// DbAttachment is a common representation of a [statusthingv1.Attachment] in a database
+type DbAttachment struct {
+	ID          string `db:"id"`
+	NoteID      string `db:"note_id"`
+	Filename    string `db:"filename"`
+	ContentType string `db:"content_type"`
+	Size        int64  `db:"size"`
+	Sha256      string `db:"sha256"`
+	*DbTimestamps
+}
+
+// DbAttachmentFromProto returns a [DbAttachment] from a [statusthingv1.Attachment]
+func DbAttachmentFromProto(pbatt *statusthingv1.Attachment) (*DbAttachment, error) {
+	if pbatt == nil {
+		return nil, serrors.NewError("attachment", serrors.ErrNilVal)
+	}
+	if !validation.ValidString(pbatt.GetId()) {
+		return nil, serrors.NewError("id", serrors.ErrEmptyString)
+	}
+	if !validation.ValidString(pbatt.GetNoteId()) {
+		return nil, serrors.NewError("note_id", serrors.ErrEmptyString)
+	}
+	if !validation.ValidString(pbatt.GetFilename()) {
+		return nil, serrors.NewError("filename", serrors.ErrEmptyString)
+	}
+	if !validation.ValidString(pbatt.GetContentType()) {
+		return nil, serrors.NewError("content_type", serrors.ErrEmptyString)
+	}
+	if !validation.ValidString(pbatt.GetSha256()) {
+		return nil, serrors.NewError("sha256", serrors.ErrEmptyString)
+	}
+	ts, err := MakeDbTimestamps(pbatt.GetTimestamps())
+	if err != nil {
+		return nil, err
+	}
+	return &DbAttachment{
+		ID:           pbatt.GetId(),
+		NoteID:       pbatt.GetNoteId(),
+		Filename:     pbatt.GetFilename(),
+		ContentType:  pbatt.GetContentType(),
+		Size:         pbatt.GetSize(),
+		Sha256:       pbatt.GetSha256(),
+		DbTimestamps: ts,
+	}, nil
+}
+
+// ToProto returns a [statusthingv1.Attachment] from a [DbAttachment]
+func (a *DbAttachment) ToProto() (*statusthingv1.Attachment, error) {
+	if !validation.ValidString(a.ID) {
+		return nil, serrors.NewError("id", serrors.ErrInvalidData)
+	}
+	if !validation.ValidString(a.NoteID) {
+		return nil, serrors.NewError("note_id", serrors.ErrInvalidData)
+	}
+	if a.DbTimestamps == nil {
+		return nil, serrors.NewError("timestamps", serrors.ErrInvalidData)
+	}
+	pbcreated := storers.Int64ToTs(int64(a.Created))
+	pbupdated := storers.Int64ToTs(int64(a.Updated))
+	if pbcreated == nil {
+		return nil, serrors.NewError("created", serrors.ErrInvalidData)
+	}
+	if pbupdated == nil {
+		return nil, serrors.NewError("updated", serrors.ErrInvalidData)
+	}
+	return &statusthingv1.Attachment{
+		Id:          a.ID,
+		NoteId:      a.NoteID,
+		Filename:    a.Filename,
+		ContentType: a.ContentType,
+		Size:        a.Size,
+		Sha256:      a.Sha256,
+		Timestamps: &statusthingv1.Timestamps{
+			Created: pbcreated,
+			Updated: pbupdated,
+		},
+	}, nil
+}
@@ -0,0 +1,71 @@
+package internal
+
+import (
+	"testing"
+
+	statusthingv1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
+	"github.com/lusis/statusthing/internal/serrors"
+	"github.com/lusis/statusthing/internal/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttachmentFromProto(t *testing.T) {
+	t.Parallel()
+	_, err := DbAttachmentFromProto(nil)
+	require.ErrorIs(t, err, serrors.ErrNilVal)
+
+	makeAttachment := func() *statusthingv1.Attachment {
+		return &statusthingv1.Attachment{
+			Id:          t.Name() + "_attachment_id",
+			NoteId:      t.Name() + "_note_id",
+			Filename:    "graph.png",
+			ContentType: "image/png",
+			Size:        42,
+			Sha256:      "abc123",
+			Timestamps:  testutils.MakeTimestamps(false),
+		}
+	}
+	testcases := map[string]struct {
+		modify  func(*statusthingv1.Attachment)
+		err     error
+		errtext string
+	}{
+		"happy-path":           {modify: func(*statusthingv1.Attachment) {}},
+		"missing-id":           {modify: func(a *statusthingv1.Attachment) { a.Id = "" }, err: serrors.ErrEmptyString, errtext: "id"},
+		"missing-note-id":      {modify: func(a *statusthingv1.Attachment) { a.NoteId = "" }, err: serrors.ErrEmptyString, errtext: "note_id"},
+		"missing-filename":     {modify: func(a *statusthingv1.Attachment) { a.Filename = " " }, err: serrors.ErrEmptyString, errtext: "filename"},
+		"missing-content-type": {modify: func(a *statusthingv1.Attachment) { a.ContentType = "" }, err: serrors.ErrEmptyString, errtext: "content_type"},
+		"missing-sha256":       {modify: func(a *statusthingv1.Attachment) { a.Sha256 = "" }, err: serrors.ErrEmptyString, errtext: "sha256"},
+		"missing-timestamps":   {modify: func(a *statusthingv1.Attachment) { a.Timestamps = nil }, err: serrors.ErrMissingTimestamp},
+	}
+	for n, tc := range testcases {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+			pb := makeAttachment()
+			tc.modify(pb)
+			rec, err := DbAttachmentFromProto(pb)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				require.ErrorContains(t, err, tc.errtext)
+				require.Nil(t, rec)
+				return
+			}
+			require.NoError(t, err)
+			res, err := rec.ToProto()
+			require.NoError(t, err)
+			require.Equal(t, pb.GetId(), res.GetId())
+			require.Equal(t, pb.GetNoteId(), res.GetNoteId())
+			require.Equal(t, pb.GetFilename(), res.GetFilename())
+			require.Equal(t, pb.GetContentType(), res.GetContentType())
+			require.Equal(t, pb.GetSize(), res.GetSize())
+			require.Equal(t, pb.GetSha256(), res.GetSha256())
+			require.True(t, res.GetTimestamps().GetCreated().IsValid())
+		})
+	}
+
+	t.Run("invalid-record", func(t *testing.T) {
+		_, err := (&DbAttachment{ID: t.Name()}).ToProto()
+		require.ErrorIs(t, err, serrors.ErrInvalidData)
+	})
+}
@@ -0,0 +1,72 @@
+package internal
+
+import (
+	statusthingv1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
+	"github.com/lusis/statusthing/internal/serrors"
+	"github.com/lusis/statusthing/internal/storers"
+	"github.com/lusis/statusthing/internal/validation"
+)
+
+// DbItemDependency is a common representation of a [statusthingv1.ItemDependency] in a database
+type DbItemDependency struct {
+	ItemID      string `db:"item_id"`
+	DependsOnID string `db:"depends_on_id"`
+	*DbTimestamps
+}
+
+// DbItemDependencyFromProto returns a [DbItemDependency] from a [statusthingv1.ItemDependency]
+func DbItemDependencyFromProto(pbdep *statusthingv1.ItemDependency) (*DbItemDependency, error) {
+	if pbdep == nil {
+		return nil, serrors.NewError("dependency", serrors.ErrNilVal)
+	}
+	itemID := pbdep.GetItemId()
+	dependsOnID := pbdep.GetDependsOnId()
+	if !validation.ValidString(itemID) {
+		return nil, serrors.NewError("item_id", serrors.ErrEmptyString)
+	}
+	if !validation.ValidString(dependsOnID) {
+		return nil, serrors.NewError("depends_on_id", serrors.ErrEmptyString)
+	}
+	ts, err := MakeDbTimestamps(pbdep.GetTimestamps())
+	if err != nil {
+		return nil, err
+	}
+	return &DbItemDependency{
+		ItemID:       itemID,
+		DependsOnID:  dependsOnID,
+		DbTimestamps: ts,
+	}, nil
+}
+
+// ToProto returns a [statusthingv1.ItemDependency] from a [DbItemDependency]
+func (d *DbItemDependency) ToProto() (*statusthingv1.ItemDependency, error) {
+	if !validation.ValidString(d.ItemID) {
+		return nil, serrors.NewError("item_id", serrors.ErrInvalidData)
+	}
+	if !validation.ValidString(d.DependsOnID) {
+		return nil, serrors.NewError("depends_on_id", serrors.ErrInvalidData)
+	}
+	if d.DbTimestamps == nil {
+		return nil, serrors.NewError("timestamps", serrors.ErrInvalidData)
+	}
+	pbcreated := storers.Int64ToTs(int64(d.Created))
+	pbupdated := storers.Int64ToTs(int64(d.Updated))
+	if pbcreated == nil {
+		return nil, serrors.NewError("created", serrors.ErrInvalidData)
+	}
+	if pbupdated == nil {
+		return nil, serrors.NewError("updated", serrors.ErrInvalidData)
+	}
+	res := &statusthingv1.ItemDependency{
+		ItemId:      d.ItemID,
+		DependsOnId: d.DependsOnID,
+		Timestamps: &statusthingv1.Timestamps{
+			Created: pbcreated,
+			Updated: pbupdated,
+		},
+	}
+	if d.Deleted != nil {
+		res.Timestamps.Deleted = storers.Int64ToTs(int64(*d.Deleted))
+	}
+	return res, nil
+}
@@ -0,0 +1,134 @@
+package internal
+
+import (
+	"testing"
+
+	statusthingv1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
+	"github.com/lusis/statusthing/internal/serrors"
+	"github.com/lusis/statusthing/internal/storers"
+	"github.com/lusis/statusthing/internal/testutils"
+	"github.com/lusis/statusthing/internal/validation"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type dependencyTestCase struct {
+	dbdep   *DbItemDependency
+	pbdep   *statusthingv1.ItemDependency
+	errtext string
+	err     error
+}
+
+func TestItemDependencyFromProto(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil-check", func(t *testing.T) {
+		s, serr := DbItemDependencyFromProto(nil)
+		require.ErrorIs(t, serr, serrors.ErrNilVal)
+		require.Nil(t, s)
+	})
+
+	testcases := map[string]dependencyTestCase{
+		"happy-path": {},
+		"missing-item-id": {
+			pbdep:   &statusthingv1.ItemDependency{DependsOnId: t.Name()},
+			err:     serrors.ErrEmptyString,
+			errtext: "item_id",
+		},
+		"missing-depends-on-id": {
+			pbdep:   &statusthingv1.ItemDependency{ItemId: t.Name()},
+			err:     serrors.ErrEmptyString,
+			errtext: "depends_on_id",
+		},
+		"missing-timestamps": {
+			pbdep:   &statusthingv1.ItemDependency{ItemId: t.Name(), DependsOnId: t.Name()},
+			err:     serrors.ErrMissingTimestamp,
+			errtext: "timestamps",
+		},
+	}
+
+	for n, tc := range testcases {
+		t.Run(n, func(t *testing.T) {
+			pb := tc.pbdep
+			if pb == nil {
+				pb = &statusthingv1.ItemDependency{
+					ItemId:      t.Name(),
+					DependsOnId: t.Name() + "_dependency",
+					Timestamps:  testutils.MakeTimestamps(false),
+				}
+			}
+			s, serr := DbItemDependencyFromProto(pb)
+			if tc.err != nil {
+				require.ErrorIs(t, serr, tc.err)
+				require.Nil(t, s)
+				if validation.ValidString(tc.errtext) {
+					require.ErrorContains(t, serr, tc.errtext)
+				}
+			} else {
+				require.NoError(t, serr)
+				require.NotNil(t, s)
+				require.Equal(t, pb.GetItemId(), s.ItemID)
+				require.Equal(t, pb.GetDependsOnId(), s.DependsOnID)
+				require.NotZero(t, s.Created)
+				require.NotZero(t, s.Updated)
+			}
+		})
+	}
+}
+
+func TestItemDependencyToProto(t *testing.T) {
+	t.Parallel()
+	testcases := map[string]dependencyTestCase{
+		"happy-path": {},
+		"missing-item-id": {
+			dbdep:   &DbItemDependency{DependsOnID: t.Name()},
+			err:     serrors.ErrInvalidData,
+			errtext: "item_id",
+		},
+		"missing-depends-on-id": {
+			dbdep:   &DbItemDependency{ItemID: t.Name()},
+			err:     serrors.ErrInvalidData,
+			errtext: "depends_on_id",
+		},
+		"missing-created": {
+			dbdep: &DbItemDependency{
+				ItemID:       t.Name(),
+				DependsOnID:  t.Name(),
+				DbTimestamps: &DbTimestamps{Updated: storers.TsToUInt64(timestamppb.Now())},
+			},
+			err:     serrors.ErrInvalidData,
+			errtext: "created",
+		},
+	}
+
+	for n, tc := range testcases {
+		t.Run(n, func(t *testing.T) {
+			dbdep := tc.dbdep
+			if dbdep == nil {
+				dbdep = &DbItemDependency{
+					ItemID:      t.Name(),
+					DependsOnID: t.Name() + "_dependency",
+					DbTimestamps: &DbTimestamps{
+						Created: storers.TsToUInt64(timestamppb.Now()),
+						Updated: storers.TsToUInt64(timestamppb.Now()),
+					},
+				}
+			}
+			s, serr := dbdep.ToProto()
+			if tc.err != nil {
+				require.ErrorIs(t, serr, tc.err)
+				require.Nil(t, s)
+				if validation.ValidString(tc.errtext) {
+					require.ErrorContains(t, serr, tc.errtext)
+				}
+			} else {
+				require.NoError(t, serr)
+				require.NotNil(t, s)
+				require.Equal(t, dbdep.ItemID, s.GetItemId())
+				require.Equal(t, dbdep.DependsOnID, s.GetDependsOnId())
+				require.True(t, s.GetTimestamps().GetCreated().IsValid())
+				require.True(t, s.GetTimestamps().GetUpdated().IsValid())
+			}
+		})
+	}
+}
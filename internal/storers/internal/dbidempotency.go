@@ -0,0 +1,58 @@
+package internal
+
+import (
+	"time"
+
+	"github.com/lusis/statusthing/internal/serrors"
+	"github.com/lusis/statusthing/internal/storers"
+	"github.com/lusis/statusthing/internal/validation"
+)
+
+// DbIdempotencyRecord is a common representation of a [storers.IdempotencyRecord] in a database
+type DbIdempotencyRecord struct {
+	Key         string `db:"idempotency_key"`
+	RequestHash string `db:"request_hash"`
+	Response    []byte `db:"response"`
+	Created     uint64 `db:"created"`
+	Expires     uint64 `db:"expires"`
+}
+
+// DbIdempotencyRecordFromRecord returns a [DbIdempotencyRecord] from a [storers.IdempotencyRecord]
+func DbIdempotencyRecordFromRecord(rec *storers.IdempotencyRecord) (*DbIdempotencyRecord, error) {
+	if rec == nil {
+		return nil, serrors.NewError("record", serrors.ErrNilVal)
+	}
+	if !validation.ValidString(rec.Key) {
+		return nil, serrors.NewError("key", serrors.ErrEmptyString)
+	}
+	if !validation.ValidString(rec.RequestHash) {
+		return nil, serrors.NewError("request_hash", serrors.ErrEmptyString)
+	}
+	if rec.Created.IsZero() || rec.Expires.IsZero() {
+		return nil, serrors.NewError("created/expires", serrors.ErrMissingTimestamp)
+	}
+	return &DbIdempotencyRecord{
+		Key:         rec.Key,
+		RequestHash: rec.RequestHash,
+		Response:    rec.Response,
+		Created:     storers.TimeToUint64(&rec.Created),
+		Expires:     storers.TimeToUint64(&rec.Expires),
+	}, nil
+}
+
+// ToRecord returns a [storers.IdempotencyRecord] from a [DbIdempotencyRecord]
+func (d *DbIdempotencyRecord) ToRecord() (*storers.IdempotencyRecord, error) {
+	if !validation.ValidString(d.Key) {
+		return nil, serrors.NewError("key", serrors.ErrInvalidData)
+	}
+	if d.Created == 0 || d.Expires == 0 {
+		return nil, serrors.NewError("created/expires", serrors.ErrInvalidData)
+	}
+	return &storers.IdempotencyRecord{
+		Key:         d.Key,
+		RequestHash: d.RequestHash,
+		Response:    d.Response,
+		Created:     time.Unix(0, int64(d.Created)).UTC(),
+		Expires:     time.Unix(0, int64(d.Expires)).UTC(),
+	}, nil
+}
@@ -0,0 +1,57 @@
+package internal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lusis/statusthing/internal/serrors"
+	"github.com/lusis/statusthing/internal/storers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIdempotencyRecordRoundTrip(t *testing.T) {
+	t.Parallel()
+	now := time.Now().UTC()
+	valid := func() *storers.IdempotencyRecord {
+		return &storers.IdempotencyRecord{
+			Key:         t.Name(),
+			RequestHash: "some-hash",
+			Response:    []byte("some-response"),
+			Created:     now,
+			Expires:     now.Add(time.Hour),
+		}
+	}
+	testcases := map[string]struct {
+		rec func() *storers.IdempotencyRecord
+		err error
+	}{
+		"happy-path": {rec: valid},
+		"nil-check":  {rec: func() *storers.IdempotencyRecord { return nil }, err: serrors.ErrNilVal},
+		"missing-key": {
+			rec: func() *storers.IdempotencyRecord { r := valid(); r.Key = ""; return r },
+			err: serrors.ErrEmptyString,
+		},
+		"missing-hash": {
+			rec: func() *storers.IdempotencyRecord { r := valid(); r.RequestHash = ""; return r },
+			err: serrors.ErrEmptyString,
+		},
+		"missing-expires": {
+			rec: func() *storers.IdempotencyRecord { r := valid(); r.Expires = time.Time{}; return r },
+			err: serrors.ErrMissingTimestamp,
+		},
+	}
+	for n, tc := range testcases {
+		t.Run(n, func(t *testing.T) {
+			dbrec, err := DbIdempotencyRecordFromRecord(tc.rec())
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				require.Nil(t, dbrec)
+				return
+			}
+			require.NoError(t, err)
+			res, err := dbrec.ToRecord()
+			require.NoError(t, err)
+			require.Equal(t, tc.rec(), res)
+		})
+	}
+}
@@ -1,8 +1,6 @@
 package internal
 
 import (
-	"html"
-
 	statusthingv1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
 	"github.com/lusis/statusthing/internal/serrors"
 	"github.com/lusis/statusthing/internal/storers"
@@ -12,7 +10,9 @@ import (
 // DbItem represents a common representation of an [statusthingv1.Item] in a db
 type DbItem struct {
 	*DbCommon
-	StatusID *string `db:"status_id"`
+	StatusID     *string `db:"status_id"`
+	ParentID     *string `db:"parent_id"`
+	RollupPolicy *string `db:"rollup_policy"`
 }
 
 // DbItemFromProto creates a [DbItem] from a [statusthingv1.Item]
@@ -24,6 +24,9 @@ func DbItemFromProto(pbitem *statusthingv1.Item) (*DbItem, error) {
 	if err != nil {
 		return nil, err
 	}
+	if pbitem.GetVersion() != 0 {
+		dbCommon.Version = pbitem.GetVersion()
+	}
 
 	statusID := pbitem.GetStatus().GetId()
 
@@ -34,6 +37,12 @@ func DbItemFromProto(pbitem *statusthingv1.Item) (*DbItem, error) {
 	if validation.ValidString(statusID) {
 		dbs.StatusID = storers.StringPtr(statusID)
 	}
+	if validation.ValidString(pbitem.GetParentId()) {
+		dbs.ParentID = storers.StringPtr(pbitem.GetParentId())
+	}
+	if pbitem.GetRollupPolicy() != statusthingv1.RollupPolicy_ROLLUP_POLICY_UNKNOWN {
+		dbs.RollupPolicy = storers.StringPtr(pbitem.GetRollupPolicy().String())
+	}
 
 	return dbs, nil
 }
@@ -51,17 +60,24 @@ func (s *DbItem) ToProto() (*statusthingv1.Item, error) {
 	}
 
 	// id/name
-	res.Id = html.UnescapeString(s.ID)
-	res.Name = html.UnescapeString(s.Name)
+	res.Id = s.ID
+	res.Name = s.Name
+	res.Version = s.Version
 
 	//desc
 	if s.Description != nil {
-		res.Description = html.UnescapeString(*s.Description)
+		res.Description = *s.Description
 	}
 
 	if s.StatusID != nil {
 		res.Status = &statusthingv1.Status{Id: *s.StatusID}
 	}
+	if s.ParentID != nil {
+		res.ParentId = *s.ParentID
+	}
+	if s.RollupPolicy != nil {
+		res.RollupPolicy = statusthingv1.RollupPolicy(statusthingv1.RollupPolicy_value[*s.RollupPolicy])
+	}
 	// timestamps
 	pbcreated := storers.Int64ToTs(int64(s.Created))
 	pbupdated := storers.Int64ToTs(int64(s.Updated))
@@ -70,6 +70,8 @@ func TestItemFromProto(t *testing.T) {
 				pb = testutils.MakeItem(t.Name())
 				pb.Description = t.Name()
 				pb.Status = testutils.MakeStatus(t.Name())
+				pb.ParentId = t.Name() + "_parent"
+				pb.RollupPolicy = statusthingv1.RollupPolicy_ROLLUP_POLICY_MAJORITY
 				pb.Timestamps = testutils.MakeTimestamps(true)
 			}
 			s, serr := DbItemFromProto(pb)
@@ -90,6 +92,12 @@ func TestItemFromProto(t *testing.T) {
 				if pb.GetStatus() != nil {
 					require.Equal(t, pb.GetStatus().GetId(), *s.StatusID)
 				}
+				if pb.GetParentId() != "" {
+					require.Equal(t, pb.GetParentId(), *s.ParentID)
+				}
+				if pb.GetRollupPolicy() != statusthingv1.RollupPolicy_ROLLUP_POLICY_UNKNOWN {
+					require.Equal(t, pb.GetRollupPolicy().String(), *s.RollupPolicy)
+				}
 				if tc.pbitem.GetTimestamps().GetDeleted().IsValid() {
 					require.NotNil(t, s.Deleted)
 				}
@@ -152,7 +160,9 @@ func TestItemToProto(t *testing.T) {
 							Updated: storers.TsToUInt64(timestamppb.Now()),
 							Deleted: storers.TsToUInt64Ptr(timestamppb.Now())},
 					},
-					StatusID: storers.StringPtr(t.Name()),
+					StatusID:     storers.StringPtr(t.Name()),
+					ParentID:     storers.StringPtr(t.Name() + "_parent"),
+					RollupPolicy: storers.StringPtr(statusthingv1.RollupPolicy_ROLLUP_POLICY_IGNORE.String()),
 				}
 			}
 			s, serr := dbitem.ToProto()
@@ -173,6 +183,12 @@ func TestItemToProto(t *testing.T) {
 				if validation.ValidString(*dbitem.StatusID) {
 					require.Equal(t, *dbitem.StatusID, s.GetStatus().GetId())
 				}
+				if dbitem.ParentID != nil {
+					require.Equal(t, *dbitem.ParentID, s.GetParentId())
+				}
+				if dbitem.RollupPolicy != nil {
+					require.Equal(t, *dbitem.RollupPolicy, s.GetRollupPolicy().String())
+				}
 				if dbitem.Deleted != nil {
 					require.True(t, s.GetTimestamps().GetDeleted().IsValid())
 				}
@@ -1,8 +1,6 @@
 package internal
 
 import (
-	"html"
-
 	statusthingv1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
 	"github.com/lusis/statusthing/internal/serrors"
 	"github.com/lusis/statusthing/internal/storers"
@@ -14,6 +12,12 @@ type DbNote struct {
 	ID       string `db:"id"`
 	NoteText string `db:"note_text"`
 	ItemID   string `db:"item_id"`
+	// Author is nil for notes added without an authenticated caller
+	Author     *string `db:"author"`
+	Visibility string  `db:"visibility"`
+	Pinned     bool    `db:"pinned"`
+	Edited     bool    `db:"edited"`
+	Version    uint64  `db:"version"`
 	*DbTimestamps
 }
 
@@ -22,8 +26,8 @@ func DbNoteFromProto(pbnote *statusthingv1.Note) (*DbNote, error) {
 	if pbnote == nil {
 		return nil, serrors.NewError("note", serrors.ErrNilVal)
 	}
-	id := html.EscapeString(pbnote.GetId())
-	txt := html.EscapeString(pbnote.GetText())
+	id := pbnote.GetId()
+	txt := pbnote.GetText()
 	if !validation.ValidString(id) {
 		return nil, serrors.NewError("id", serrors.ErrEmptyString)
 	}
@@ -37,8 +41,21 @@ func DbNoteFromProto(pbnote *statusthingv1.Note) (*DbNote, error) {
 	res := &DbNote{
 		ID:           id,
 		NoteText:     txt,
+		Visibility:   statusthingv1.NoteVisibility_NOTE_VISIBILITY_PUBLIC.String(),
+		Pinned:       pbnote.GetPinned(),
+		Edited:       pbnote.GetEdited(),
+		Version:      1,
 		DbTimestamps: ts,
 	}
+	if validation.ValidString(pbnote.GetAuthor()) {
+		res.Author = storers.StringPtr(pbnote.GetAuthor())
+	}
+	if pbnote.GetVisibility() != statusthingv1.NoteVisibility_NOTE_VISIBILITY_UNKNOWN {
+		res.Visibility = pbnote.GetVisibility().String()
+	}
+	if pbnote.GetVersion() != 0 {
+		res.Version = pbnote.GetVersion()
+	}
 	// we set the item_id externally
 	return res, nil
 }
@@ -56,8 +73,15 @@ func (n *DbNote) ToProto() (*statusthingv1.Note, error) {
 		return nil, serrors.NewError("text", serrors.ErrInvalidData)
 	}
 	// id/name
-	res.Id = html.UnescapeString(n.ID)
-	res.Text = html.UnescapeString(n.NoteText)
+	res.Id = n.ID
+	res.Text = n.NoteText
+	res.Version = n.Version
+	if n.Author != nil {
+		res.Author = *n.Author
+	}
+	res.Visibility = statusthingv1.NoteVisibility(statusthingv1.NoteVisibility_value[n.Visibility])
+	res.Pinned = n.Pinned
+	res.Edited = n.Edited
 	// timestamps
 	pbcreated := storers.Int64ToTs(int64(n.Created))
 	pbupdated := storers.Int64ToTs(int64(n.Updated))
@@ -0,0 +1,81 @@
+package internal
+
+import (
+	"time"
+
+	statusthingv1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
+	"github.com/lusis/statusthing/internal/serrors"
+	"github.com/lusis/statusthing/internal/storers"
+	"github.com/lusis/statusthing/internal/validation"
+)
+
+// DbNoteRevision is a common representation of a [statusthingv1.NoteRevision] in a database
+type DbNoteRevision struct {
+	NoteID     string `db:"note_id"`
+	Version    uint64 `db:"version"`
+	NoteText   string `db:"note_text"`
+	Visibility string `db:"visibility"`
+	Pinned     bool   `db:"pinned"`
+	Written    uint64 `db:"written"`
+	Replaced   uint64 `db:"replaced"`
+}
+
+// DbNoteRevisionFromNote returns a [DbNoteRevision] of the provided [statusthingv1.Note] as it was before being replaced at the provided time
+func DbNoteRevisionFromNote(pbnote *statusthingv1.Note, replaced time.Time) (*DbNoteRevision, error) {
+	if pbnote == nil {
+		return nil, serrors.NewError("note", serrors.ErrNilVal)
+	}
+	if !validation.ValidString(pbnote.GetId()) {
+		return nil, serrors.NewError("id", serrors.ErrEmptyString)
+	}
+	if !validation.ValidString(pbnote.GetText()) {
+		return nil, serrors.NewError("text", serrors.ErrEmptyString)
+	}
+	if pbnote.GetVersion() == 0 {
+		return nil, serrors.NewError("version", serrors.ErrAtLeastOne)
+	}
+	written := storers.TsToInt64(pbnote.GetTimestamps().GetUpdated())
+	if written == 0 {
+		return nil, serrors.NewError("updated", serrors.ErrInvalidData)
+	}
+	res := &DbNoteRevision{
+		NoteID:     pbnote.GetId(),
+		Version:    pbnote.GetVersion(),
+		NoteText:   pbnote.GetText(),
+		Visibility: statusthingv1.NoteVisibility_NOTE_VISIBILITY_PUBLIC.String(),
+		Pinned:     pbnote.GetPinned(),
+		Written:    uint64(written),
+		Replaced:   storers.TimeToUint64(&replaced),
+	}
+	if pbnote.GetVisibility() != statusthingv1.NoteVisibility_NOTE_VISIBILITY_UNKNOWN {
+		res.Visibility = pbnote.GetVisibility().String()
+	}
+	return res, nil
+}
+
+// ToProto returns a [statusthingv1.NoteRevision] from a [DbNoteRevision]
+func (r *DbNoteRevision) ToProto() (*statusthingv1.NoteRevision, error) {
+	if !validation.ValidString(r.NoteID) {
+		return nil, serrors.NewError("note_id", serrors.ErrInvalidData)
+	}
+	if !validation.ValidString(r.NoteText) {
+		return nil, serrors.NewError("text", serrors.ErrInvalidData)
+	}
+	written := storers.Int64ToTs(int64(r.Written))
+	if written == nil {
+		return nil, serrors.NewError("written", serrors.ErrInvalidData)
+	}
+	replaced := storers.Int64ToTs(int64(r.Replaced))
+	if replaced == nil {
+		return nil, serrors.NewError("replaced", serrors.ErrInvalidData)
+	}
+	return &statusthingv1.NoteRevision{
+		NoteId:     r.NoteID,
+		Version:    r.Version,
+		Text:       r.NoteText,
+		Visibility: statusthingv1.NoteVisibility(statusthingv1.NoteVisibility_value[r.Visibility]),
+		Pinned:     r.Pinned,
+		Written:    written,
+		Replaced:   replaced,
+	}, nil
+}
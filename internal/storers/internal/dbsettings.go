@@ -0,0 +1,101 @@
+package internal
+
+import (
+	statusthingv1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
+	"github.com/lusis/statusthing/internal/serrors"
+	"github.com/lusis/statusthing/internal/storers"
+	"github.com/lusis/statusthing/internal/validation"
+)
+
+// SettingsID is the id of the only settings record
+const SettingsID = "site"
+
+// DbSettings is a common representation of the [statusthingv1.Settings] in a database
+// the logo and favicon images are stored in the same record but are not part of [DbSettings]
+type DbSettings struct {
+	ID              string  `db:"id" goqu:"skipupdate"`
+	PageTitle       *string `db:"page_title"`
+	LogoURL         *string `db:"logo_url"`
+	FaviconURL      *string `db:"favicon_url"`
+	AccentColor     *string `db:"accent_color"`
+	AccentTextColor *string `db:"accent_text_color"`
+	SupportURL      *string `db:"support_url"`
+	FooterText      *string `db:"footer_text"`
+	Timezone        *string `db:"timezone"`
+	Version         uint64  `db:"version"`
+	*DbTimestamps
+}
+
+// DbSettingsFromProto returns a [DbSettings] from a [statusthingv1.Settings]
+func DbSettingsFromProto(pbsettings *statusthingv1.Settings) (*DbSettings, error) {
+	if pbsettings == nil {
+		return nil, serrors.NewError("settings", serrors.ErrNilVal)
+	}
+	ts, err := MakeDbTimestamps(pbsettings.GetTimestamps())
+	if err != nil {
+		return nil, err
+	}
+	res := &DbSettings{
+		ID:           SettingsID,
+		Version:      1,
+		DbTimestamps: ts,
+	}
+	if pbsettings.GetVersion() != 0 {
+		res.Version = pbsettings.GetVersion()
+	}
+	for _, field := range []struct {
+		dst **string
+		val string
+	}{
+		{&res.PageTitle, pbsettings.GetPageTitle()},
+		{&res.LogoURL, pbsettings.GetLogoUrl()},
+		{&res.FaviconURL, pbsettings.GetFaviconUrl()},
+		{&res.AccentColor, pbsettings.GetAccentColor()},
+		{&res.AccentTextColor, pbsettings.GetAccentTextColor()},
+		{&res.SupportURL, pbsettings.GetSupportUrl()},
+		{&res.FooterText, pbsettings.GetFooterText()},
+		{&res.Timezone, pbsettings.GetTimezone()},
+	} {
+		if validation.ValidString(field.val) {
+			*field.dst = storers.StringPtr(field.val)
+		}
+	}
+	return res, nil
+}
+
+// ToProto returns a [statusthingv1.Settings] from a [DbSettings]
+func (s *DbSettings) ToProto() (*statusthingv1.Settings, error) {
+	if s.DbTimestamps == nil {
+		return nil, serrors.NewError("timestamps", serrors.ErrInvalidData)
+	}
+	pbcreated := storers.Int64ToTs(int64(s.Created))
+	pbupdated := storers.Int64ToTs(int64(s.Updated))
+	if pbcreated == nil {
+		return nil, serrors.NewError("created", serrors.ErrInvalidData)
+	}
+	if pbupdated == nil {
+		return nil, serrors.NewError("updated", serrors.ErrInvalidData)
+	}
+	return &statusthingv1.Settings{
+		PageTitle:       safeString(s.PageTitle),
+		LogoUrl:         safeString(s.LogoURL),
+		FaviconUrl:      safeString(s.FaviconURL),
+		AccentColor:     safeString(s.AccentColor),
+		AccentTextColor: safeString(s.AccentTextColor),
+		SupportUrl:      safeString(s.SupportURL),
+		FooterText:      safeString(s.FooterText),
+		Timezone:        safeString(s.Timezone),
+		Version:         s.Version,
+		Timestamps: &statusthingv1.Timestamps{
+			Created: pbcreated,
+			Updated: pbupdated,
+		},
+	}, nil
+}
+
+func safeString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
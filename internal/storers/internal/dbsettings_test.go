@@ -0,0 +1,45 @@
+package internal
+
+import (
+	"testing"
+
+	statusthingv1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
+	"github.com/lusis/statusthing/internal/serrors"
+	"github.com/lusis/statusthing/internal/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSettingsFromProto(t *testing.T) {
+	t.Parallel()
+	_, err := DbSettingsFromProto(nil)
+	require.ErrorIs(t, err, serrors.ErrNilVal)
+	_, err = DbSettingsFromProto(&statusthingv1.Settings{})
+	require.ErrorIs(t, err, serrors.ErrMissingTimestamp)
+
+	pb := &statusthingv1.Settings{
+		PageTitle:       "Acme",
+		AccentColor:     "#3273dc",
+		AccentTextColor: "#ffffff",
+		SupportUrl:      "https://example.com/help",
+		Timezone:        "Europe/Paris",
+		Version:         3,
+		Timestamps:      testutils.MakeTimestamps(false),
+	}
+	rec, err := DbSettingsFromProto(pb)
+	require.NoError(t, err)
+	require.Equal(t, SettingsID, rec.ID)
+	require.Nil(t, rec.FooterText, "empty fields should be null")
+	res, err := rec.ToProto()
+	require.NoError(t, err)
+	require.Equal(t, pb.GetPageTitle(), res.GetPageTitle())
+	require.Equal(t, pb.GetAccentColor(), res.GetAccentColor())
+	require.Equal(t, pb.GetAccentTextColor(), res.GetAccentTextColor())
+	require.Equal(t, pb.GetSupportUrl(), res.GetSupportUrl())
+	require.Equal(t, pb.GetTimezone(), res.GetTimezone())
+	require.Empty(t, res.GetFooterText())
+	require.Equal(t, uint64(3), res.GetVersion())
+
+	rec.DbTimestamps = nil
+	_, err = rec.ToProto()
+	require.ErrorIs(t, err, serrors.ErrInvalidData)
+}
@@ -1,8 +1,6 @@
 package internal
 
 import (
-	"html"
-
 	statusthingv1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
 	"github.com/lusis/statusthing/internal/serrors"
 	"github.com/lusis/statusthing/internal/storers"
@@ -27,8 +25,11 @@ func DbStatusFromProto(pbstatus *statusthingv1.Status) (*DbStatus, error) {
 	if err != nil {
 		return nil, err
 	}
+	if pbstatus.GetVersion() != 0 {
+		dbCommon.Version = pbstatus.GetVersion()
+	}
 
-	color := html.EscapeString(pbstatus.GetColor())
+	color := pbstatus.GetColor()
 	kind := pbstatus.GetKind()
 
 	if kind == statusthingv1.StatusKind_STATUS_KIND_UNKNOWN {
@@ -61,18 +62,19 @@ func (s *DbStatus) ToProto() (proto.Message, error) {
 	}
 
 	// id/name
-	res.Id = html.UnescapeString(s.ID)
-	res.Name = html.UnescapeString(s.Name)
+	res.Id = s.ID
+	res.Name = s.Name
+	res.Version = s.Version
 
 	// kind
 	res.Kind = statusthingv1.StatusKind(statusthingv1.StatusKind_value[*s.Kind])
 
 	//desc/color
 	if s.Description != nil {
-		res.Description = html.UnescapeString(*s.Description)
+		res.Description = *s.Description
 	}
 	if s.Color != nil {
-		res.Color = html.UnescapeString(*s.Color)
+		res.Color = *s.Color
 	}
 	// timestamps
 	pbcreated := storers.Int64ToTs(int64(s.Created))
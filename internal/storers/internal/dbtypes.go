@@ -2,8 +2,6 @@
 package internal
 
 import (
-	"html"
-
 	statusthingv1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
 	"github.com/lusis/statusthing/internal/serrors"
 	"github.com/lusis/statusthing/internal/storers"
@@ -22,6 +20,7 @@ type DbCommon struct {
 	ID          string  `db:"id" goqu:"skipupdate"`
 	Name        string  `db:"name"`
 	Description *string `db:"description"`
+	Version     uint64  `db:"version"`
 	*DbTimestamps
 }
 
@@ -58,10 +57,6 @@ func MakeDbTimestamps(timestamps *statusthingv1.Timestamps) (*DbTimestamps, erro
 
 // MakeDbCommon builds the minimum required common fields of all db records
 func MakeDbCommon(id, name, desc string, timestamps *statusthingv1.Timestamps) (*DbCommon, error) {
-	id = html.EscapeString(id)
-	name = html.EscapeString(name)
-	desc = html.EscapeString(desc)
-
 	if !validation.ValidString(id) {
 		return nil, serrors.NewError("id", serrors.ErrEmptyString)
 	}
@@ -69,8 +64,9 @@ func MakeDbCommon(id, name, desc string, timestamps *statusthingv1.Timestamps) (
 		return nil, serrors.NewError("name", serrors.ErrEmptyString)
 	}
 	dbc := &DbCommon{
-		ID:   id,
-		Name: name,
+		ID:      id,
+		Name:    name,
+		Version: 1,
 	}
 	if validation.ValidString(desc) {
 		dbc.Description = storers.StringPtr(desc)
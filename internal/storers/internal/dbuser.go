@@ -11,14 +11,16 @@ import (
 
 // DbUser represents a user stored in a database
 type DbUser struct {
-	ID           string  `db:"id" goqu:"skipupdate"`
-	Username     string  `db:"username"`
-	Password     string  `db:"password"`
-	FirstName    *string `db:"first_name"`
-	LastName     *string `db:"last_name"`
-	EmailAddress *string `db:"email_address"`
-	LastLogin    *uint64 `db:"last_login"`
-	AvatarURL    *string `db:"avatar_url"`
+	ID                     string  `db:"id" goqu:"skipupdate"`
+	Username               string  `db:"username"`
+	Password               string  `db:"password"`
+	FirstName              *string `db:"first_name"`
+	LastName               *string `db:"last_name"`
+	EmailAddress           *string `db:"email_address"`
+	LastLogin              *uint64 `db:"last_login"`
+	AvatarURL              *string `db:"avatar_url"`
+	Role                   *string `db:"role"`
+	PasswordChangeRequired bool    `db:"password_change_required"`
 	*DbTimestamps
 }
 
@@ -67,6 +69,10 @@ func DbUserFromProto(pbuser *v1.User) (*DbUser, error) {
 	if validation.ValidString(avatarURL) {
 		res.AvatarURL = &avatarURL
 	}
+	if pbuser.GetRole() != v1.Role_ROLE_UNKNOWN {
+		res.Role = storers.StringPtr(pbuser.GetRole().String())
+	}
+	res.PasswordChangeRequired = pbuser.GetPasswordChangeRequired()
 	if err := lastlogin.CheckValid(); err == nil {
 		res.LastLogin = storers.TsToUInt64Ptr(lastlogin)
 	}
@@ -121,5 +127,9 @@ func (u *DbUser) ToProto() (proto.Message, error) {
 	if u.AvatarURL != nil {
 		res.AvatarUrl = *u.AvatarURL
 	}
+	if u.Role != nil {
+		res.Role = v1.Role(v1.Role_value[*u.Role])
+	}
+	res.PasswordChangeRequired = u.PasswordChangeRequired
 	return res, nil
 }
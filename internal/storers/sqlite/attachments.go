@@ -0,0 +1,114 @@
+package sqlite
+
+import (
+	"context"
+	"errors"
+
+	"github.com/doug-martin/goqu/v9"
+
+	v1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
+	"github.com/lusis/statusthing/internal/serrors"
+	"github.com/lusis/statusthing/internal/storers/internal"
+	_ "github.com/lusis/statusthing/internal/storers/sqlite/driver" // sql driver
+	"github.com/lusis/statusthing/internal/validation"
+
+	"modernc.org/sqlite"
+)
+
+// StoreAttachment stores the provided [statusthingv1.Attachment]
+// [serrors.ErrNotFound] is returned if the note it is attached to doesn't exist
+func (s *Store) StoreAttachment(ctx context.Context, att *v1.Attachment) (*v1.Attachment, error) {
+	rec, recerr := internal.DbAttachmentFromProto(att)
+	if recerr != nil {
+		return nil, recerr
+	}
+	if err := s.storeStruct(ctx, attachmentsTableName, rec); err != nil {
+		var sqliteErr *sqlite.Error
+		if errors.As(err, &sqliteErr) && sqliteErr.Code() == 787 {
+			// foreign key constraint
+			return nil, serrors.NewWrappedError("note", serrors.ErrNotFound, sqliteErr)
+		}
+		return nil, err
+	}
+	return s.GetAttachment(ctx, rec.ID)
+}
+
+// GetAttachment gets a [statusthingv1.Attachment] by its id
+func (s *Store) GetAttachment(ctx context.Context, attachmentID string) (*v1.Attachment, error) {
+	if !validation.ValidString(attachmentID) {
+		return nil, serrors.NewError("attachmentid", serrors.ErrEmptyString)
+	}
+	rec := &internal.DbAttachment{}
+	found, ferr := s.goqudb.From(attachmentsTableName).Prepared(true).Where(goqu.C(idColumn).Eq(attachmentID)).ScanStructContext(ctx, rec)
+	if ferr != nil {
+		return nil, serrors.NewWrappedError("read", serrors.ErrStoreUnavailable, ferr)
+	}
+	if !found {
+		return nil, serrors.NewError("attachment", serrors.ErrNotFound)
+	}
+	return rec.ToProto()
+}
+
+// FindAttachments gets every [statusthingv1.Attachment] of the [statusthingv1.Note] with the provided id, oldest first
+func (s *Store) FindAttachments(ctx context.Context, noteID string) ([]*v1.Attachment, error) {
+	if !validation.ValidString(noteID) {
+		return nil, serrors.NewError("noteid", serrors.ErrEmptyString)
+	}
+	return s.findAttachments(ctx, noteID)
+}
+
+// AttachmentExists is true when an [statusthingv1.Attachment] with the provided id is stored
+func (s *Store) AttachmentExists(ctx context.Context, attachmentID string) (bool, error) {
+	count, err := s.goqudb.From(attachmentsTableName).Prepared(true).Where(goqu.C(idColumn).Eq(attachmentID)).CountContext(ctx)
+	if err != nil {
+		return false, serrors.NewWrappedError("read", serrors.ErrStoreUnavailable, err)
+	}
+	return count > 0, nil
+}
+
+// DeleteAttachment deletes a [statusthingv1.Attachment] by its id
+func (s *Store) DeleteAttachment(ctx context.Context, attachmentID string) error {
+	if _, err := s.GetAttachment(ctx, attachmentID); err != nil {
+		return err
+	}
+	return s.del(ctx, attachmentsTableName, idColumn, attachmentID)
+}
+
+// findAttachments gets every [statusthingv1.Attachment] of the notes with the provided ids, oldest first
+func (s *Store) findAttachments(ctx context.Context, noteIDs ...string) ([]*v1.Attachment, error) {
+	dbresults := []*internal.DbAttachment{}
+	if err := s.goqudb.From(attachmentsTableName).Prepared(true).Where(goqu.C(noteIDColumn).In(noteIDs)).Order(goqu.C(idColumn).Asc()).ScanStructsContext(ctx, &dbresults); err != nil {
+		return nil, serrors.NewWrappedError("driver", serrors.ErrUnrecoverable, err)
+	}
+	res := []*v1.Attachment{}
+	for _, rec := range dbresults {
+		pb, err := rec.ToProto()
+		if err != nil {
+			return nil, serrors.NewWrappedError("proto", serrors.ErrUnrecoverable, err)
+		}
+		res = append(res, pb)
+	}
+	return res, nil
+}
+
+// withAttachments sets the attachments of each of the provided notes
+func (s *Store) withAttachments(ctx context.Context, notes ...*v1.Note) error {
+	if len(notes) == 0 {
+		return nil
+	}
+	ids := make([]string, 0, len(notes))
+	byID := map[string]*v1.Note{}
+	for _, note := range notes {
+		ids = append(ids, note.GetId())
+		byID[note.GetId()] = note
+	}
+	atts, err := s.findAttachments(ctx, ids...)
+	if err != nil {
+		return err
+	}
+	for _, att := range atts {
+		note := byID[att.GetNoteId()]
+		note.Attachments = append(note.Attachments, att)
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package sqlite
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	v1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
+	"github.com/lusis/statusthing/internal/serrors"
+	"github.com/lusis/statusthing/internal/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttachments(t *testing.T) {
+	ctx := context.TODO()
+	db, dberr := makeTestdb(t, ":memory:")
+	require.NoError(t, dberr)
+	store, _ := New(db)
+	item, err := store.StoreItem(ctx, testutils.MakeItem(t.Name()))
+	require.NoError(t, err)
+	note, err := store.StoreNote(ctx, testutils.MakeNote(t.Name()), item.GetId())
+	require.NoError(t, err)
+	require.Empty(t, note.GetAttachments())
+
+	makeAttachment := func(id, noteID string) *v1.Attachment {
+		return &v1.Attachment{Id: id, NoteId: noteID, Filename: id + ".png", ContentType: "image/png", Size: 3, Sha256: "abc", Timestamps: testutils.MakeTimestamps(false)}
+	}
+	_, err = store.StoreAttachment(ctx, makeAttachment("orphan", "missing"))
+	require.ErrorIs(t, err, serrors.ErrNotFound, "attachments need a note")
+
+	first, err := store.StoreAttachment(ctx, makeAttachment("first", note.GetId()))
+	require.NoError(t, err)
+	require.Equal(t, "first.png", first.GetFilename())
+	_, err = store.StoreAttachment(ctx, makeAttachment("second", note.GetId()))
+	require.NoError(t, err)
+
+	res, err := store.FindAttachments(ctx, note.GetId())
+	require.NoError(t, err)
+	require.Len(t, res, 2)
+	got, err := store.GetNote(ctx, note.GetId())
+	require.NoError(t, err)
+	require.Len(t, got.GetAttachments(), 2, "notes should carry their attachments")
+	gotItem, err := store.GetItem(ctx, item.GetId())
+	require.NoError(t, err)
+	require.Len(t, gotItem.GetNotes()[0].GetAttachments(), 2)
+
+	exists, err := store.AttachmentExists(ctx, "first")
+	require.NoError(t, err)
+	require.True(t, exists)
+	require.NoError(t, store.DeleteAttachment(ctx, "first"))
+	require.ErrorIs(t, store.DeleteAttachment(ctx, "first"), serrors.ErrNotFound)
+	exists, err = store.AttachmentExists(ctx, "first")
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	require.NoError(t, store.DeleteNote(ctx, note.GetId()))
+	_, err = store.GetAttachment(ctx, "second")
+	require.ErrorIs(t, err, serrors.ErrNotFound, "attachments should be removed with their note")
+}
+
+func TestBlobs(t *testing.T) {
+	ctx := context.TODO()
+	db, dberr := makeTestdb(t, ":memory:")
+	require.NoError(t, dberr)
+	store, _ := New(db)
+
+	require.ErrorIs(t, store.PutBlob(ctx, "", strings.NewReader("x")), serrors.ErrEmptyString)
+	require.NoError(t, store.PutBlob(ctx, "first", strings.NewReader("hello")))
+	require.NoError(t, store.PutBlob(ctx, "second", strings.NewReader("world")))
+	rc, err := store.GetBlob(ctx, "first")
+	require.NoError(t, err)
+	b, err := io.ReadAll(rc)
+	require.NoError(t, err)
+	require.NoError(t, rc.Close())
+	require.Equal(t, "hello", string(b))
+
+	keys, err := store.ListBlobs(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []string{"first", "second"}, keys)
+
+	require.NoError(t, store.DeleteBlob(ctx, "first"))
+	require.ErrorIs(t, store.DeleteBlob(ctx, "first"), serrors.ErrNotFound)
+	_, err = store.GetBlob(ctx, "first")
+	require.ErrorIs(t, err, serrors.ErrNotFound)
+}
@@ -0,0 +1,139 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/lusis/statusthing/internal/serrors"
+	"github.com/lusis/statusthing/internal/validation"
+	"github.com/lusis/statusthing/migrations"
+)
+
+const (
+	// preRestoreSuffix and the time of the restore are added to the name of a database replaced by [Restore]
+	preRestoreSuffix = ".pre-restore-"
+	// preRestoreTimeFormat keeps every replaced database so a second restore can't overwrite the first
+	preRestoreTimeFormat = "20060102T150405.000000000Z"
+)
+
+// dbFileSuffixes are the files that make up a sqlite database
+// a wal or journal left behind would be applied to whatever database is at the path so they move with it
+var dbFileSuffixes = []string{"", "-wal", "-shm", "-journal"}
+
+// Backup writes a consistent copy of the database to a new file at the provided path using VACUUM INTO
+// it is safe to call while the store is in use
+func (s *Store) Backup(ctx context.Context, path string) error {
+	if !validation.ValidString(path) {
+		return serrors.NewError("path", serrors.ErrEmptyString)
+	}
+	if s.conn == nil {
+		// VACUUM can't run inside a transaction
+		return serrors.NewError("backup in transaction", serrors.ErrNotImplemented)
+	}
+	if _, err := os.Stat(path); err == nil {
+		return serrors.NewError(path, serrors.ErrAlreadySet)
+	}
+	if _, err := s.conn.ExecContext(ctx, "VACUUM INTO ?", path); err != nil {
+		return serrors.NewWrappedError("backup", serrors.ErrStoreUnavailable, err)
+	}
+	return nil
+}
+
+// Restore replaces the database at dbPath with the backup at backupPath
+// it returns the schema version of the backup and the path the replaced database was kept at, which is empty if there wasn't one
+// the backup is checked for corruption and for a schema version this build can migrate before anything is changed
+// the replaced database is kept next to dbPath with a .pre-restore-<time> suffix so earlier ones are never overwritten
+// nothing may have dbPath open while restoring
+func Restore(ctx context.Context, backupPath, dbPath string) (uint, string, error) {
+	if !validation.ValidString(backupPath) {
+		return 0, "", serrors.NewError("backup path", serrors.ErrEmptyString)
+	}
+	if !validation.ValidString(dbPath) {
+		return 0, "", serrors.NewError("db path", serrors.ErrEmptyString)
+	}
+	if _, err := os.Stat(backupPath); err != nil {
+		return 0, "", serrors.NewWrappedError(backupPath, serrors.ErrNotFound, err)
+	}
+	version, err := checkBackup(ctx, backupPath)
+	if err != nil {
+		return 0, "", err
+	}
+	keptPath := dbPath + preRestoreSuffix + time.Now().UTC().Format(preRestoreTimeFormat)
+	for _, suffix := range dbFileSuffixes {
+		if _, err := os.Stat(keptPath + suffix); err == nil {
+			return 0, "", serrors.NewError(keptPath+suffix, serrors.ErrAlreadySet)
+		}
+	}
+
+	// copy next to the destination first so the swap is a rename
+	tmpPath := dbPath + ".restore"
+	if err := os.Remove(tmpPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return 0, "", serrors.NewWrappedError("remove "+tmpPath, serrors.ErrUnrecoverable, err)
+	}
+	if err := copyFile(backupPath, tmpPath); err != nil {
+		return 0, "", err
+	}
+	kept := false
+	for _, suffix := range dbFileSuffixes {
+		if _, err := os.Stat(dbPath + suffix); err != nil {
+			continue
+		}
+		// the wal and journal keep their suffix after the new name so sqlite still finds them with the kept database
+		if err := os.Rename(dbPath+suffix, keptPath+suffix); err != nil {
+			return 0, "", serrors.NewWrappedError("move existing db", serrors.ErrUnrecoverable, err)
+		}
+		kept = kept || suffix == ""
+	}
+	if err := os.Rename(tmpPath, dbPath); err != nil {
+		return 0, "", serrors.NewWrappedError("swap db", serrors.ErrUnrecoverable, err)
+	}
+	if !kept {
+		keptPath = ""
+	}
+	return version, keptPath, nil
+}
+
+// checkBackup returns the schema version of the backup after checking its integrity
+func checkBackup(ctx context.Context, backupPath string) (uint, error) {
+	db, err := sql.Open("sqlite3", backupPath)
+	if err != nil {
+		return 0, serrors.NewWrappedError("driver", serrors.ErrStoreUnavailable, err)
+	}
+	defer db.Close()
+	var result string
+	if err := db.QueryRowContext(ctx, "PRAGMA integrity_check").Scan(&result); err != nil {
+		return 0, serrors.NewWrappedError("integrity check", serrors.ErrInvalidData, err)
+	}
+	if result != "ok" {
+		return 0, serrors.NewError(fmt.Sprintf("integrity check: %s", result), serrors.ErrInvalidData)
+	}
+	return migrations.CheckVersion(ctx, db)
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return serrors.NewWrappedError("read backup", serrors.ErrNotFound, err)
+	}
+	defer in.Close()
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return serrors.NewWrappedError("write "+dst, serrors.ErrUnrecoverable, err)
+	}
+	_, err = io.Copy(out, in)
+	if err == nil {
+		err = out.Sync()
+	}
+	if cerr := out.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return serrors.NewWrappedError("write "+dst, serrors.ErrUnrecoverable, errors.Join(err, os.Remove(dst)))
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/lusis/statusthing/internal/serrors"
+	"github.com/lusis/statusthing/internal/storers"
+	"github.com/lusis/statusthing/internal/testutils"
+	"github.com/lusis/statusthing/migrations"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackupRestore(t *testing.T) {
+	ctx := context.TODO()
+	dir := t.TempDir()
+	db, dberr := makeTestdb(t, filepath.Join(dir, "live.db"))
+	require.NoError(t, dberr)
+	defer db.Close()
+	store, _ := New(db)
+	item, err := store.StoreItem(ctx, testutils.MakeItem(t.Name()))
+	require.NoError(t, err)
+
+	backupPath := filepath.Join(dir, "backup.db")
+	require.NoError(t, store.Backup(ctx, backupPath))
+	require.ErrorIs(t, store.Backup(ctx, backupPath), serrors.ErrAlreadySet, "backups should never overwrite files")
+	require.ErrorIs(t, store.Backup(ctx, ""), serrors.ErrEmptyString)
+	require.ErrorIs(t, store.WithTx(ctx, func(tx storers.StatusThingStorer) error {
+		return tx.(*Store).Backup(ctx, filepath.Join(dir, "tx.db"))
+	}), serrors.ErrNotImplemented)
+
+	latest, err := migrations.LatestVersion("sqlite3")
+	require.NoError(t, err)
+
+	t.Run("happy-path", func(t *testing.T) {
+		target := filepath.Join(t.TempDir(), "restored.db")
+		require.NoError(t, os.WriteFile(target, []byte("old"), 0o600))
+
+		version, keptPath, err := Restore(ctx, backupPath, target)
+		require.NoError(t, err)
+		require.Equal(t, latest, version)
+		require.Contains(t, keptPath, target+preRestoreSuffix)
+		old, err := os.ReadFile(keptPath)
+		require.NoError(t, err)
+		require.Equal(t, "old", string(old), "the replaced db should be kept")
+
+		_, secondKept, err := Restore(ctx, backupPath, target)
+		require.NoError(t, err)
+		require.NotEqual(t, keptPath, secondKept)
+		old, err = os.ReadFile(keptPath)
+		require.NoError(t, err)
+		require.Equal(t, "old", string(old), "a second restore should not overwrite the first kept db")
+		require.FileExists(t, secondKept)
+
+		restored, err := sql.Open("sqlite3", target)
+		require.NoError(t, err)
+		defer restored.Close()
+		rstore, _ := New(restored)
+		res, err := rstore.GetItem(ctx, item.GetId())
+		require.NoError(t, err)
+		require.Equal(t, item.GetName(), res.GetName())
+	})
+	t.Run("no-existing-db", func(t *testing.T) {
+		_, keptPath, err := Restore(ctx, backupPath, filepath.Join(t.TempDir(), "new.db"))
+		require.NoError(t, err)
+		require.Empty(t, keptPath, "there is nothing to keep")
+	})
+	t.Run("missing-backup", func(t *testing.T) {
+		_, _, err := Restore(ctx, filepath.Join(t.TempDir(), "missing.db"), filepath.Join(t.TempDir(), "target.db"))
+		require.ErrorIs(t, err, serrors.ErrNotFound)
+	})
+	t.Run("not-a-db", func(t *testing.T) {
+		bad := filepath.Join(t.TempDir(), "bad.db")
+		require.NoError(t, os.WriteFile(bad, []byte("this is not a database at all, not even close"), 0o600))
+		_, _, err := Restore(ctx, bad, filepath.Join(t.TempDir(), "target.db"))
+		require.ErrorIs(t, err, serrors.ErrInvalidData)
+	})
+	t.Run("newer-schema", func(t *testing.T) {
+		newer := filepath.Join(t.TempDir(), "newer.db")
+		require.NoError(t, store.Backup(ctx, newer))
+		ndb, err := sql.Open("sqlite3", newer)
+		require.NoError(t, err)
+		_, err = ndb.Exec("UPDATE schema_migrations SET version = ?", latest+1)
+		require.NoError(t, err)
+		require.NoError(t, ndb.Close())
+
+		target := filepath.Join(t.TempDir(), "target.db")
+		_, _, err = Restore(ctx, newer, target)
+		require.ErrorIs(t, err, serrors.ErrConflict)
+		_, err = os.Stat(target)
+		require.ErrorIs(t, err, os.ErrNotExist, "nothing should change when the backup is rejected")
+	})
+	t.Run("dirty-schema", func(t *testing.T) {
+		dirty := filepath.Join(t.TempDir(), "dirty.db")
+		require.NoError(t, store.Backup(ctx, dirty))
+		ddb, err := sql.Open("sqlite3", dirty)
+		require.NoError(t, err)
+		_, err = ddb.Exec("UPDATE schema_migrations SET dirty = true")
+		require.NoError(t, err)
+		require.NoError(t, ddb.Close())
+
+		_, _, err = Restore(ctx, dirty, filepath.Join(t.TempDir(), "target.db"))
+		require.ErrorIs(t, err, serrors.ErrInvalidData)
+	})
+}
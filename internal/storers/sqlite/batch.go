@@ -0,0 +1,63 @@
+package sqlite
+
+import (
+	"context"
+
+	v1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
+	"github.com/lusis/statusthing/internal/filters"
+	"github.com/lusis/statusthing/internal/serrors"
+	_ "github.com/lusis/statusthing/internal/storers/sqlite/driver" // sql driver
+)
+
+// BatchUpdateItems updates every [statusthingv1.Item] with the provided ids with the provided [filters.FilterOption]
+// and stores the [statusthingv1.Note] for each item id in notes, if any
+// all changes are made in a single transaction. if any item fails, no changes are made and a [serrors.BatchError] is returned
+// Supports the same filters as [Store.UpdateItem]
+func (s *Store) BatchUpdateItems(ctx context.Context, itemIDs []string, notes map[string]*v1.Note, opts ...filters.FilterOption) error {
+	if len(itemIDs) == 0 {
+		return serrors.NewError("itemids", serrors.ErrAtLeastOne)
+	}
+	return s.withTx(ctx, func(tx *Store) error {
+		errs := map[string]error{}
+		for _, itemID := range itemIDs {
+			if len(opts) != 0 {
+				if err := tx.UpdateItem(ctx, itemID, opts...); err != nil {
+					errs[itemID] = err
+					continue
+				}
+			} else if _, err := tx.GetItem(ctx, itemID); err != nil {
+				errs[itemID] = err
+				continue
+			}
+			if note, ok := notes[itemID]; ok && note != nil {
+				if _, err := tx.StoreNote(ctx, note, itemID); err != nil {
+					errs[itemID] = err
+				}
+			}
+		}
+		if len(errs) != 0 {
+			return serrors.NewBatchError(errs)
+		}
+		return nil
+	})
+}
+
+// BatchDeleteItems deletes every [statusthingv1.Item] with the provided ids
+// all items are deleted in a single transaction. if any item fails, no items are deleted and a [serrors.BatchError] is returned
+func (s *Store) BatchDeleteItems(ctx context.Context, itemIDs []string) error {
+	if len(itemIDs) == 0 {
+		return serrors.NewError("itemids", serrors.ErrAtLeastOne)
+	}
+	return s.withTx(ctx, func(tx *Store) error {
+		errs := map[string]error{}
+		for _, itemID := range itemIDs {
+			if err := tx.DeleteItem(ctx, itemID); err != nil {
+				errs[itemID] = err
+			}
+		}
+		if len(errs) != 0 {
+			return serrors.NewBatchError(errs)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,93 @@
+package sqlite
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	v1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
+	"github.com/lusis/statusthing/internal/filters"
+	"github.com/lusis/statusthing/internal/serrors"
+	_ "github.com/lusis/statusthing/internal/storers/sqlite/driver" // sql driver
+	"github.com/lusis/statusthing/internal/testutils"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatchUpdateItems(t *testing.T) {
+	ctx := context.TODO()
+	db, dberr := makeTestdb(t, ":memory:")
+	require.NoError(t, dberr)
+	store, _ := New(db)
+
+	first, ferr := store.StoreItem(ctx, testutils.MakeItem(t.Name()+"_first"))
+	require.NoError(t, ferr)
+	second, serr := store.StoreItem(ctx, testutils.MakeItem(t.Name()+"_second"))
+	require.NoError(t, serr)
+	status, sterr := store.StoreStatus(ctx, testutils.MakeStatus(t.Name()))
+	require.NoError(t, sterr)
+
+	makeNotes := func(ids ...string) map[string]*v1.Note {
+		notes := map[string]*v1.Note{}
+		for _, id := range ids {
+			note := testutils.MakeNote(t.Name())
+			note.Id = id + "_note"
+			note.Text = "shared note text"
+			notes[id] = note
+		}
+		return notes
+	}
+
+	t.Run("empty", func(t *testing.T) {
+		require.ErrorIs(t, store.BatchUpdateItems(ctx, nil, nil), serrors.ErrAtLeastOne)
+	})
+	t.Run("all-or-nothing", func(t *testing.T) {
+		ids := []string{first.GetId(), "missing", second.GetId()}
+		err := store.BatchUpdateItems(ctx, ids, makeNotes(ids...), filters.WithStatusID(status.GetId()))
+		require.ErrorIs(t, err, serrors.ErrBatchFailed)
+		require.ErrorIs(t, err, serrors.ErrNotFound)
+		var batchErr *serrors.BatchError
+		require.True(t, errors.As(err, &batchErr))
+		require.Equal(t, []string{"missing"}, batchErr.IDs())
+
+		res, err := store.GetItem(ctx, first.GetId())
+		require.NoError(t, err)
+		require.Nil(t, res.GetStatus(), "status should not have been changed")
+		require.Len(t, res.GetNotes(), 0, "note should not have been added")
+	})
+	t.Run("happy-path", func(t *testing.T) {
+		ids := []string{first.GetId(), second.GetId()}
+		require.NoError(t, store.BatchUpdateItems(ctx, ids, makeNotes(ids...), filters.WithStatusID(status.GetId())))
+		for _, id := range ids {
+			res, err := store.GetItem(ctx, id)
+			require.NoError(t, err)
+			require.Equal(t, status.GetId(), res.GetStatus().GetId())
+			require.Len(t, res.GetNotes(), 1)
+			require.Equal(t, "shared note text", res.GetNotes()[0].GetText())
+		}
+	})
+}
+
+func TestBatchDeleteItems(t *testing.T) {
+	ctx := context.TODO()
+	db, dberr := makeTestdb(t, ":memory:")
+	require.NoError(t, dberr)
+	store, _ := New(db)
+
+	first, ferr := store.StoreItem(ctx, testutils.MakeItem(t.Name()+"_first"))
+	require.NoError(t, ferr)
+	second, serr := store.StoreItem(ctx, testutils.MakeItem(t.Name()+"_second"))
+	require.NoError(t, serr)
+	_, nerr := store.StoreNote(ctx, testutils.MakeNote(t.Name()), first.GetId())
+	require.NoError(t, nerr)
+
+	err := store.BatchDeleteItems(ctx, []string{first.GetId(), "missing"})
+	require.ErrorIs(t, err, serrors.ErrBatchFailed)
+	_, gerr := store.GetItem(ctx, first.GetId())
+	require.NoError(t, gerr, "item should not have been deleted")
+
+	require.NoError(t, store.BatchDeleteItems(ctx, []string{first.GetId(), second.GetId()}))
+	all, aerr := store.FindItems(ctx)
+	require.NoError(t, aerr)
+	require.Len(t, all, 0)
+}
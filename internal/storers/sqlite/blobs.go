@@ -0,0 +1,65 @@
+package sqlite
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/doug-martin/goqu/v9"
+
+	"github.com/lusis/statusthing/internal/serrors"
+	_ "github.com/lusis/statusthing/internal/storers/sqlite/driver" // sql driver
+	"github.com/lusis/statusthing/internal/validation"
+)
+
+// PutBlob stores everything read from the provided reader under the provided key
+// the blob is read into memory so callers should limit how much can be read
+func (s *Store) PutBlob(ctx context.Context, key string, r io.Reader) error {
+	if !validation.ValidString(key) {
+		return serrors.NewError("key", serrors.ErrEmptyString)
+	}
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return serrors.NewWrappedError("blob", serrors.ErrInvalidData, err)
+	}
+	return s.storeStruct(ctx, blobsTableName, goqu.Record{blobKeyColumn: key, dataColumn: data})
+}
+
+// GetBlob returns a reader for the blob with the provided key
+func (s *Store) GetBlob(ctx context.Context, key string) (io.ReadCloser, error) {
+	var data []byte
+	found, err := s.goqudb.From(blobsTableName).Prepared(true).Select(dataColumn).Where(goqu.C(blobKeyColumn).Eq(key)).ScanValContext(ctx, &data)
+	if err != nil {
+		return nil, serrors.NewWrappedError("read", serrors.ErrStoreUnavailable, err)
+	}
+	if !found {
+		return nil, serrors.NewError("blob", serrors.ErrNotFound)
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+// ListBlobs returns the key of every stored blob
+func (s *Store) ListBlobs(ctx context.Context) ([]string, error) {
+	keys := []string{}
+	if err := s.goqudb.From(blobsTableName).Prepared(true).Select(blobKeyColumn).Order(goqu.C(blobKeyColumn).Asc()).ScanValsContext(ctx, &keys); err != nil {
+		return nil, serrors.NewWrappedError("read", serrors.ErrStoreUnavailable, err)
+	}
+	return keys, nil
+}
+
+// DeleteBlob deletes the blob with the provided key
+func (s *Store) DeleteBlob(ctx context.Context, key string) error {
+	res, err := s.db.ExecContext(ctx, fmt.Sprintf("DELETE FROM %s WHERE %s = ?", blobsTableName, blobKeyColumn), key)
+	if err != nil {
+		return serrors.NewWrappedError("write", serrors.ErrUnrecoverable, err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return serrors.NewWrappedError("affected-rows", serrors.ErrUnrecoverable, err)
+	}
+	if affected == 0 {
+		return serrors.NewError("blob", serrors.ErrNotFound)
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/doug-martin/goqu/v9"
+
+	v1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
+	"github.com/lusis/statusthing/internal/filters"
+	"github.com/lusis/statusthing/internal/serrors"
+	"github.com/lusis/statusthing/internal/storers/internal"
+	_ "github.com/lusis/statusthing/internal/storers/sqlite/driver" // sql driver
+	"github.com/lusis/statusthing/internal/validation"
+)
+
+// StoreDependency stores the provided [statusthingv1.ItemDependency]
+func (s *Store) StoreDependency(ctx context.Context, dep *v1.ItemDependency) (*v1.ItemDependency, error) {
+	rec, recerr := internal.DbItemDependencyFromProto(dep)
+	if recerr != nil {
+		return nil, recerr
+	}
+	if err := s.storeStruct(ctx, depsTableName, rec); err != nil {
+		return nil, err
+	}
+	res, err := s.FindDependencies(ctx, filters.WithItemID(rec.ItemID))
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range res {
+		if d.GetDependsOnId() == dep.GetDependsOnId() {
+			return d, nil
+		}
+	}
+	return nil, serrors.NewError("dependency", serrors.ErrNotFound)
+}
+
+// FindDependencies returns all known [statusthingv1.ItemDependency] optionally filtered by the provided [filters.FilterOption]
+// Supported filters:
+// - [filters.WithItemID] only returns the dependencies of the provided item id
+func (s *Store) FindDependencies(ctx context.Context, opts ...filters.FilterOption) ([]*v1.ItemDependency, error) {
+	f, ferr := filters.New(opts...)
+	if ferr != nil {
+		return nil, ferr
+	}
+	dbresults := []*internal.DbItemDependency{}
+	pbresults := []*v1.ItemDependency{}
+	ds := s.goqudb.From(depsTableName).Prepared(true)
+	if validation.ValidString(f.ItemID()) {
+		ds = ds.Where(goqu.C(itemIDColumn).Eq(f.ItemID()))
+	}
+	dserr := ds.Order(goqu.C(itemIDColumn).Asc(), goqu.C(dependsOnColumn).Asc()).ScanStructsContext(ctx, &dbresults)
+	if dserr != nil {
+		return nil, serrors.NewWrappedError("driver", serrors.ErrUnrecoverable, dserr)
+	}
+	for _, rec := range dbresults {
+		pb, pberr := rec.ToProto()
+		if pberr != nil {
+			return nil, serrors.NewWrappedError("proto", serrors.ErrUnrecoverable, pberr)
+		}
+		pbresults = append(pbresults, pb)
+	}
+	return pbresults, nil
+}
+
+// DeleteDependency deletes the [statusthingv1.ItemDependency] between the provided item ids
+func (s *Store) DeleteDependency(ctx context.Context, itemID, dependsOnID string) error {
+	if !validation.ValidString(itemID) {
+		return serrors.NewError("itemid", serrors.ErrEmptyString)
+	}
+	if !validation.ValidString(dependsOnID) {
+		return serrors.NewError("dependsonid", serrors.ErrEmptyString)
+	}
+	res, reserr := s.db.ExecContext(ctx, fmt.Sprintf("DELETE FROM %s WHERE %s = ? AND %s = ?", depsTableName, itemIDColumn, dependsOnColumn), itemID, dependsOnID)
+	if reserr != nil {
+		return serrors.NewWrappedError("write", serrors.ErrUnrecoverable, reserr)
+	}
+	affected, aferr := res.RowsAffected()
+	if aferr != nil {
+		return serrors.NewWrappedError("affected-rows", serrors.ErrUnrecoverable, aferr)
+	}
+	if affected == 0 {
+		return serrors.NewError("dependency", serrors.ErrNotFound)
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package sqlite
+
+import (
+	"context"
+	"testing"
+
+	statusthingv1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
+	"github.com/lusis/statusthing/internal/filters"
+	"github.com/lusis/statusthing/internal/serrors"
+	_ "github.com/lusis/statusthing/internal/storers/sqlite/driver" // sql driver
+	"github.com/lusis/statusthing/internal/testutils"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDependencyLifecycle(t *testing.T) {
+	ctx := context.TODO()
+	db, dberr := makeTestdb(t, ":memory:")
+	require.NoError(t, dberr)
+	require.NotNil(t, db)
+	store, _ := New(db)
+
+	app, aerr := store.StoreItem(ctx, testutils.MakeItem(t.Name()+"_app"))
+	require.NoError(t, aerr)
+	database, derr := store.StoreItem(ctx, testutils.MakeItem(t.Name()+"_db"))
+	require.NoError(t, derr)
+
+	// Store
+	res, reserr := store.StoreDependency(ctx, &statusthingv1.ItemDependency{
+		ItemId:      app.GetId(),
+		DependsOnId: database.GetId(),
+		Timestamps:  testutils.MakeTimestamps(false),
+	})
+	require.NoError(t, reserr)
+	require.NotNil(t, res)
+	require.Equal(t, app.GetId(), res.GetItemId())
+	require.Equal(t, database.GetId(), res.GetDependsOnId())
+	require.NotNil(t, res.GetTimestamps().GetCreated())
+
+	// missing items are rejected by the foreign key
+	_, missingerr := store.StoreDependency(ctx, &statusthingv1.ItemDependency{
+		ItemId:      app.GetId(),
+		DependsOnId: "missing",
+		Timestamps:  testutils.MakeTimestamps(false),
+	})
+	require.Error(t, missingerr)
+
+	// Find
+	all, allerr := store.FindDependencies(ctx)
+	require.NoError(t, allerr)
+	require.Len(t, all, 1)
+	none, noneerr := store.FindDependencies(ctx, filters.WithItemID(database.GetId()))
+	require.NoError(t, noneerr)
+	require.Len(t, none, 0)
+
+	// dependencies are populated on the item
+	gres, gerr := store.GetItem(ctx, app.GetId())
+	require.NoError(t, gerr)
+	require.Equal(t, []string{database.GetId()}, gres.GetDependsOn())
+
+	// Delete
+	require.NoError(t, store.DeleteDependency(ctx, app.GetId(), database.GetId()))
+	require.ErrorIs(t, store.DeleteDependency(ctx, app.GetId(), database.GetId()), serrors.ErrNotFound)
+
+	// deleting an item removes its edges
+	_, reserr = store.StoreDependency(ctx, &statusthingv1.ItemDependency{
+		ItemId:      app.GetId(),
+		DependsOnId: database.GetId(),
+		Timestamps:  testutils.MakeTimestamps(false),
+	})
+	require.NoError(t, reserr)
+	require.NoError(t, store.DeleteItem(ctx, database.GetId()))
+	after, aftererr := store.FindDependencies(ctx)
+	require.NoError(t, aftererr)
+	require.Len(t, after, 0)
+}
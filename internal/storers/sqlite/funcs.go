@@ -2,11 +2,14 @@ package sqlite
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/doug-martin/goqu/v9"
 
 	"github.com/lusis/statusthing/internal/serrors"
+	"github.com/lusis/statusthing/internal/storers"
 	"github.com/lusis/statusthing/internal/storers/internal"
 	_ "github.com/lusis/statusthing/internal/storers/sqlite/driver" // sql driver
 
@@ -78,3 +81,66 @@ func (s *Store) update(ctx context.Context, tableName string, idCol string, idVa
 	}
 	return nil
 }
+
+// updateVersioned updates the record in tableName with the provided id, bumping its version and updated timestamp
+// if expectedVersion is not zero the record is only updated when its current version matches and [serrors.ErrConflict] is returned otherwise
+// callers are expected to have checked that the record exists
+func (s *Store) updateVersioned(ctx context.Context, tableName string, recordName string, idVal string, expectedVersion uint64, cols map[string]any) error {
+	now := time.Now()
+	record := goqu.Record{
+		versionColumn: goqu.L("? + 1", goqu.C(versionColumn)),
+		updatedColumn: storers.TimeToUint64(&now),
+	}
+	for k, v := range cols {
+		record[k] = v
+	}
+	ds := s.goqudb.Update(tableName).Prepared(true).Where(goqu.C(idColumn).Eq(idVal)).Set(record)
+	if expectedVersion != 0 {
+		ds = ds.Where(goqu.C(versionColumn).Eq(expectedVersion))
+	}
+	query, params, qerr := ds.ToSQL()
+	if qerr != nil {
+		return serrors.NewWrappedError("sqlbuilder", serrors.ErrUnrecoverable, qerr)
+	}
+
+	res, reserr := s.db.ExecContext(ctx, query, params...)
+	if reserr != nil {
+		var sqliteErr *sqlite.Error
+		if errors.As(reserr, &sqliteErr) {
+			// foreign key constraint
+			if sqliteErr.Code() == 787 {
+				return serrors.NewWrappedError(recordName, serrors.ErrNotFound, sqliteErr)
+			}
+		}
+		return serrors.NewWrappedError("write", serrors.ErrUnrecoverable, reserr)
+	}
+	affected, aferr := res.RowsAffected()
+	if aferr != nil {
+		return serrors.NewWrappedError("affected-rows", serrors.ErrUnrecoverable, aferr)
+	}
+	if affected == 0 {
+		// we checked for existence earlier so the only way to miss is a version mismatch
+		return serrors.NewError("version", serrors.ErrConflict)
+	}
+	return nil
+}
+
+// clearColumns sets the columns for each of the provided cleared fields to null
+// field names match column names and only the provided clearable columns can be cleared
+// [serrors.ErrEmptyString] is returned for any other field since it can't be empty
+func clearColumns(columns map[string]any, cleared []string, clearable ...string) error {
+	allowed := map[string]bool{}
+	for _, col := range clearable {
+		allowed[col] = true
+	}
+	for _, field := range cleared {
+		if !allowed[field] {
+			return serrors.NewError(field, serrors.ErrEmptyString)
+		}
+		if _, ok := columns[field]; ok {
+			return serrors.NewError(field, serrors.ErrAlreadySet)
+		}
+		columns[field] = nil
+	}
+	return nil
+}
@@ -0,0 +1,135 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/lusis/statusthing/internal/filters"
+	"github.com/lusis/statusthing/internal/serrors"
+	"github.com/lusis/statusthing/internal/testutils"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersionedUpdates(t *testing.T) {
+	ctx := context.TODO()
+	db, dberr := makeTestdb(t, ":memory:")
+	require.NoError(t, dberr)
+	store, _ := New(db)
+
+	item, err := store.StoreItem(ctx, testutils.MakeItem(t.Name()))
+	require.NoError(t, err)
+	status, err := store.StoreStatus(ctx, testutils.MakeStatus(t.Name()))
+	require.NoError(t, err)
+	note, err := store.StoreNote(ctx, testutils.MakeNote(t.Name()), item.GetId())
+	require.NoError(t, err)
+
+	edits := 0
+	type testCase struct {
+		update func(opts ...filters.FilterOption) error
+		get    func() (uint64, uint64, error)
+	}
+	testCases := map[string]testCase{
+		"item": {
+			update: func(opts ...filters.FilterOption) error {
+				return store.UpdateItem(ctx, item.GetId(), append(opts, filters.WithName("new-name"))...)
+			},
+			get: func() (uint64, uint64, error) {
+				res, err := store.GetItem(ctx, item.GetId())
+				return res.GetVersion(), uint64(res.GetTimestamps().GetUpdated().AsTime().UnixNano()), err
+			},
+		},
+		"status": {
+			update: func(opts ...filters.FilterOption) error {
+				return store.UpdateStatus(ctx, status.GetId(), append(opts, filters.WithName("new-name"))...)
+			},
+			get: func() (uint64, uint64, error) {
+				res, err := store.GetStatus(ctx, status.GetId())
+				return res.GetVersion(), uint64(res.GetTimestamps().GetUpdated().AsTime().UnixNano()), err
+			},
+		},
+		"note": {
+			update: func(opts ...filters.FilterOption) error {
+				// notes only change when their contents do
+				edits++
+				return store.UpdateNote(ctx, note.GetId(), append(opts, filters.WithNoteText(fmt.Sprintf("new-text-%d", edits)))...)
+			},
+			get: func() (uint64, uint64, error) {
+				res, err := store.GetNote(ctx, note.GetId())
+				return res.GetVersion(), uint64(res.GetTimestamps().GetUpdated().AsTime().UnixNano()), err
+			},
+		},
+	}
+	for n, tc := range testCases {
+		t.Run(n, func(t *testing.T) {
+			version, updated, err := tc.get()
+			require.NoError(t, err)
+			require.Equal(t, uint64(1), version)
+
+			// unconditional updates always bump the version
+			require.NoError(t, tc.update())
+			version, newUpdated, err := tc.get()
+			require.NoError(t, err)
+			require.Equal(t, uint64(2), version)
+			require.Greater(t, newUpdated, updated)
+
+			// a stale version is rejected and nothing changes
+			require.ErrorIs(t, tc.update(filters.WithExpectedVersion(1)), serrors.ErrConflict)
+			version, _, err = tc.get()
+			require.NoError(t, err)
+			require.Equal(t, uint64(2), version)
+
+			// the current version is accepted
+			require.NoError(t, tc.update(filters.WithExpectedVersion(2)))
+			version, _, err = tc.get()
+			require.NoError(t, err)
+			require.Equal(t, uint64(3), version)
+		})
+	}
+}
+
+func TestClearedFields(t *testing.T) {
+	ctx := context.TODO()
+	db, dberr := makeTestdb(t, ":memory:")
+	require.NoError(t, dberr)
+	store, _ := New(db)
+
+	parent, err := store.StoreItem(ctx, testutils.MakeItem(t.Name()+"_parent"))
+	require.NoError(t, err)
+	child := testutils.MakeItem(t.Name() + "_child")
+	child.Description = "some-description"
+	child.ParentId = parent.GetId()
+	item, err := store.StoreItem(ctx, child)
+	require.NoError(t, err)
+	require.Equal(t, "some-description", item.GetDescription())
+	status := testutils.MakeStatus(t.Name())
+	status.Color = "red"
+	status, err = store.StoreStatus(ctx, status)
+	require.NoError(t, err)
+	require.Equal(t, "red", status.GetColor())
+
+	t.Run("item", func(t *testing.T) {
+		require.NoError(t, store.UpdateItem(ctx, item.GetId(), filters.WithClearedFields("description", "parent_id")))
+		res, err := store.GetItem(ctx, item.GetId())
+		require.NoError(t, err)
+		require.Empty(t, res.GetDescription())
+		require.Empty(t, res.GetParentId())
+		require.Equal(t, item.GetName(), res.GetName())
+	})
+	t.Run("status", func(t *testing.T) {
+		require.NoError(t, store.UpdateStatus(ctx, status.GetId(), filters.WithClearedFields("color")))
+		res, err := store.GetStatus(ctx, status.GetId())
+		require.NoError(t, err)
+		require.Empty(t, res.GetColor())
+		require.Equal(t, status.GetKind(), res.GetKind())
+	})
+	t.Run("required-field", func(t *testing.T) {
+		require.ErrorIs(t, store.UpdateItem(ctx, item.GetId(), filters.WithClearedFields("name")), serrors.ErrEmptyString)
+		require.ErrorIs(t, store.UpdateStatus(ctx, status.GetId(), filters.WithClearedFields("kind")), serrors.ErrEmptyString)
+	})
+	t.Run("set-and-cleared", func(t *testing.T) {
+		err := store.UpdateStatus(ctx, status.GetId(), filters.WithColor("blue"), filters.WithClearedFields("color"))
+		require.ErrorIs(t, err, serrors.ErrAlreadySet)
+	})
+}
@@ -0,0 +1,91 @@
+package sqlite
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+
+	"github.com/lusis/statusthing/internal/serrors"
+	"github.com/lusis/statusthing/internal/storers"
+	"github.com/lusis/statusthing/internal/storers/internal"
+	_ "github.com/lusis/statusthing/internal/storers/sqlite/driver" // sql driver
+	"github.com/lusis/statusthing/internal/validation"
+
+	"modernc.org/sqlite"
+)
+
+// StoreIdempotencyRecord stores the provided [storers.IdempotencyRecord]
+// [serrors.ErrConflict] is returned if a record with the same key already exists
+func (s *Store) StoreIdempotencyRecord(ctx context.Context, rec *storers.IdempotencyRecord) error {
+	dbrec, recerr := internal.DbIdempotencyRecordFromRecord(rec)
+	if recerr != nil {
+		return recerr
+	}
+	err := s.storeStruct(ctx, idempotencyTableName, dbrec)
+	var sqliteErr *sqlite.Error
+	if errors.As(err, &sqliteErr) {
+		// primary key constraint
+		if sqliteErr.Code() == 1555 {
+			return serrors.NewWrappedError("idempotency key", serrors.ErrConflict, sqliteErr)
+		}
+	}
+	return err
+}
+
+// GetIdempotencyRecord gets a [storers.IdempotencyRecord] by its key
+func (s *Store) GetIdempotencyRecord(ctx context.Context, key string) (*storers.IdempotencyRecord, error) {
+	if !validation.ValidString(key) {
+		return nil, serrors.NewError("key", serrors.ErrEmptyString)
+	}
+	dbrec := &internal.DbIdempotencyRecord{}
+	found, ferr := s.goqudb.From(idempotencyTableName).Prepared(true).
+		Where(goqu.C(idempotencyKeyColumn).Eq(key)).ScanStructContext(ctx, dbrec)
+	if ferr != nil {
+		return nil, serrors.NewWrappedError("read", serrors.ErrStoreUnavailable, ferr)
+	}
+	if !found {
+		return nil, serrors.NewError("idempotency key", serrors.ErrNotFound)
+	}
+	return dbrec.ToRecord()
+}
+
+// UpdateIdempotencyRecord sets the response of the [storers.IdempotencyRecord] with the provided key
+func (s *Store) UpdateIdempotencyRecord(ctx context.Context, key string, response []byte) error {
+	if !validation.ValidString(key) {
+		return serrors.NewError("key", serrors.ErrEmptyString)
+	}
+	if len(response) == 0 {
+		return serrors.NewError("response", serrors.ErrNilVal)
+	}
+	if _, err := s.GetIdempotencyRecord(ctx, key); err != nil {
+		return err
+	}
+	return s.update(ctx, idempotencyTableName, idempotencyKeyColumn, key, map[string]any{responseColumn: response})
+}
+
+// DeleteIdempotencyRecord deletes a [storers.IdempotencyRecord] by its key
+func (s *Store) DeleteIdempotencyRecord(ctx context.Context, key string) error {
+	if !validation.ValidString(key) {
+		return serrors.NewError("key", serrors.ErrEmptyString)
+	}
+	if _, err := s.GetIdempotencyRecord(ctx, key); err != nil {
+		return err
+	}
+	return s.del(ctx, idempotencyTableName, idempotencyKeyColumn, key)
+}
+
+// DeleteExpiredIdempotencyRecords deletes every [storers.IdempotencyRecord] that expired before the provided time and returns how many were deleted
+func (s *Store) DeleteExpiredIdempotencyRecords(ctx context.Context, before time.Time) (int, error) {
+	res, err := s.db.ExecContext(ctx, fmt.Sprintf("DELETE FROM %s WHERE %s < ?", idempotencyTableName, expiresColumn), storers.TimeToUint64(&before))
+	if err != nil {
+		return 0, serrors.NewWrappedError("write", serrors.ErrUnrecoverable, err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return 0, serrors.NewWrappedError("affected-rows", serrors.ErrUnrecoverable, err)
+	}
+	return int(affected), nil
+}
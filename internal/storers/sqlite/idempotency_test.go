@@ -0,0 +1,75 @@
+package sqlite
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lusis/statusthing/internal/serrors"
+	"github.com/lusis/statusthing/internal/storers"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIdempotencyRecordLifecycle(t *testing.T) {
+	ctx := context.TODO()
+	db, dberr := makeTestdb(t, ":memory:")
+	require.NoError(t, dberr)
+	store, _ := New(db)
+
+	now := time.Now().UTC()
+	rec := &storers.IdempotencyRecord{
+		Key:         t.Name(),
+		RequestHash: "some-hash",
+		Created:     now,
+		Expires:     now.Add(time.Hour),
+	}
+
+	// Store
+	require.NoError(t, store.StoreIdempotencyRecord(ctx, rec))
+	require.ErrorIs(t, store.StoreIdempotencyRecord(ctx, rec), serrors.ErrConflict)
+
+	// Get
+	res, err := store.GetIdempotencyRecord(ctx, rec.Key)
+	require.NoError(t, err)
+	require.Equal(t, rec.RequestHash, res.RequestHash)
+	require.Empty(t, res.Response)
+	require.True(t, rec.Expires.Equal(res.Expires))
+
+	// Update
+	require.NoError(t, store.UpdateIdempotencyRecord(ctx, rec.Key, []byte("some-response")))
+	require.ErrorIs(t, store.UpdateIdempotencyRecord(ctx, "missing", []byte("some-response")), serrors.ErrNotFound)
+	res, err = store.GetIdempotencyRecord(ctx, rec.Key)
+	require.NoError(t, err)
+	require.Equal(t, []byte("some-response"), res.Response)
+
+	// Delete
+	require.NoError(t, store.DeleteIdempotencyRecord(ctx, rec.Key))
+	_, err = store.GetIdempotencyRecord(ctx, rec.Key)
+	require.ErrorIs(t, err, serrors.ErrNotFound)
+	require.ErrorIs(t, store.DeleteIdempotencyRecord(ctx, rec.Key), serrors.ErrNotFound)
+}
+
+func TestDeleteExpiredIdempotencyRecords(t *testing.T) {
+	ctx := context.TODO()
+	db, dberr := makeTestdb(t, ":memory:")
+	require.NoError(t, dberr)
+	store, _ := New(db)
+
+	now := time.Now().UTC()
+	for key, expires := range map[string]time.Time{"expired": now.Add(-time.Minute), "current": now.Add(time.Hour)} {
+		require.NoError(t, store.StoreIdempotencyRecord(ctx, &storers.IdempotencyRecord{
+			Key:         key,
+			RequestHash: "some-hash",
+			Created:     now.Add(-time.Hour),
+			Expires:     expires,
+		}))
+	}
+	deleted, err := store.DeleteExpiredIdempotencyRecords(ctx, now)
+	require.NoError(t, err)
+	require.Equal(t, 1, deleted)
+	_, err = store.GetIdempotencyRecord(ctx, "expired")
+	require.ErrorIs(t, err, serrors.ErrNotFound)
+	_, err = store.GetIdempotencyRecord(ctx, "current")
+	require.NoError(t, err)
+}
@@ -4,7 +4,6 @@ package sqlite
 
 import (
 	"context"
-	"errors"
 	"fmt"
 
 	"github.com/doug-martin/goqu/v9"
@@ -94,12 +93,24 @@ func (s *Store) GetItem(ctx context.Context, itemID string) (*statusthingv1.Item
 			return nil, serrors.NewWrappedError("notes", serrors.ErrInvalidData, nerr)
 		}
 		pbrec.Notes = notes
+
+		deps, derr := s.FindDependencies(ctx, filters.WithItemID(rec.ID))
+		if derr != nil {
+			return nil, serrors.NewWrappedError("dependencies", serrors.ErrInvalidData, derr)
+		}
+		for _, dep := range deps {
+			pbrec.DependsOn = append(pbrec.DependsOn, dep.GetDependsOnId())
+		}
 		return pbrec, nil
 	}
 	return nil, serrors.NewError("items", serrors.ErrNotFound)
 }
 
 // FindItems returns all known [statusthingv1.Item] optionally filtered by the provided [filters.FilterOption]
+// Supported filters:
+// - [filters.WithStatusIDs]
+// - [filters.WithStatusKinds]
+// - [filters.WithParentID]
 func (s *Store) FindItems(ctx context.Context, opts ...filters.FilterOption) ([]*statusthingv1.Item, error) {
 	f, ferr := filters.New(opts...)
 	if ferr != nil {
@@ -131,8 +142,12 @@ func (s *Store) FindItems(ctx context.Context, opts ...filters.FilterOption) ([]
 		exprs = append(exprs, goqu.I("status.id").In(f.StatusIDs())) // TOOD: cleanup column names
 	}
 
-	where := ds.Where(goqu.Or(exprs...)).Order(goqu.C(idColumn).Asc())
-	werr := where.ScanStructsContext(ctx, &dbitems)
+	where := ds.Where(goqu.Or(exprs...))
+	// parent is a narrowing filter and not an alternative like the status filters
+	if validation.ValidString(f.ParentID()) {
+		where = where.Where(goqu.I("items.parent_id").Eq(f.ParentID()))
+	}
+	werr := where.Order(goqu.I("items.id").Asc()).ScanStructsContext(ctx, &dbitems)
 	if werr != nil {
 		return nil, serrors.NewWrappedError("driver", serrors.ErrUnrecoverable, werr)
 	}
@@ -152,6 +167,10 @@ func (s *Store) FindItems(ctx context.Context, opts ...filters.FilterOption) ([]
 // - [filters.WithStatusID]
 // - [filters.WithName]
 // - [filters.WithDescription]
+// - [filters.WithParentID]
+// - [filters.WithRollupPolicy]
+// - [filters.WithExpectedVersion]: returns [serrors.ErrConflict] if the item has been changed since
+// - [filters.WithClearedFields]: description, status_id, parent_id and rollup_policy can be cleared
 func (s *Store) UpdateItem(ctx context.Context, itemID string, opts ...filters.FilterOption) error {
 	f, ferr := filters.New(opts...)
 	if ferr != nil {
@@ -177,26 +196,17 @@ func (s *Store) UpdateItem(ctx context.Context, itemID string, opts ...filters.F
 	if validation.ValidString(desc) {
 		columns[descriptionColumn] = desc
 	}
-
-	query, params, qerr := s.goqudb.Update(itemsTableName).Prepared(true).Where(goqu.I(idColumn).Eq(itemID)).Set(columns).ToSQL()
-	if qerr != nil {
-		return serrors.NewWrappedError("sqlbuilder", serrors.ErrUnrecoverable, qerr)
+	if validation.ValidString(f.ParentID()) {
+		columns[parentIDColumn] = f.ParentID()
 	}
-
-	res, reserr := s.db.ExecContext(ctx, query, params...)
-	if reserr != nil {
-		var sqliteErr *sqlite.Error
-		if errors.As(reserr, &sqliteErr) {
-			if sqliteErr.Code() == 787 {
-				return serrors.NewWrappedError("item", serrors.ErrNotFound, sqliteErr)
-			}
-		}
-		return serrors.NewWrappedError("write", serrors.ErrUnrecoverable, reserr)
+	if f.RollupPolicy() != statusthingv1.RollupPolicy_ROLLUP_POLICY_UNKNOWN {
+		columns[rollupColumn] = f.RollupPolicy().String()
 	}
-	if _, lerr := res.LastInsertId(); lerr != nil {
-		return serrors.NewWrappedError("last-insert-id", serrors.ErrUnrecoverable, lerr)
+	if err := clearColumns(columns, f.ClearedFields(), descriptionColumn, statusIDColumn, parentIDColumn, rollupColumn); err != nil {
+		return err
 	}
-	return nil
+
+	return s.updateVersioned(ctx, itemsTableName, "item", itemID, f.ExpectedVersion(), columns)
 }
 
 // DeleteItem deletes the [statusthingv1.Item] by its id
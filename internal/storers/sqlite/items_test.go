@@ -219,3 +219,37 @@ func TestUpdateItem(t *testing.T) {
 	})
 
 }
+
+func TestItemParent(t *testing.T) {
+	ctx := context.TODO()
+	db, dberr := makeTestdb(t, ":memory:")
+	require.NoError(t, dberr)
+	store, _ := New(db)
+
+	parent, err := store.StoreItem(ctx, testutils.MakeItem(t.Name()+"_parent"))
+	require.NoError(t, err)
+	child := testutils.MakeItem(t.Name() + "_child")
+	child.ParentId = parent.GetId()
+	cres, err := store.StoreItem(ctx, child)
+	require.NoError(t, err)
+	require.Equal(t, parent.GetId(), cres.GetParentId())
+	_, err = store.StoreItem(ctx, testutils.MakeItem(t.Name()+"_other"))
+	require.NoError(t, err)
+
+	found, err := store.FindItems(ctx, filters.WithParentID(parent.GetId()))
+	require.NoError(t, err)
+	require.Len(t, found, 1)
+	require.Equal(t, cres.GetId(), found[0].GetId())
+
+	uerr := store.UpdateItem(ctx, parent.GetId(), filters.WithRollupPolicy(statusthingv1.RollupPolicy_ROLLUP_POLICY_IGNORE))
+	require.NoError(t, uerr)
+	pres, err := store.GetItem(ctx, parent.GetId())
+	require.NoError(t, err)
+	require.Equal(t, statusthingv1.RollupPolicy_ROLLUP_POLICY_IGNORE, pres.GetRollupPolicy())
+
+	// deleting the parent ungroups the children
+	require.NoError(t, store.DeleteItem(ctx, parent.GetId()))
+	orphan, err := store.GetItem(ctx, cres.GetId())
+	require.NoError(t, err)
+	require.Empty(t, orphan.GetParentId())
+}
@@ -3,6 +3,7 @@ package sqlite
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/doug-martin/goqu/v9"
 
@@ -53,13 +54,21 @@ func (s *Store) GetNote(ctx context.Context, noteID string) (*v1.Note, error) {
 	if ferr != nil {
 		return nil, serrors.NewWrappedError("read", serrors.ErrStoreUnavailable, ferr)
 	}
-	if found {
-		return rec.ToProto()
+	if !found {
+		return nil, serrors.NewError("note", serrors.ErrNotFound)
 	}
-	return nil, serrors.NewError("status", serrors.ErrNotFound)
+	note, err := rec.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.withAttachments(ctx, note); err != nil {
+		return nil, err
+	}
+	return note, nil
 }
 
 // FindNotes gets all known [statusthingv1.Note] for the provided item id
+// pinned notes come first, then notes in the order they were added
 // no filters are supported at this time
 func (s *Store) FindNotes(ctx context.Context, itemID string, _ ...filters.FilterOption) ([]*v1.Note, error) {
 	dbresults := []*internal.DbNote{}
@@ -67,7 +76,7 @@ func (s *Store) FindNotes(ctx context.Context, itemID string, _ ...filters.Filte
 	if !validation.ValidString(itemID) {
 		return nil, serrors.NewError("itemid", serrors.ErrEmptyString)
 	}
-	dserr := s.goqudb.From(notesTableName).Prepared(true).Where(goqu.C(itemIDColumn).Eq(itemID)).Order(goqu.C(idColumn).Asc()).ScanStructsContext(ctx, &dbresults)
+	dserr := s.goqudb.From(notesTableName).Prepared(true).Where(goqu.C(itemIDColumn).Eq(itemID)).Order(goqu.C(pinnedColumn).Desc(), goqu.C(idColumn).Asc()).ScanStructsContext(ctx, &dbresults)
 	if dserr != nil {
 		return nil, serrors.NewWrappedError("driver", serrors.ErrUnrecoverable, dserr)
 	}
@@ -78,12 +87,20 @@ func (s *Store) FindNotes(ctx context.Context, itemID string, _ ...filters.Filte
 		}
 		pbresults = append(pbresults, pb)
 	}
+	if err := s.withAttachments(ctx, pbresults...); err != nil {
+		return nil, err
+	}
 	return pbresults, nil
 }
 
 // UpdateNote updates the [statusthingv1.Note] with the provided [filters.FilterOption]
+// when the text or visibility changes the note as it was before is kept as a [statusthingv1.NoteRevision]
+// the note is marked as edited when its text changes. updates that change nothing aren't written
 // supported filters:
 // [filters.WithNoteText]
+// [filters.WithNoteVisibility]
+// [filters.WithPinned]
+// [filters.WithExpectedVersion]: returns [serrors.ErrConflict] if the note has been changed since
 func (s *Store) UpdateNote(ctx context.Context, noteID string, opts ...filters.FilterOption) error {
 	if len(opts) == 0 {
 		return serrors.NewError("opts", serrors.ErrAtLeastOne)
@@ -92,28 +109,77 @@ func (s *Store) UpdateNote(ctx context.Context, noteID string, opts ...filters.F
 	if ferr != nil {
 		return ferr
 	}
-	_, eerr := s.GetNote(ctx, noteID)
-	if eerr != nil {
-		return eerr
+	columns := map[string]any{}
+	if validation.ValidString(f.NoteText()) {
+		columns[noteColumn] = f.NoteText()
 	}
-	if !validation.ValidString(f.NoteText()) {
+	if f.NoteVisibility() != v1.NoteVisibility_NOTE_VISIBILITY_UNKNOWN {
+		columns[visibilityColumn] = f.NoteVisibility().String()
+	}
+	if pinned, ok := f.Pinned(); ok {
+		columns[pinnedColumn] = pinned
+	}
+	if len(columns) == 0 {
 		return serrors.NewError("text", serrors.ErrEmptyString)
 	}
 
-	query, params, qerr := s.goqudb.Update(notesTableName).Prepared(true).Where(goqu.C(idColumn).Eq(noteID)).
-		Set(goqu.Record{"note_text": f.NoteText()}).ToSQL()
-	if qerr != nil {
-		return serrors.NewWrappedError("driver", serrors.ErrUnrecoverable, qerr)
-	}
+	return s.withTx(ctx, func(tx *Store) error {
+		current, err := tx.GetNote(ctx, noteID)
+		if err != nil {
+			return err
+		}
+		if f.ExpectedVersion() != 0 && f.ExpectedVersion() != current.GetVersion() {
+			return serrors.NewError("version", serrors.ErrConflict)
+		}
+		textChanged := validation.ValidString(f.NoteText()) && f.NoteText() != current.GetText()
+		visibilityChanged := f.NoteVisibility() != v1.NoteVisibility_NOTE_VISIBILITY_UNKNOWN && f.NoteVisibility() != current.GetVisibility()
+		pinned, ok := f.Pinned()
+		pinnedChanged := ok && pinned != current.GetPinned()
+		if !textChanged && !visibilityChanged && !pinnedChanged {
+			return nil
+		}
+		if textChanged {
+			columns[editedColumn] = true
+		}
+		// pinning doesn't change what the note says so it isn't kept as a revision
+		if textChanged || visibilityChanged {
+			rev, err := internal.DbNoteRevisionFromNote(current, time.Now())
+			if err != nil {
+				return err
+			}
+			query, params, qerr := tx.goqudb.Insert(revisionsTableName).Prepared(true).Rows(rev).ToSQL()
+			if qerr != nil {
+				return serrors.NewWrappedError("querybuilder", serrors.ErrUnrecoverable, qerr)
+			}
+			if _, err := tx.db.ExecContext(ctx, query, params...); err != nil {
+				return serrors.NewWrappedError("write", serrors.ErrUnrecoverable, err)
+			}
+		}
+		return tx.updateVersioned(ctx, notesTableName, "note", noteID, f.ExpectedVersion(), columns)
+	})
+}
 
-	res, reserr := s.db.ExecContext(ctx, query, params...)
-	if reserr != nil {
-		return serrors.NewWrappedError("write", serrors.ErrUnrecoverable, reserr)
+// FindNoteRevisions gets every [statusthingv1.NoteRevision] of the [statusthingv1.Note] with the provided id, oldest first
+func (s *Store) FindNoteRevisions(ctx context.Context, noteID string) ([]*v1.NoteRevision, error) {
+	if !validation.ValidString(noteID) {
+		return nil, serrors.NewError("noteid", serrors.ErrEmptyString)
 	}
-	if _, lerr := res.LastInsertId(); lerr != nil {
-		return serrors.NewWrappedError("last-insert-id", serrors.ErrUnrecoverable, lerr)
+	if _, err := s.GetNote(ctx, noteID); err != nil {
+		return nil, err
 	}
-	return nil
+	dbresults := []*internal.DbNoteRevision{}
+	if err := s.goqudb.From(revisionsTableName).Prepared(true).Where(goqu.C(noteIDColumn).Eq(noteID)).Order(goqu.C(versionColumn).Asc()).ScanStructsContext(ctx, &dbresults); err != nil {
+		return nil, serrors.NewWrappedError("driver", serrors.ErrUnrecoverable, err)
+	}
+	res := []*v1.NoteRevision{}
+	for _, rec := range dbresults {
+		pb, err := rec.ToProto()
+		if err != nil {
+			return nil, serrors.NewWrappedError("proto", serrors.ErrUnrecoverable, err)
+		}
+		res = append(res, pb)
+	}
+	return res, nil
 }
 
 // DeleteNote deletes a [statusthingv1.Note] by its id
@@ -2,8 +2,10 @@ package sqlite
 
 import (
 	"context"
+	"strings"
 	"testing"
 
+	v1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
 	"github.com/lusis/statusthing/internal/filters"
 	"github.com/lusis/statusthing/internal/serrors"
 	_ "github.com/lusis/statusthing/internal/storers/sqlite/driver" // sql driver
@@ -68,3 +70,125 @@ func TestNoteLifeCycle(t *testing.T) {
 	require.NoError(t, cierr)
 	require.True(t, proto.Equal(cires, ires))
 }
+
+func TestNoteTextStoredAsIs(t *testing.T) {
+	ctx := context.TODO()
+	db, dberr := makeTestdb(t, ":memory:")
+	require.NoError(t, dberr)
+	store, _ := New(db)
+	first, err := store.StoreItem(ctx, testutils.MakeItem("first"))
+	require.NoError(t, err)
+	second, err := store.StoreItem(ctx, testutils.MakeItem("second <&> \"quoted\""))
+	require.NoError(t, err)
+
+	text := "## Investigating\n\n**Errors** on `/api` for <some> users & \"others\"\n" + strings.Repeat("more detail ", 100)
+	for _, item := range []string{first.GetId(), second.GetId()} {
+		note := testutils.MakeNote(item)
+		note.Text = text
+		_, err := store.StoreNote(ctx, note, item)
+		require.NoError(t, err, "the same text should be allowed on more than one item")
+		res, err := store.GetNote(ctx, note.GetId())
+		require.NoError(t, err)
+		require.Equal(t, text, res.GetText())
+	}
+	res, err := store.GetItem(ctx, second.GetId())
+	require.NoError(t, err)
+	require.Equal(t, second.GetName(), res.GetName())
+	require.Contains(t, res.GetName(), "<&>")
+}
+
+func TestNoteVisibilityAndPinning(t *testing.T) {
+	ctx := context.TODO()
+	db, dberr := makeTestdb(t, ":memory:")
+	require.NoError(t, dberr)
+	store, _ := New(db)
+	item, err := store.StoreItem(ctx, testutils.MakeItem(t.Name()))
+	require.NoError(t, err)
+
+	plain := testutils.MakeNote(t.Name() + "-plain")
+	_, err = store.StoreNote(ctx, plain, item.GetId())
+	require.NoError(t, err)
+	internal := testutils.MakeNote(t.Name() + "-internal")
+	internal.Author = "ci-bot"
+	internal.Visibility = v1.NoteVisibility_NOTE_VISIBILITY_INTERNAL
+	_, err = store.StoreNote(ctx, internal, item.GetId())
+	require.NoError(t, err)
+
+	res, err := store.GetNote(ctx, plain.GetId())
+	require.NoError(t, err)
+	require.Equal(t, v1.NoteVisibility_NOTE_VISIBILITY_PUBLIC, res.GetVisibility(), "notes should be public by default")
+	require.Empty(t, res.GetAuthor())
+	res, err = store.GetNote(ctx, internal.GetId())
+	require.NoError(t, err)
+	require.Equal(t, v1.NoteVisibility_NOTE_VISIBILITY_INTERNAL, res.GetVisibility())
+	require.Equal(t, "ci-bot", res.GetAuthor())
+
+	require.NoError(t, store.UpdateNote(ctx, internal.GetId(), filters.WithPinned(true), filters.WithNoteVisibility(v1.NoteVisibility_NOTE_VISIBILITY_PUBLIC)))
+	notes, err := store.FindNotes(ctx, item.GetId())
+	require.NoError(t, err)
+	require.Len(t, notes, 2)
+	require.Equal(t, internal.GetId(), notes[0].GetId(), "pinned notes should be first")
+	require.True(t, notes[0].GetPinned())
+	require.Equal(t, v1.NoteVisibility_NOTE_VISIBILITY_PUBLIC, notes[0].GetVisibility())
+	require.Equal(t, "ci-bot", notes[0].GetAuthor(), "updates should keep the author")
+
+	require.ErrorIs(t, store.UpdateNote(ctx, internal.GetId(), filters.WithExpectedVersion(2)), serrors.ErrEmptyString, "something must be changed")
+}
+
+func TestNoteRevisions(t *testing.T) {
+	ctx := context.TODO()
+	db, dberr := makeTestdb(t, ":memory:")
+	require.NoError(t, dberr)
+	store, _ := New(db)
+	item, err := store.StoreItem(ctx, testutils.MakeItem(t.Name()))
+	require.NoError(t, err)
+	note, err := store.StoreNote(ctx, testutils.MakeNote(t.Name()), item.GetId())
+	require.NoError(t, err)
+
+	revisions, err := store.FindNoteRevisions(ctx, note.GetId())
+	require.NoError(t, err)
+	require.Empty(t, revisions)
+	_, err = store.FindNoteRevisions(ctx, "missing")
+	require.ErrorIs(t, err, serrors.ErrNotFound)
+
+	require.NoError(t, store.UpdateNote(ctx, note.GetId(), filters.WithPinned(true)))
+	res, err := store.GetNote(ctx, note.GetId())
+	require.NoError(t, err)
+	require.False(t, res.GetEdited(), "pinning should not mark the note as edited")
+	require.Equal(t, uint64(2), res.GetVersion())
+
+	require.NoError(t, store.UpdateNote(ctx, note.GetId(), filters.WithNoteText(note.GetText()), filters.WithPinned(true)))
+	res, err = store.GetNote(ctx, note.GetId())
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), res.GetVersion(), "updates that change nothing should not be written")
+
+	require.NoError(t, store.UpdateNote(ctx, note.GetId(), filters.WithNoteText("rewritten")))
+	res, err = store.GetNote(ctx, note.GetId())
+	require.NoError(t, err)
+	require.True(t, res.GetEdited())
+	require.Equal(t, uint64(3), res.GetVersion())
+
+	err = store.UpdateNote(ctx, note.GetId(), filters.WithNoteText("lost update"), filters.WithExpectedVersion(1))
+	require.ErrorIs(t, err, serrors.ErrConflict)
+
+	revisions, err = store.FindNoteRevisions(ctx, note.GetId())
+	require.NoError(t, err)
+	require.Len(t, revisions, 1, "only the text change should keep a revision")
+	require.Equal(t, uint64(2), revisions[0].GetVersion())
+	require.Equal(t, note.GetText(), revisions[0].GetText())
+	require.True(t, revisions[0].GetPinned())
+	require.NotNil(t, revisions[0].GetWritten())
+	require.NotNil(t, revisions[0].GetReplaced())
+
+	require.NoError(t, store.UpdateNote(ctx, note.GetId(), filters.WithNoteVisibility(v1.NoteVisibility_NOTE_VISIBILITY_INTERNAL)))
+	revisions, err = store.FindNoteRevisions(ctx, note.GetId())
+	require.NoError(t, err)
+	require.Len(t, revisions, 2, "a visibility change should keep a revision")
+	require.Equal(t, "rewritten", revisions[1].GetText())
+	require.Equal(t, v1.NoteVisibility_NOTE_VISIBILITY_PUBLIC, revisions[1].GetVisibility())
+
+	require.NoError(t, store.DeleteNote(ctx, note.GetId()))
+	var count int
+	require.NoError(t, db.QueryRowContext(ctx, "SELECT COUNT(*) FROM note_revisions").Scan(&count))
+	require.Zero(t, count, "revisions should be removed with the note")
+}
@@ -2,28 +2,52 @@ package sqlite
 
 const (
 	// tables
-	itemsTableName  = "items"
-	statusTableName = "status"
-	notesTableName  = "notes"
-	usersTableName  = "users"
+	itemsTableName       = "items"
+	statusTableName      = "status"
+	notesTableName       = "notes"
+	revisionsTableName   = "note_revisions"
+	depsTableName        = "item_dependencies"
+	usersTableName       = "users"
+	idempotencyTableName = "idempotency_keys"
+	attachmentsTableName = "attachments"
+	blobsTableName       = "blobs"
+	settingsTableName    = "settings"
+	sessionsTableName    = "sessions"
 
 	// columns
-	idColumn          = "id"
-	statusIDColumn    = "status_id"
-	itemIDColumn      = "item_id"
-	descriptionColumn = "description"
-	nameColumn        = "name"
-	colorColumn       = "color"
-	createdColumn     = "created"
-	updatedColumn     = "updated"
-	deleteColumn      = "deleted"
-	kindColumn        = "kind"
-	noteColumn        = "note_text"
-	fnameColumn       = "first_name"
-	lnameColumn       = "last_name"
-	lastloginColumn   = "last_login"
-	usernameColumn    = "username"
-	passwordColumn    = "password"
-	emailColumn       = "email_address"
-	avatarURLColumn   = "avatar_url"
+	idColumn             = "id"
+	statusIDColumn       = "status_id"
+	itemIDColumn         = "item_id"
+	descriptionColumn    = "description"
+	nameColumn           = "name"
+	colorColumn          = "color"
+	createdColumn        = "created"
+	updatedColumn        = "updated"
+	deleteColumn         = "deleted"
+	kindColumn           = "kind"
+	noteColumn           = "note_text"
+	fnameColumn          = "first_name"
+	lnameColumn          = "last_name"
+	lastloginColumn      = "last_login"
+	usernameColumn       = "username"
+	passwordColumn       = "password"
+	emailColumn          = "email_address"
+	avatarURLColumn      = "avatar_url"
+	avatarColumn         = "avatar"
+	parentIDColumn       = "parent_id"
+	rollupColumn         = "rollup_policy"
+	dependsOnColumn      = "depends_on_id"
+	versionColumn        = "version"
+	idempotencyKeyColumn = "idempotency_key"
+	responseColumn       = "response"
+	expiresColumn        = "expires"
+	visibilityColumn     = "visibility"
+	pinnedColumn         = "pinned"
+	editedColumn         = "edited"
+	noteIDColumn         = "note_id"
+	blobKeyColumn        = "blob_key"
+	dataColumn           = "data"
+	tokenColumn          = "token"
+	roleColumn           = "role"
+	passwordChangeColumn = "password_change_required"
 )
@@ -0,0 +1,90 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+
+	"github.com/lusis/statusthing/internal/serrors"
+	"github.com/lusis/statusthing/internal/storers"
+	"github.com/lusis/statusthing/internal/validation"
+)
+
+// StoreSession creates or replaces the session with the provided token
+func (s *Store) StoreSession(ctx context.Context, token string, data []byte, expires time.Time) error {
+	if !validation.ValidString(token) {
+		return serrors.NewError("token", serrors.ErrEmptyString)
+	}
+	query := fmt.Sprintf("INSERT INTO %s (%s, %s, %s) VALUES (?, ?, ?) ON CONFLICT(%s) DO UPDATE SET %s = excluded.%s, %s = excluded.%s",
+		sessionsTableName, tokenColumn, dataColumn, expiresColumn, tokenColumn, dataColumn, dataColumn, expiresColumn, expiresColumn)
+	if _, err := s.db.ExecContext(ctx, query, token, data, storers.TimeToUint64(&expires)); err != nil {
+		return serrors.NewWrappedError("write", serrors.ErrUnrecoverable, err)
+	}
+	return nil
+}
+
+// GetSession gets the data of the session with the provided token
+// sessions that have expired are [serrors.ErrNotFound]
+func (s *Store) GetSession(ctx context.Context, token string) ([]byte, error) {
+	if !validation.ValidString(token) {
+		return nil, serrors.NewError("token", serrors.ErrEmptyString)
+	}
+	now := time.Now()
+	var data []byte
+	found, err := s.goqudb.From(sessionsTableName).Prepared(true).Select(dataColumn).
+		Where(goqu.C(tokenColumn).Eq(token), goqu.C(expiresColumn).Gt(storers.TimeToUint64(&now))).
+		ScanValContext(ctx, &data)
+	if err != nil {
+		return nil, serrors.NewWrappedError("read", serrors.ErrStoreUnavailable, err)
+	}
+	if !found {
+		return nil, serrors.NewError("session", serrors.ErrNotFound)
+	}
+	return data, nil
+}
+
+// FindSessions gets the data of every session that hasn't expired by token
+func (s *Store) FindSessions(ctx context.Context) (map[string][]byte, error) {
+	now := time.Now()
+	recs := []struct {
+		Token string `db:"token"`
+		Data  []byte `db:"data"`
+	}{}
+	err := s.goqudb.From(sessionsTableName).Prepared(true).Select(tokenColumn, dataColumn).
+		Where(goqu.C(expiresColumn).Gt(storers.TimeToUint64(&now))).
+		ScanStructsContext(ctx, &recs)
+	if err != nil {
+		return nil, serrors.NewWrappedError("read", serrors.ErrStoreUnavailable, err)
+	}
+	res := make(map[string][]byte, len(recs))
+	for _, rec := range recs {
+		res[rec.Token] = rec.Data
+	}
+	return res, nil
+}
+
+// DeleteSession deletes the session with the provided token. deleting a session that doesn't exist is not an error
+func (s *Store) DeleteSession(ctx context.Context, token string) error {
+	if !validation.ValidString(token) {
+		return serrors.NewError("token", serrors.ErrEmptyString)
+	}
+	if _, err := s.db.ExecContext(ctx, fmt.Sprintf("DELETE FROM %s WHERE %s = ?", sessionsTableName, tokenColumn), token); err != nil {
+		return serrors.NewWrappedError("write", serrors.ErrUnrecoverable, err)
+	}
+	return nil
+}
+
+// DeleteExpiredSessions deletes every session that expired before the provided time and returns how many were deleted
+func (s *Store) DeleteExpiredSessions(ctx context.Context, before time.Time) (int, error) {
+	res, err := s.db.ExecContext(ctx, fmt.Sprintf("DELETE FROM %s WHERE %s < ?", sessionsTableName, expiresColumn), storers.TimeToUint64(&before))
+	if err != nil {
+		return 0, serrors.NewWrappedError("write", serrors.ErrUnrecoverable, err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return 0, serrors.NewWrappedError("affected-rows", serrors.ErrUnrecoverable, err)
+	}
+	return int(affected), nil
+}
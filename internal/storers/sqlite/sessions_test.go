@@ -0,0 +1,50 @@
+package sqlite
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lusis/statusthing/internal/serrors"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionLifecycle(t *testing.T) {
+	ctx := context.TODO()
+	db, dberr := makeTestdb(t, ":memory:")
+	require.NoError(t, dberr)
+	store, _ := New(db)
+	now := time.Now()
+
+	require.ErrorIs(t, store.StoreSession(ctx, "", []byte("data"), now.Add(time.Hour)), serrors.ErrEmptyString)
+	_, err := store.GetSession(ctx, "missing")
+	require.ErrorIs(t, err, serrors.ErrNotFound)
+
+	// Store and replace
+	require.NoError(t, store.StoreSession(ctx, "current", []byte("first"), now.Add(time.Hour)))
+	require.NoError(t, store.StoreSession(ctx, "current", []byte("second"), now.Add(2*time.Hour)))
+	require.NoError(t, store.StoreSession(ctx, "expired", []byte("old"), now.Add(-time.Minute)))
+	data, err := store.GetSession(ctx, "current")
+	require.NoError(t, err)
+	require.Equal(t, []byte("second"), data)
+	_, err = store.GetSession(ctx, "expired")
+	require.ErrorIs(t, err, serrors.ErrNotFound, "expired sessions should not be found")
+
+	// Find
+	all, err := store.FindSessions(ctx)
+	require.NoError(t, err)
+	require.Equal(t, map[string][]byte{"current": []byte("second")}, all)
+
+	// Delete expired
+	deleted, err := store.DeleteExpiredSessions(ctx, now)
+	require.NoError(t, err)
+	require.Equal(t, 1, deleted)
+
+	// Delete
+	require.NoError(t, store.DeleteSession(ctx, "current"))
+	require.NoError(t, store.DeleteSession(ctx, "current"), "deleting a missing session is not an error")
+	all, err = store.FindSessions(ctx)
+	require.NoError(t, err)
+	require.Empty(t, all)
+}
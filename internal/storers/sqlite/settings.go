@@ -0,0 +1,129 @@
+package sqlite
+
+import (
+	"context"
+
+	"github.com/doug-martin/goqu/v9"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
+	"github.com/lusis/statusthing/internal/serrors"
+	"github.com/lusis/statusthing/internal/storers"
+	"github.com/lusis/statusthing/internal/storers/internal"
+)
+
+// GetSettings gets the [v1.Settings]
+// settings that were never stored are returned empty with a version of 0
+func (s *Store) GetSettings(ctx context.Context) (*v1.Settings, error) {
+	rec := &internal.DbSettings{}
+	found, err := s.goqudb.From(settingsTableName).Prepared(true).Where(goqu.C(idColumn).Eq(internal.SettingsID)).ScanStructContext(ctx, rec)
+	if err != nil {
+		return nil, serrors.NewWrappedError("read", serrors.ErrStoreUnavailable, err)
+	}
+	if !found {
+		return &v1.Settings{}, nil
+	}
+	return rec.ToProto()
+}
+
+// StoreSettings replaces the [v1.Settings] and stores the provided images in a single transaction
+// if expectedVersion is not zero the settings are only stored when their current version matches and [serrors.ErrConflict] is returned otherwise
+func (s *Store) StoreSettings(ctx context.Context, settings *v1.Settings, expectedVersion uint64, images ...*storers.SettingsImage) (*v1.Settings, error) {
+	if settings == nil {
+		return nil, serrors.NewError("settings", serrors.ErrNilVal)
+	}
+	for _, img := range images {
+		if img == nil {
+			return nil, serrors.NewError("image", serrors.ErrNilVal)
+		}
+		if img.Name != storers.SettingsLogo && img.Name != storers.SettingsFavicon {
+			return nil, serrors.NewError("image "+img.Name, serrors.ErrUnknownField)
+		}
+	}
+	var res *v1.Settings
+	err := s.withTx(ctx, func(tx *Store) error {
+		current, err := tx.GetSettings(ctx)
+		if err != nil {
+			return err
+		}
+		if expectedVersion != 0 && current.GetVersion() != expectedVersion {
+			return serrors.NewError("version", serrors.ErrConflict)
+		}
+		next, ok := proto.Clone(settings).(*v1.Settings)
+		if !ok {
+			return serrors.NewError("settings", serrors.ErrInvalidData)
+		}
+		now := timestamppb.Now()
+		next.Version = current.GetVersion() + 1
+		next.Timestamps = &v1.Timestamps{Created: now, Updated: now}
+		if current.GetVersion() != 0 {
+			next.Timestamps.Created = current.GetTimestamps().GetCreated()
+		}
+		rec, err := internal.DbSettingsFromProto(next)
+		if err != nil {
+			return err
+		}
+		if current.GetVersion() == 0 {
+			if err := tx.storeStruct(ctx, settingsTableName, rec); err != nil {
+				return err
+			}
+		} else if err := tx.update(ctx, settingsTableName, idColumn, internal.SettingsID, settingsColumns(rec)); err != nil {
+			return err
+		}
+		for _, img := range images {
+			cols := map[string]any{img.Name: nil, img.Name + "_type": nil}
+			if len(img.Data) != 0 {
+				cols[img.Name] = img.Data
+				cols[img.Name+"_type"] = img.ContentType
+			}
+			if err := tx.update(ctx, settingsTableName, idColumn, internal.SettingsID, cols); err != nil {
+				return err
+			}
+		}
+		res, err = tx.GetSettings(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetSettingsImage gets the [storers.SettingsImage] with the provided name
+func (s *Store) GetSettingsImage(ctx context.Context, name string) (*storers.SettingsImage, error) {
+	if name != storers.SettingsLogo && name != storers.SettingsFavicon {
+		return nil, serrors.NewError("image "+name, serrors.ErrNotFound)
+	}
+	rec := struct {
+		Data        []byte  `db:"data"`
+		ContentType *string `db:"content_type"`
+	}{}
+	found, err := s.goqudb.From(settingsTableName).Prepared(true).
+		Select(goqu.C(name).As("data"), goqu.C(name+"_type").As("content_type")).
+		Where(goqu.C(idColumn).Eq(internal.SettingsID), goqu.C(name).IsNotNull()).
+		ScanStructContext(ctx, &rec)
+	if err != nil {
+		return nil, serrors.NewWrappedError("read", serrors.ErrStoreUnavailable, err)
+	}
+	if !found || rec.ContentType == nil {
+		return nil, serrors.NewError("image "+name, serrors.ErrNotFound)
+	}
+	return &storers.SettingsImage{Name: name, ContentType: *rec.ContentType, Data: rec.Data}, nil
+}
+
+// settingsColumns returns every column of the settings record so fields that were cleared are set to null
+func settingsColumns(rec *internal.DbSettings) map[string]any {
+	return map[string]any{
+		"page_title":        rec.PageTitle,
+		"logo_url":          rec.LogoURL,
+		"favicon_url":       rec.FaviconURL,
+		"accent_color":      rec.AccentColor,
+		"accent_text_color": rec.AccentTextColor,
+		"support_url":       rec.SupportURL,
+		"footer_text":       rec.FooterText,
+		"timezone":          rec.Timezone,
+		versionColumn:       rec.Version,
+		updatedColumn:       rec.Updated,
+	}
+}
@@ -0,0 +1,61 @@
+package sqlite
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
+	"github.com/lusis/statusthing/internal/serrors"
+	"github.com/lusis/statusthing/internal/storers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSettings(t *testing.T) {
+	ctx := context.TODO()
+	db, dberr := makeTestdb(t, ":memory:")
+	require.NoError(t, dberr)
+	store, _ := New(db)
+
+	empty, err := store.GetSettings(ctx)
+	require.NoError(t, err)
+	require.Zero(t, empty.GetVersion(), "settings that were never stored should be empty")
+	_, err = store.GetSettingsImage(ctx, storers.SettingsLogo)
+	require.ErrorIs(t, err, serrors.ErrNotFound)
+
+	_, err = store.StoreSettings(ctx, &v1.Settings{PageTitle: "Acme"}, 1)
+	require.ErrorIs(t, err, serrors.ErrConflict)
+	first, err := store.StoreSettings(ctx, &v1.Settings{PageTitle: "Acme", FooterText: "call us", LogoUrl: "/branding/logo"}, 0,
+		&storers.SettingsImage{Name: storers.SettingsLogo, ContentType: "image/png", Data: []byte("png")},
+	)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), first.GetVersion())
+	require.Equal(t, "Acme", first.GetPageTitle())
+	require.Equal(t, "call us", first.GetFooterText())
+	require.True(t, first.GetTimestamps().GetCreated().IsValid())
+	logo, err := store.GetSettingsImage(ctx, storers.SettingsLogo)
+	require.NoError(t, err)
+	require.Equal(t, "image/png", logo.ContentType)
+	require.Equal(t, []byte("png"), logo.Data)
+
+	_, err = store.StoreSettings(ctx, &v1.Settings{PageTitle: "Acme Corp"}, 2)
+	require.ErrorIs(t, err, serrors.ErrConflict)
+	second, err := store.StoreSettings(ctx, &v1.Settings{PageTitle: "Acme Corp", Timezone: "Europe/Paris"}, 1,
+		&storers.SettingsImage{Name: storers.SettingsLogo},
+	)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), second.GetVersion())
+	require.Equal(t, "Acme Corp", second.GetPageTitle())
+	require.Empty(t, second.GetFooterText(), "fields missing from the new settings should be cleared")
+	require.Empty(t, second.GetLogoUrl())
+	require.Equal(t, "Europe/Paris", second.GetTimezone())
+	require.Equal(t, first.GetTimestamps().GetCreated().AsTime(), second.GetTimestamps().GetCreated().AsTime())
+	_, err = store.GetSettingsImage(ctx, storers.SettingsLogo)
+	require.ErrorIs(t, err, serrors.ErrNotFound, "empty images should be removed")
+
+	_, err = store.StoreSettings(ctx, second, 0, &storers.SettingsImage{Name: "banner", Data: []byte("png")})
+	require.ErrorIs(t, err, serrors.ErrUnknownField)
+	_, err = store.StoreSettings(ctx, nil, 0)
+	require.ErrorIs(t, err, serrors.ErrNilVal)
+	_, err = store.GetSettingsImage(ctx, "banner")
+	require.ErrorIs(t, err, serrors.ErrNotFound)
+}
@@ -1,8 +1,12 @@
 package sqlite
 
 import (
+	"context"
 	"database/sql"
+	"errors"
 
+	"github.com/lusis/statusthing/internal/serrors"
+	"github.com/lusis/statusthing/internal/storers"
 	_ "github.com/lusis/statusthing/internal/storers/sqlite/driver" // sql driver
 	"github.com/lusis/statusthing/internal/storers/unimplemented"
 
@@ -10,16 +14,66 @@ import (
 	_ "github.com/doug-martin/goqu/v9/dialect/sqlite3" // goqu dialect
 )
 
+// execer is the subset of [sql.DB] and [sql.Tx] we use directly
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+// querier is the subset of [goqu.Database] and [goqu.TxDatabase] we use to build queries
+type querier interface {
+	From(from ...any) *goqu.SelectDataset
+	Insert(table any) *goqu.InsertDataset
+	Update(table any) *goqu.UpdateDataset
+}
+
 // Store stores statusthing data
 type Store struct {
 	*unimplemented.StatusThingStore
-	db     *sql.DB
-	goqudb *goqu.Database
+	// conn is only set outside of a transaction
+	conn   *sql.DB
+	db     execer
+	goqudb querier
 }
 
 // New returns a new [Store]
 func New(db *sql.DB) (*Store, error) {
 	goqu.SetIgnoreUntaggedFields(true)
 	gdb := goqu.New("sqlite3", db)
-	return &Store{db: db, goqudb: gdb}, nil
+	return &Store{conn: db, db: db, goqudb: gdb}, nil
+}
+
+// WithTx calls the provided function with a [storers.StatusThingStorer] that does all of its work in a single transaction
+// the transaction is committed if the function returns nil and rolled back otherwise
+func (s *Store) WithTx(ctx context.Context, fn func(storers.StatusThingStorer) error) error {
+	return s.withTx(ctx, func(tx *Store) error {
+		return fn(tx)
+	})
+}
+
+// withTx runs the provided function with a [Store] that does all of its work in a single transaction
+// the transaction is committed if the function returns nil and rolled back otherwise
+// calling withTx on a [Store] that is already in a transaction reuses that transaction
+func (s *Store) withTx(ctx context.Context, fn func(*Store) error) error {
+	if s.conn == nil {
+		return fn(s)
+	}
+	tx, err := s.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return serrors.NewWrappedError("begin", serrors.ErrStoreUnavailable, err)
+	}
+	txstore := &Store{
+		StatusThingStore: s.StatusThingStore,
+		db:               tx,
+		goqudb:           goqu.NewTx("sqlite3", tx),
+	}
+	if err := fn(txstore); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			return serrors.NewWrappedError("rollback", serrors.ErrUnrecoverable, errors.Join(err, rerr))
+		}
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return serrors.NewWrappedError("commit", serrors.ErrUnrecoverable, err)
+	}
+	return nil
 }
@@ -3,12 +3,14 @@ package sqlite
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"io/ioutil"
 	"os"
 	"testing"
 
 	"github.com/lusis/statusthing/internal/storers"
 	_ "github.com/lusis/statusthing/internal/storers/sqlite/driver" // sql driver
+	"github.com/lusis/statusthing/internal/testutils"
 	"github.com/lusis/statusthing/migrations"
 
 	"github.com/stretchr/testify/require"
@@ -37,6 +39,45 @@ func makeTestdb(t *testing.T, option string) (*sql.DB, error) {
 func TestImplementsStatusStorer(t *testing.T) {
 	t.Parallel()
 	require.Implements(t, (*storers.StatusStorer)(nil), &Store{})
+	require.Implements(t, (*storers.Transactor)(nil), &Store{})
+	require.Implements(t, (*storers.Backuper)(nil), &Store{})
+	require.Implements(t, (*storers.BlobStorer)(nil), &Store{})
+}
+
+func TestWithTx(t *testing.T) {
+	ctx := context.TODO()
+	db, dberr := makeTestdb(t, ":memory:")
+	require.NoError(t, dberr)
+	store, _ := New(db)
+
+	t.Run("rollback", func(t *testing.T) {
+		txerr := fmt.Errorf("rollback please")
+		err := store.WithTx(ctx, func(tx storers.StatusThingStorer) error {
+			if _, err := tx.StoreItem(ctx, testutils.MakeItem(t.Name())); err != nil {
+				return err
+			}
+			return txerr
+		})
+		require.ErrorIs(t, err, txerr)
+		res, ferr := store.FindItems(ctx)
+		require.NoError(t, ferr)
+		require.Len(t, res, 0, "item should have been rolled back")
+	})
+	t.Run("commit", func(t *testing.T) {
+		err := store.WithTx(ctx, func(tx storers.StatusThingStorer) error {
+			item, err := tx.StoreItem(ctx, testutils.MakeItem(t.Name()))
+			if err != nil {
+				return err
+			}
+			_, err = tx.StoreNote(ctx, testutils.MakeNote(t.Name()), item.GetId())
+			return err
+		})
+		require.NoError(t, err)
+		res, ferr := store.FindItems(ctx)
+		require.NoError(t, ferr)
+		require.Len(t, res, 1)
+		require.Len(t, res[0].GetNotes(), 1)
+	})
 }
 func TestCreateTables(t *testing.T) {
 	t.Parallel()
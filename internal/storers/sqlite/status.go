@@ -86,6 +86,8 @@ func (s *Store) FindStatus(ctx context.Context, opts ...filters.FilterOption) ([
 }
 
 // UpdateStatus updates the [statusthingv1.Status] by id with the provided [filters.FilterOption]
+// [filters.WithExpectedVersion] returns [serrors.ErrConflict] if the status has been changed since
+// [filters.WithClearedFields] can clear the description and color
 func (s *Store) UpdateStatus(ctx context.Context, statusID string, opts ...filters.FilterOption) error {
 	f, ferr := filters.New(opts...)
 	if ferr != nil {
@@ -114,7 +116,10 @@ func (s *Store) UpdateStatus(ctx context.Context, statusID string, opts ...filte
 	if validation.ValidString(desc) {
 		columns[descriptionColumn] = desc
 	}
-	return s.update(ctx, statusTableName, idColumn, statusID, columns)
+	if err := clearColumns(columns, f.ClearedFields(), descriptionColumn, colorColumn); err != nil {
+		return err
+	}
+	return s.updateVersioned(ctx, statusTableName, "status", statusID, f.ExpectedVersion(), columns)
 }
 
 // DeleteStatus deletes a [statusthingv1.Status] by its id
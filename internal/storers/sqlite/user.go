@@ -4,6 +4,8 @@ package sqlite
 import (
 	"context"
 
+	"github.com/doug-martin/goqu/v9"
+
 	v1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
 	"github.com/lusis/statusthing/internal/filters"
 	"github.com/lusis/statusthing/internal/serrors"
@@ -39,9 +41,30 @@ func (s *Store) GetUser(ctx context.Context, username string) (*v1.User, error)
 	return r, nil
 }
 
-// FindUsers finds users
+// FindUsers returns all known [v1.User] ordered by username
+// no filters are currently supported
 func (s *Store) FindUsers(ctx context.Context, opts ...filters.FilterOption) ([]*v1.User, error) {
-	panic("not implemented") // TODO: Implement
+	if _, ferr := filters.New(opts...); ferr != nil {
+		return nil, ferr
+	}
+	dbresults := []*internal.DbUser{}
+	pbresults := []*v1.User{}
+	dserr := s.goqudb.From(usersTableName).Prepared(true).Order(goqu.C(usernameColumn).Asc()).ScanStructsContext(ctx, &dbresults)
+	if dserr != nil {
+		return nil, serrors.NewWrappedError("driver", serrors.ErrUnrecoverable, dserr)
+	}
+	for _, rec := range dbresults {
+		pb, pberr := rec.ToProto()
+		if pberr != nil {
+			return nil, serrors.NewWrappedError("proto", serrors.ErrUnrecoverable, pberr)
+		}
+		user, ok := pb.(*v1.User)
+		if !ok {
+			return nil, serrors.NewError("casting-user", serrors.ErrInvalidData)
+		}
+		pbresults = append(pbresults, user)
+	}
+	return pbresults, nil
 }
 
 // UpdateUser updates a [v1.User]
@@ -60,7 +83,9 @@ func (s *Store) UpdateUser(ctx context.Context, username string, opts ...filters
 	email := f.EmailAddress()
 	lastlogin := f.LastLogin()
 	avatarURL := f.AvatarURL()
+	avatar := f.Avatar()
 	password := f.Password()
+	role := f.Role()
 
 	columns := map[string]any{}
 
@@ -76,9 +101,18 @@ func (s *Store) UpdateUser(ctx context.Context, username string, opts ...filters
 	if validation.ValidString(avatarURL) {
 		columns[avatarURLColumn] = avatarURL
 	}
+	if len(avatar) != 0 {
+		columns[avatarColumn] = avatar
+	}
 	if validation.ValidString(password) {
 		columns[passwordColumn] = password
 	}
+	if role != v1.Role_ROLE_UNKNOWN {
+		columns[roleColumn] = role.String()
+	}
+	if required, ok := f.PasswordChangeRequired(); ok {
+		columns[passwordChangeColumn] = required
+	}
 	if lastlogin != nil {
 		columns[lastloginColumn] = storers.TimeToUint64(lastlogin)
 	}
@@ -92,3 +126,20 @@ func (s *Store) DeleteUser(ctx context.Context, username string) error {
 	}
 	return s.del(ctx, usersTableName, usernameColumn, username)
 }
+
+// GetAvatar gets the avatar image of a [v1.User] by username
+// users without an avatar return [serrors.ErrNotFound]
+func (s *Store) GetAvatar(ctx context.Context, username string) ([]byte, error) {
+	var avatar []byte
+	found, err := s.goqudb.From(usersTableName).Prepared(true).
+		Select(goqu.C(avatarColumn)).
+		Where(goqu.C(usernameColumn).Eq(username), goqu.C(avatarColumn).IsNotNull()).
+		ScanValContext(ctx, &avatar)
+	if err != nil {
+		return nil, serrors.NewWrappedError("driver", serrors.ErrUnrecoverable, err)
+	}
+	if !found {
+		return nil, serrors.NewError("avatar", serrors.ErrNotFound)
+	}
+	return avatar, nil
+}
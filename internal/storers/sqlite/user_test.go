@@ -53,6 +53,8 @@ func TestUserLifeCycle(t *testing.T) {
 		filters.WithEmailAddress("new-email"),
 		filters.WithLastLogin(&now),
 		filters.WithPassword("newpass"),
+		filters.WithRole(v1.Role_ROLE_EDITOR),
+		filters.WithPasswordChangeRequired(true),
 	)
 	require.NoError(t, uerr)
 	gres, gerr := store.GetUser(ctx, user.Username)
@@ -64,6 +66,34 @@ func TestUserLifeCycle(t *testing.T) {
 	require.Equal(t, "new-email", gres.GetEmailAddress())
 	require.True(t, gres.GetLastLogin().IsValid())
 	require.Equal(t, "newpass", gres.GetPassword())
+	require.Equal(t, v1.Role_ROLE_EDITOR, gres.GetRole())
+	require.True(t, gres.GetPasswordChangeRequired())
+	require.NoError(t, store.UpdateUser(ctx, user.Username, filters.WithPasswordChangeRequired(false)))
+	gres, gerr = store.GetUser(ctx, user.Username)
+	require.NoError(t, gerr)
+	require.False(t, gres.GetPasswordChangeRequired())
+
+	// Avatar
+	_, err = store.GetAvatar(ctx, user.Username)
+	require.ErrorIs(t, err, serrors.ErrNotFound)
+	require.NoError(t, store.UpdateUser(ctx, user.Username, filters.WithAvatar([]byte("png"))))
+	avatar, err := store.GetAvatar(ctx, user.Username)
+	require.NoError(t, err)
+	require.Equal(t, []byte("png"), avatar)
+	_, err = store.GetAvatar(ctx, "missing")
+	require.ErrorIs(t, err, serrors.ErrNotFound)
+
+	// Find
+	other := testutils.MakeUser(t.Name() + "_other")
+	other.EmailAddress = t.Name() + "_other_email"
+	_, err = store.StoreUser(ctx, other)
+	require.NoError(t, err)
+	fres, ferr := store.FindUsers(ctx)
+	require.NoError(t, ferr)
+	require.Len(t, fres, 2)
+	// ordered by username
+	require.Equal(t, other.GetUsername(), fres[0].GetUsername())
+	require.Equal(t, user.GetUsername(), fres[1].GetUsername())
 
 	delerr := store.DeleteUser(ctx, res.Username)
 	require.NoError(t, delerr)
@@ -0,0 +1,36 @@
+package unimplemented
+
+import (
+	"context"
+
+	v1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
+	"github.com/lusis/statusthing/internal/serrors"
+)
+
+// AttachmentStore stores [statusthingv1.Attachment]
+type AttachmentStore struct{}
+
+// StoreAttachment stores the provided [statusthingv1.Attachment]
+func (as *AttachmentStore) StoreAttachment(ctx context.Context, att *v1.Attachment) (*v1.Attachment, error) { // nolint: revive
+	return nil, serrors.ErrNotImplemented
+}
+
+// GetAttachment gets a [statusthingv1.Attachment] by its id
+func (as *AttachmentStore) GetAttachment(ctx context.Context, attachmentID string) (*v1.Attachment, error) { // nolint: revive
+	return nil, serrors.ErrNotImplemented
+}
+
+// FindAttachments gets every [statusthingv1.Attachment] of a [statusthingv1.Note]
+func (as *AttachmentStore) FindAttachments(ctx context.Context, noteID string) ([]*v1.Attachment, error) { // nolint: revive
+	return nil, serrors.ErrNotImplemented
+}
+
+// AttachmentExists is true when an [statusthingv1.Attachment] with the provided id is stored
+func (as *AttachmentStore) AttachmentExists(ctx context.Context, attachmentID string) (bool, error) { // nolint: revive
+	return false, serrors.ErrNotImplemented
+}
+
+// DeleteAttachment deletes a [statusthingv1.Attachment] by its id
+func (as *AttachmentStore) DeleteAttachment(ctx context.Context, attachmentID string) error { // nolint: revive
+	return serrors.ErrNotImplemented
+}
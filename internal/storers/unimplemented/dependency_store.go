@@ -0,0 +1,27 @@
+package unimplemented
+
+import (
+	"context"
+
+	v1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
+	"github.com/lusis/statusthing/internal/filters"
+	"github.com/lusis/statusthing/internal/serrors"
+)
+
+// DependencyStore ...
+type DependencyStore struct{}
+
+// StoreDependency stores the provided [statusthingv1.ItemDependency]
+func (ds *DependencyStore) StoreDependency(ctx context.Context, dep *v1.ItemDependency) (*v1.ItemDependency, error) { // nolint: revive
+	return nil, serrors.ErrNotImplemented
+}
+
+// FindDependencies returns all known [statusthingv1.ItemDependency] optionally filtered by the provided [filters.FilterOption]
+func (ds *DependencyStore) FindDependencies(ctx context.Context, opts ...filters.FilterOption) ([]*v1.ItemDependency, error) { // nolint: revive
+	return nil, serrors.ErrNotImplemented
+}
+
+// DeleteDependency deletes the [statusthingv1.ItemDependency] between the provided item ids
+func (ds *DependencyStore) DeleteDependency(ctx context.Context, itemID, dependsOnID string) error { // nolint: revive
+	return serrors.ErrNotImplemented
+}
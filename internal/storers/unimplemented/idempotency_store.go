@@ -0,0 +1,37 @@
+package unimplemented
+
+import (
+	"context"
+	"time"
+
+	"github.com/lusis/statusthing/internal/serrors"
+	"github.com/lusis/statusthing/internal/storers"
+)
+
+// IdempotencyStore ...
+type IdempotencyStore struct{}
+
+// StoreIdempotencyRecord stores the provided [storers.IdempotencyRecord]
+func (is *IdempotencyStore) StoreIdempotencyRecord(ctx context.Context, rec *storers.IdempotencyRecord) error { // nolint: revive
+	return serrors.ErrNotImplemented
+}
+
+// GetIdempotencyRecord gets a [storers.IdempotencyRecord] by its key
+func (is *IdempotencyStore) GetIdempotencyRecord(ctx context.Context, key string) (*storers.IdempotencyRecord, error) { // nolint: revive
+	return nil, serrors.ErrNotImplemented
+}
+
+// UpdateIdempotencyRecord sets the response of the [storers.IdempotencyRecord] with the provided key
+func (is *IdempotencyStore) UpdateIdempotencyRecord(ctx context.Context, key string, response []byte) error { // nolint: revive
+	return serrors.ErrNotImplemented
+}
+
+// DeleteIdempotencyRecord deletes a [storers.IdempotencyRecord] by its key
+func (is *IdempotencyStore) DeleteIdempotencyRecord(ctx context.Context, key string) error { // nolint: revive
+	return serrors.ErrNotImplemented
+}
+
+// DeleteExpiredIdempotencyRecords deletes every [storers.IdempotencyRecord] that expired before the provided time and returns how many were deleted
+func (is *IdempotencyStore) DeleteExpiredIdempotencyRecords(ctx context.Context, before time.Time) (int, error) { // nolint: revive
+	return 0, serrors.ErrNotImplemented
+}
@@ -0,0 +1,22 @@
+package unimplemented
+
+import (
+	"context"
+
+	v1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
+	"github.com/lusis/statusthing/internal/filters"
+	"github.com/lusis/statusthing/internal/serrors"
+)
+
+// ItemBatchStore ...
+type ItemBatchStore struct{}
+
+// BatchUpdateItems updates every [statusthingv1.Item] with the provided ids with the provided [filters.FilterOption]
+func (bs *ItemBatchStore) BatchUpdateItems(ctx context.Context, itemIDs []string, notes map[string]*v1.Note, opts ...filters.FilterOption) error { // nolint: revive
+	return serrors.ErrNotImplemented
+}
+
+// BatchDeleteItems deletes every [statusthingv1.Item] with the provided ids
+func (bs *ItemBatchStore) BatchDeleteItems(ctx context.Context, itemIDs []string) error { // nolint: revive
+	return serrors.ErrNotImplemented
+}
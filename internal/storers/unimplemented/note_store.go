@@ -31,6 +31,11 @@ func (ns *NoteStorer) UpdateNote(ctx context.Context, noteID string, opts ...fil
 	return serrors.ErrNotImplemented
 }
 
+// FindNoteRevisions gets every [statusthingv1.NoteRevision] of a [statusthingv1.Note]
+func (ns *NoteStorer) FindNoteRevisions(ctx context.Context, noteID string) ([]*v1.NoteRevision, error) { // nolint: revive
+	return nil, serrors.ErrNotImplemented
+}
+
 // DeleteNote deletes a [statusthingv1.Note] by its id
 func (ns *NoteStorer) DeleteNote(ctx context.Context, noteID string) error { // nolint: revive
 	return serrors.ErrNotImplemented
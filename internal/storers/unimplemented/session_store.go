@@ -0,0 +1,36 @@
+package unimplemented
+
+import (
+	"context"
+	"time"
+
+	"github.com/lusis/statusthing/internal/serrors"
+)
+
+// SessionStore stores admin ui sessions
+type SessionStore struct{}
+
+// StoreSession creates or replaces the session with the provided token
+func (ss *SessionStore) StoreSession(ctx context.Context, token string, data []byte, expires time.Time) error { // nolint: revive
+	return serrors.ErrNotImplemented
+}
+
+// GetSession gets the data of the session with the provided token
+func (ss *SessionStore) GetSession(ctx context.Context, token string) ([]byte, error) { // nolint: revive
+	return nil, serrors.ErrNotImplemented
+}
+
+// FindSessions gets the data of every session that hasn't expired by token
+func (ss *SessionStore) FindSessions(ctx context.Context) (map[string][]byte, error) { // nolint: revive
+	return nil, serrors.ErrNotImplemented
+}
+
+// DeleteSession deletes the session with the provided token
+func (ss *SessionStore) DeleteSession(ctx context.Context, token string) error { // nolint: revive
+	return serrors.ErrNotImplemented
+}
+
+// DeleteExpiredSessions deletes every session that expired before the provided time
+func (ss *SessionStore) DeleteExpiredSessions(ctx context.Context, before time.Time) (int, error) { // nolint: revive
+	return 0, serrors.ErrNotImplemented
+}
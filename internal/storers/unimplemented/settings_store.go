@@ -0,0 +1,27 @@
+package unimplemented
+
+import (
+	"context"
+
+	v1 "github.com/lusis/statusthing/gen/go/statusthing/v1"
+	"github.com/lusis/statusthing/internal/serrors"
+	"github.com/lusis/statusthing/internal/storers"
+)
+
+// SettingsStore stores [statusthingv1.Settings]
+type SettingsStore struct{}
+
+// GetSettings gets the [statusthingv1.Settings]
+func (ss *SettingsStore) GetSettings(ctx context.Context) (*v1.Settings, error) { // nolint: revive
+	return nil, serrors.ErrNotImplemented
+}
+
+// StoreSettings replaces the [statusthingv1.Settings] and stores the provided images
+func (ss *SettingsStore) StoreSettings(ctx context.Context, settings *v1.Settings, expectedVersion uint64, images ...*storers.SettingsImage) (*v1.Settings, error) { // nolint: revive
+	return nil, serrors.ErrNotImplemented
+}
+
+// GetSettingsImage gets the [storers.SettingsImage] with the provided name
+func (ss *SettingsStore) GetSettingsImage(ctx context.Context, name string) (*storers.SettingsImage, error) { // nolint: revive
+	return nil, serrors.ErrNotImplemented
+}
@@ -6,4 +6,10 @@ type StatusThingStore struct {
 	*StatusStore
 	*ItemStore
 	*UserStore
+	*DependencyStore
+	*ItemBatchStore
+	*IdempotencyStore
+	*AttachmentStore
+	*SettingsStore
+	*SessionStore
 }
@@ -11,5 +11,12 @@ func TestImplements(t *testing.T) {
 	require.Implements(t, (*storers.StatusStorer)(nil), new(StatusStore), "unimplemented custom status store should satisfy interface")
 	require.Implements(t, (*storers.NoteStorer)(nil), new(NoteStorer), "unimplemented note store should satisfy interface")
 	require.Implements(t, (*storers.ItemStorer)(nil), new(ItemStore), "unimplemented status thing store should sastify interface")
+	require.Implements(t, (*storers.DependencyStorer)(nil), new(DependencyStore), "unimplemented dependency store should sastify interface")
+	require.Implements(t, (*storers.ItemBatchStorer)(nil), new(ItemBatchStore), "unimplemented item batch store should sastify interface")
+	require.Implements(t, (*storers.IdempotencyStorer)(nil), new(IdempotencyStore), "unimplemented idempotency store should sastify interface")
+	require.Implements(t, (*storers.UserStorer)(nil), new(UserStore), "unimplemented user store should sastify interface")
+	require.Implements(t, (*storers.AttachmentStorer)(nil), new(AttachmentStore), "unimplemented attachment store should sastify interface")
+	require.Implements(t, (*storers.SettingsStorer)(nil), new(SettingsStore), "unimplemented settings store should sastify interface")
+	require.Implements(t, (*storers.SessionStorer)(nil), new(SessionStore), "unimplemented session store should satisfy interface")
 	require.Implements(t, (*storers.StatusThingStorer)(nil), new(StatusThingStore), "unimplemented status thing store should sastify interface")
 }
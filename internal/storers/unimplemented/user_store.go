@@ -35,3 +35,8 @@ func (us *UserStore) UpdateUser(ctx context.Context, userID string, opts ...filt
 func (us *UserStore) DeleteUser(ctx context.Context, userID string) error {
 	panic("not implemented") // TODO: Implement
 }
+
+// GetAvatar gets the avatar image of a [v1.User] by username
+func (us *UserStore) GetAvatar(ctx context.Context, username string) ([]byte, error) {
+	panic("not implemented") // TODO: Implement
+}
@@ -0,0 +1,146 @@
+package templating
+
+import (
+	"errors"
+	"html/template"
+	"io/fs"
+	"path"
+	"sort"
+
+	"golang.org/x/exp/slog"
+)
+
+// CheckFunc checks the contents of an override before it is used
+type CheckFunc func(name string, data []byte) error
+
+// HTMLCheck returns a [CheckFunc] that accepts templates that parse with the provided funcs
+func HTMLCheck(funcs template.FuncMap) CheckFunc {
+	return func(name string, data []byte) error {
+		_, err := template.New(name).Funcs(funcs).Parse(string(data))
+		return err
+	}
+}
+
+// LayeredFS is an [fs.FS] that reads each file from the first layer that has it
+// directories list the files of every layer
+type LayeredFS struct {
+	layers []fs.FS
+}
+
+// NewLayeredFS returns a new [LayeredFS] with the provided layers, the first layer on top
+// nil layers are skipped
+func NewLayeredFS(layers ...fs.FS) *LayeredFS {
+	l := &LayeredFS{}
+	for _, layer := range layers {
+		if layer != nil {
+			l.layers = append(l.layers, layer)
+		}
+	}
+	return l
+}
+
+// Open implements [fs.FS]
+func (l *LayeredFS) Open(name string) (fs.File, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrInvalid}
+	}
+	for _, layer := range l.layers {
+		f, err := layer.Open(name)
+		if err == nil {
+			return f, nil
+		}
+		if !errors.Is(err, fs.ErrNotExist) {
+			return nil, err
+		}
+	}
+	return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+}
+
+// ReadDir implements [fs.ReadDirFS]
+// an entry in more than one layer is the one from the top layer
+func (l *LayeredFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	found := false
+	entries := map[string]fs.DirEntry{}
+	for i := len(l.layers) - 1; i >= 0; i-- {
+		layerEntries, err := fs.ReadDir(l.layers[i], name)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		found = true
+		for _, e := range layerEntries {
+			entries[e.Name()] = e
+		}
+	}
+	if !found {
+		return nil, &fs.PathError{Op: "readdir", Path: name, Err: fs.ErrNotExist}
+	}
+	res := make([]fs.DirEntry, 0, len(entries))
+	for _, e := range entries {
+		res = append(res, e)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Name() < res[j].Name() })
+	return res, nil
+}
+
+// NewOverrideFS returns a [LayeredFS] of overrides on top of base
+// overrides matching pattern are only used when check accepts them. ones it rejects are logged and hidden so the file in base is used instead
+// overrides are checked every time they are read so fixing one takes effect without a restart
+func NewOverrideFS(base, overrides fs.FS, pattern string, check CheckFunc) *LayeredFS {
+	if overrides == nil {
+		return NewLayeredFS(base)
+	}
+	return NewLayeredFS(&checkedFS{fs: overrides, pattern: pattern, check: check}, base)
+}
+
+// checkedFS hides the files matching pattern that check rejects
+type checkedFS struct {
+	fs      fs.FS
+	pattern string
+	check   CheckFunc
+}
+
+// Open implements [fs.FS]
+func (c *checkedFS) Open(name string) (fs.File, error) {
+	if !c.accepted(name) {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+	}
+	return c.fs.Open(name)
+}
+
+// ReadDir implements [fs.ReadDirFS]
+func (c *checkedFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	entries, err := fs.ReadDir(c.fs, name)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]fs.DirEntry, 0, len(entries))
+	for _, e := range entries {
+		if e.IsDir() || c.accepted(path.Join(name, e.Name())) {
+			res = append(res, e)
+		}
+	}
+	return res, nil
+}
+
+// accepted is true unless name matches the pattern and fails the check
+func (c *checkedFS) accepted(name string) bool {
+	if c.check == nil {
+		return true
+	}
+	if matched, _ := path.Match(c.pattern, name); !matched {
+		return true
+	}
+	data, err := fs.ReadFile(c.fs, name)
+	if err != nil {
+		// missing files are left to Open to report
+		return true
+	}
+	if err := c.check(name, data); err != nil {
+		slog.Warn("ignoring theme override", "name", name, "error", err)
+		return false
+	}
+	return true
+}
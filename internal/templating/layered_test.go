@@ -0,0 +1,95 @@
+package templating
+
+import (
+	"html/template"
+	"io/fs"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLayeredFS(t *testing.T) {
+	t.Parallel()
+	base := fstest.MapFS{
+		"index.html":   {Data: []byte("base index")},
+		"other.html":   {Data: []byte("base other")},
+		"css/site.css": {Data: []byte("base css")},
+	}
+	top := fstest.MapFS{
+		"index.html":    {Data: []byte("top index")},
+		"extra.html":    {Data: []byte("top extra")},
+		"css/theme.css": {Data: []byte("top css")},
+	}
+	l := NewLayeredFS(top, nil, base)
+	testCases := map[string]struct {
+		name string
+		want string
+		err  error
+	}{
+		"top":        {name: "index.html", want: "top index"},
+		"base":       {name: "other.html", want: "base other"},
+		"top-only":   {name: "extra.html", want: "top extra"},
+		"nested":     {name: "css/site.css", want: "base css"},
+		"missing":    {name: "missing.html", err: fs.ErrNotExist},
+		"invalid":    {name: "../index.html", err: fs.ErrInvalid},
+		"nested-top": {name: "css/theme.css", want: "top css"},
+	}
+	for n, tc := range testCases {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+			b, err := fs.ReadFile(l, tc.name)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.want, string(b))
+		})
+	}
+	matches, err := fs.Glob(l, "*.html")
+	require.NoError(t, err)
+	require.Equal(t, []string{"extra.html", "index.html", "other.html"}, matches)
+	entries, err := fs.ReadDir(l, "css")
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	_, err = fs.ReadDir(l, "js")
+	require.ErrorIs(t, err, fs.ErrNotExist)
+}
+
+func TestOverrideFS(t *testing.T) {
+	t.Parallel()
+	funcs := template.FuncMap{"upper": func(s string) string { return s }}
+	base := fstest.MapFS{
+		"index.html": {Data: []byte(`base {{ upper "index" }}`)},
+		"other.html": {Data: []byte("base other")},
+	}
+	overrides := fstest.MapFS{
+		"index.html":   {Data: []byte("broken {{ if }}")},
+		"other.html":   {Data: []byte(`theme {{ upper "other" }}`)},
+		"unknown.html": {Data: []byte(`{{ nosuchfunc }}`)},
+		"site.css":     {Data: []byte("{{ not checked")},
+	}
+	o := NewOverrideFS(base, overrides, "*.html", HTMLCheck(funcs))
+	b, err := fs.ReadFile(o, "index.html")
+	require.NoError(t, err)
+	require.Equal(t, `base {{ upper "index" }}`, string(b), "an override that doesn't parse should fall back to the base file")
+	b, err = fs.ReadFile(o, "other.html")
+	require.NoError(t, err)
+	require.Equal(t, `theme {{ upper "other" }}`, string(b))
+	_, err = fs.ReadFile(o, "unknown.html")
+	require.ErrorIs(t, err, fs.ErrNotExist, "templates calling unknown funcs should be hidden")
+	b, err = fs.ReadFile(o, "site.css")
+	require.NoError(t, err)
+	require.Equal(t, "{{ not checked", string(b), "files not matching the pattern should not be checked")
+
+	templates, err := template.New("").Funcs(funcs).ParseFS(o, "*.html")
+	require.NoError(t, err)
+	require.NotNil(t, templates.Lookup("index.html"))
+	require.Nil(t, templates.Lookup("unknown.html"))
+
+	b, err = fs.ReadFile(NewOverrideFS(base, nil, "*.html", nil), "index.html")
+	require.NoError(t, err)
+	require.Equal(t, `base {{ upper "index" }}`, string(b))
+}
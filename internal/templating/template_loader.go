@@ -2,8 +2,8 @@
 package templating
 
 import (
+	"html/template"
 	"io/fs"
-	"text/template"
 
 	"golang.org/x/exp/slog"
 )
@@ -23,6 +23,19 @@ var AllStatusKind = []string{
 	"STATUS_KIND_DECOMM",
 }
 
+// AllRollupPolicy is a reusable list of all our current rollup policies in a quick slice form
+var AllRollupPolicy = []string{
+	"ROLLUP_POLICY_WORST_OF",
+	"ROLLUP_POLICY_MAJORITY",
+	"ROLLUP_POLICY_IGNORE",
+}
+
+// AllNoteVisibility is a reusable list of all our current note visibilities in a quick slice form
+var AllNoteVisibility = []string{
+	"NOTE_VISIBILITY_PUBLIC",
+	"NOTE_VISIBILITY_INTERNAL",
+}
+
 // TemplateLoader is something that can lookup templates
 type TemplateLoader interface {
 	Lookup(s string) *template.Template
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS items_old
+	(
+		id VARCHAR(191) PRIMARY KEY,
+		name VARCHAR(191) NOT NULL UNIQUE,
+		description VARCHAR(191) DEFAULT NULL,
+		status_id VARCHAR(191) DEFAULT NULL,
+		created INT NOT NULL,
+		updated INT NOT NULL,
+		deleted INT DEFAULT NULL,
+		FOREIGN KEY(status_id) REFERENCES status(id)
+	);
+INSERT INTO items_old SELECT id, name, description, status_id, created, updated, deleted FROM items;
+DROP TABLE items;
+ALTER TABLE items_old RENAME TO items;
@@ -0,0 +1,2 @@
+ALTER TABLE items ADD COLUMN parent_id VARCHAR(191) DEFAULT NULL REFERENCES items(id) ON DELETE SET NULL;
+ALTER TABLE items ADD COLUMN rollup_policy VARCHAR(191) DEFAULT NULL;
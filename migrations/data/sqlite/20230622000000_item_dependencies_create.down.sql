@@ -0,0 +1 @@
+DROP TABLE IF EXISTS item_dependencies;
\ No newline at end of file
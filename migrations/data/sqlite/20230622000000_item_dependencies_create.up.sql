@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS item_dependencies
+	(
+		item_id VARCHAR(191) NOT NULL,
+		depends_on_id VARCHAR(191) NOT NULL,
+		created INT NOT NULL,
+		updated INT NOT NULL,
+		deleted INT DEFAULT NULL,
+		PRIMARY KEY(item_id, depends_on_id),
+		FOREIGN KEY(item_id) REFERENCES items(id) ON DELETE CASCADE,
+		FOREIGN KEY(depends_on_id) REFERENCES items(id) ON DELETE CASCADE
+	);
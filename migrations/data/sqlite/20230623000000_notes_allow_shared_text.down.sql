@@ -0,0 +1,14 @@
+-- restoring UNIQUE fails if batch updates stored the same note text on several items
+CREATE TABLE IF NOT EXISTS notes_old
+	(
+		id VARCHAR(191) PRIMARY KEY,
+		note_text VARCHAR(191) NOT NULL UNIQUE,
+		item_id VARCHAR(191) NOT NULL,
+		created INT NOT NULL,
+		updated INT NOT NULL,
+		deleted INT DEFAULT NULL,
+		FOREIGN KEY(item_id) REFERENCES items(id)
+	);
+INSERT INTO notes_old SELECT id, note_text, item_id, created, updated, deleted FROM notes;
+DROP TABLE notes;
+ALTER TABLE notes_old RENAME TO notes;
@@ -0,0 +1,17 @@
+-- BatchUpdateItems can add the same note text to every item in the batch,
+-- which the UNIQUE constraint on note_text made impossible past the first item.
+-- BatchDeleteItems deletes items that may have notes; with foreign keys enforced
+-- those notes have to go with the item, hence ON DELETE CASCADE.
+CREATE TABLE IF NOT EXISTS notes_new
+	(
+		id VARCHAR(191) PRIMARY KEY,
+		note_text VARCHAR(191) NOT NULL,
+		item_id VARCHAR(191) NOT NULL,
+		created INT NOT NULL,
+		updated INT NOT NULL,
+		deleted INT DEFAULT NULL,
+		FOREIGN KEY(item_id) REFERENCES items(id) ON DELETE CASCADE
+	);
+INSERT INTO notes_new SELECT id, note_text, item_id, created, updated, deleted FROM notes;
+DROP TABLE notes;
+ALTER TABLE notes_new RENAME TO notes;
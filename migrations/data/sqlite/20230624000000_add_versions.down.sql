@@ -0,0 +1,3 @@
+ALTER TABLE notes DROP COLUMN version;
+ALTER TABLE status DROP COLUMN version;
+ALTER TABLE items DROP COLUMN version;
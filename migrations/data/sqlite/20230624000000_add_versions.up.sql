@@ -0,0 +1,3 @@
+ALTER TABLE items ADD COLUMN version INT NOT NULL DEFAULT 1;
+ALTER TABLE status ADD COLUMN version INT NOT NULL DEFAULT 1;
+ALTER TABLE notes ADD COLUMN version INT NOT NULL DEFAULT 1;
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS idempotency_keys
+	(
+		idempotency_key VARCHAR(191) PRIMARY KEY,
+		request_hash VARCHAR(191) NOT NULL,
+		response BLOB DEFAULT NULL,
+		created INT NOT NULL,
+		expires INT NOT NULL
+	);
+CREATE INDEX IF NOT EXISTS idempotency_keys_expires ON idempotency_keys(expires);
@@ -0,0 +1,23 @@
+-- &amp; goes first so the other entities aren't escaped twice
+UPDATE status SET
+	name = REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(name, '&', '&amp;'), '<', '&lt;'), '>', '&gt;'), '''', '&#39;'), '"', '&#34;'),
+	description = REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(description, '&', '&amp;'), '<', '&lt;'), '>', '&gt;'), '''', '&#39;'), '"', '&#34;'),
+	color = REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(color, '&', '&amp;'), '<', '&lt;'), '>', '&gt;'), '''', '&#39;'), '"', '&#34;');
+UPDATE items SET
+	name = REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(name, '&', '&amp;'), '<', '&lt;'), '>', '&gt;'), '''', '&#39;'), '"', '&#34;'),
+	description = REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(description, '&', '&amp;'), '<', '&lt;'), '>', '&gt;'), '''', '&#39;'), '"', '&#34;');
+UPDATE notes SET note_text = REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(note_text, '&', '&amp;'), '<', '&lt;'), '>', '&gt;'), '''', '&#39;'), '"', '&#34;');
+CREATE TABLE IF NOT EXISTS notes_old
+	(
+		id VARCHAR(191) PRIMARY KEY,
+		note_text VARCHAR(191) NOT NULL,
+		item_id VARCHAR(191) NOT NULL,
+		created INT NOT NULL,
+		updated INT NOT NULL,
+		deleted INT DEFAULT NULL,
+		version INT NOT NULL DEFAULT 1,
+		FOREIGN KEY(item_id) REFERENCES items(id) ON DELETE CASCADE
+	);
+INSERT INTO notes_old (id, note_text, item_id, created, updated, deleted, version) SELECT id, note_text, item_id, created, updated, deleted, version FROM notes;
+DROP TABLE notes;
+ALTER TABLE notes_old RENAME TO notes;
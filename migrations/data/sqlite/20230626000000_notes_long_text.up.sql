@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS notes_new
+	(
+		id VARCHAR(191) PRIMARY KEY,
+		note_text TEXT NOT NULL,
+		item_id VARCHAR(191) NOT NULL,
+		created INT NOT NULL,
+		updated INT NOT NULL,
+		deleted INT DEFAULT NULL,
+		version INT NOT NULL DEFAULT 1,
+		FOREIGN KEY(item_id) REFERENCES items(id) ON DELETE CASCADE
+	);
+INSERT INTO notes_new (id, note_text, item_id, created, updated, deleted, version) SELECT id, note_text, item_id, created, updated, deleted, version FROM notes;
+DROP TABLE notes;
+ALTER TABLE notes_new RENAME TO notes;
+-- text was html escaped when it was written. it is stored as-is now and escaped when it is displayed
+-- &amp; goes last so text that was escaped twice is only unescaped once
+UPDATE notes SET note_text = REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(note_text, '&lt;', '<'), '&gt;', '>'), '&#39;', ''''), '&#34;', '"'), '&amp;', '&');
+UPDATE items SET
+	name = REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(name, '&lt;', '<'), '&gt;', '>'), '&#39;', ''''), '&#34;', '"'), '&amp;', '&'),
+	description = REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(description, '&lt;', '<'), '&gt;', '>'), '&#39;', ''''), '&#34;', '"'), '&amp;', '&');
+UPDATE status SET
+	name = REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(name, '&lt;', '<'), '&gt;', '>'), '&#39;', ''''), '&#34;', '"'), '&amp;', '&'),
+	description = REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(description, '&lt;', '<'), '&gt;', '>'), '&#39;', ''''), '&#34;', '"'), '&amp;', '&'),
+	color = REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(color, '&lt;', '<'), '&gt;', '>'), '&#39;', ''''), '&#34;', '"'), '&amp;', '&');
@@ -0,0 +1,3 @@
+ALTER TABLE notes DROP COLUMN pinned;
+ALTER TABLE notes DROP COLUMN visibility;
+ALTER TABLE notes DROP COLUMN author_id;
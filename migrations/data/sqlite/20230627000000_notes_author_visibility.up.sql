@@ -0,0 +1,3 @@
+ALTER TABLE notes ADD COLUMN author_id VARCHAR(191) DEFAULT NULL;
+ALTER TABLE notes ADD COLUMN visibility VARCHAR(191) NOT NULL DEFAULT 'NOTE_VISIBILITY_PUBLIC';
+ALTER TABLE notes ADD COLUMN pinned INT NOT NULL DEFAULT 0;
@@ -0,0 +1,2 @@
+ALTER TABLE notes DROP COLUMN edited;
+DROP TABLE IF EXISTS note_revisions;
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS note_revisions
+	(
+		note_id VARCHAR(191) NOT NULL,
+		version INT NOT NULL,
+		note_text TEXT NOT NULL,
+		visibility VARCHAR(191) NOT NULL,
+		pinned INT NOT NULL DEFAULT 0,
+		written INT NOT NULL,
+		replaced INT NOT NULL,
+		PRIMARY KEY (note_id, version),
+		FOREIGN KEY(note_id) REFERENCES notes(id) ON DELETE CASCADE
+	);
+ALTER TABLE notes ADD COLUMN edited INT NOT NULL DEFAULT 0;
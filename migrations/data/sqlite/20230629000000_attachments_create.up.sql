@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS attachments
+	(
+		id VARCHAR(191) PRIMARY KEY,
+		note_id VARCHAR(191) NOT NULL,
+		filename VARCHAR(191) NOT NULL,
+		content_type VARCHAR(191) NOT NULL,
+		size INT NOT NULL,
+		sha256 VARCHAR(64) NOT NULL,
+		created INT NOT NULL,
+		updated INT NOT NULL,
+		deleted INT DEFAULT NULL,
+		FOREIGN KEY(note_id) REFERENCES notes(id) ON DELETE CASCADE
+	);
+CREATE INDEX IF NOT EXISTS attachments_note_id ON attachments(note_id);
+CREATE TABLE IF NOT EXISTS blobs
+	(
+		blob_key VARCHAR(191) PRIMARY KEY,
+		data BLOB NOT NULL
+	);
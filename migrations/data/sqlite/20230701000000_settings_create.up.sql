@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS settings
+	(
+		id VARCHAR(191) PRIMARY KEY,
+		page_title VARCHAR(191),
+		logo_url VARCHAR(191),
+		favicon_url VARCHAR(191),
+		accent_color VARCHAR(191),
+		accent_text_color VARCHAR(191),
+		support_url VARCHAR(191),
+		footer_text TEXT,
+		timezone VARCHAR(191),
+		logo BLOB,
+		logo_type VARCHAR(191),
+		favicon BLOB,
+		favicon_type VARCHAR(191),
+		version INT NOT NULL DEFAULT 1,
+		created INT NOT NULL,
+		updated INT NOT NULL,
+		deleted INT DEFAULT NULL
+	);
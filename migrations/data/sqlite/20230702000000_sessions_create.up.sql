@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS sessions
+	(
+		token VARCHAR(191) PRIMARY KEY,
+		data BLOB NOT NULL,
+		expires INT NOT NULL
+	);
+CREATE INDEX IF NOT EXISTS sessions_expires ON sessions(expires);
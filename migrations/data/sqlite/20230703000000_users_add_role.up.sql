@@ -0,0 +1,3 @@
+ALTER TABLE users ADD COLUMN role VARCHAR(191);
+-- every user could do everything before roles existed
+UPDATE users SET role = 'ROLE_ADMIN';
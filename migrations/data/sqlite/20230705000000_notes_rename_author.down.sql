@@ -0,0 +1 @@
+ALTER TABLE notes RENAME COLUMN author TO author_id;
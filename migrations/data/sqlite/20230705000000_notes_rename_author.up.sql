@@ -0,0 +1 @@
+ALTER TABLE notes RENAME COLUMN author_id TO author;
@@ -0,0 +1,72 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+
+	"github.com/lusis/statusthing/internal/serrors"
+	"github.com/lusis/statusthing/migrations/sqlite3"
+)
+
+// LatestVersion returns the version of the newest migration for the provided driver
+func LatestVersion(driver string) (uint, error) {
+	var subfs fs.FS
+	switch driver {
+	case "sqlite", "sqlite3":
+		myfs, err := fs.Sub(migrationFS, "data/sqlite")
+		if err != nil {
+			return 0, serrors.NewWrappedError("migrations-subfs", serrors.ErrUnrecoverable, err)
+		}
+		subfs = myfs
+	default:
+		return 0, serrors.NewError("driver", serrors.ErrNotImplemented)
+	}
+	src, err := iofs.New(subfs, ".")
+	if err != nil {
+		return 0, serrors.NewWrappedError("migrationfs-iofs", serrors.ErrUnrecoverable, err)
+	}
+	defer src.Close()
+	version, err := src.First()
+	if err != nil {
+		return 0, serrors.NewWrappedError("migrations", serrors.ErrUnrecoverable, err)
+	}
+	for {
+		next, err := src.Next(version)
+		if errors.Is(err, os.ErrNotExist) {
+			return version, nil
+		}
+		if err != nil {
+			return 0, serrors.NewWrappedError("migrations", serrors.ErrUnrecoverable, err)
+		}
+		version = next
+	}
+}
+
+// CheckVersion returns the schema version of the provided sqlite db
+// [serrors.ErrInvalidData] is returned if the db has never been migrated or a migration failed part way
+// [serrors.ErrConflict] is returned if the db was migrated by a newer version of statusthing
+func CheckVersion(ctx context.Context, db *sql.DB) (uint, error) {
+	var version uint
+	var dirty bool
+	query := fmt.Sprintf("SELECT version, dirty FROM %s LIMIT 1", sqlite3.DefaultMigrationsTable)
+	if err := db.QueryRowContext(ctx, query).Scan(&version, &dirty); err != nil {
+		return 0, serrors.NewWrappedError("schema version", serrors.ErrInvalidData, err)
+	}
+	if dirty {
+		return 0, serrors.NewError(fmt.Sprintf("schema version %d is dirty", version), serrors.ErrInvalidData)
+	}
+	latest, err := LatestVersion("sqlite3")
+	if err != nil {
+		return 0, err
+	}
+	if version > latest {
+		return 0, serrors.NewError(fmt.Sprintf("schema version %d is newer than %d", version, latest), serrors.ErrConflict)
+	}
+	return version, nil
+}